@@ -2,25 +2,47 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"item-pdp-service/docs"
+	grpcserver "item-pdp-service/internal/application/grpc/server"
 	"item-pdp-service/internal/application/http/handlers"
+	"item-pdp-service/internal/application/http/middleware"
 	"item-pdp-service/internal/application/http/routes"
 	"item-pdp-service/internal/application/usecase"
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/auth"
+	"item-pdp-service/internal/infrastructure/cache"
 	"item-pdp-service/internal/infrastructure/config"
+	"item-pdp-service/internal/infrastructure/currency"
 	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/events"
 	"item-pdp-service/internal/infrastructure/persistence"
+	"item-pdp-service/internal/infrastructure/storage"
+	"item-pdp-service/internal/infrastructure/tracing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 )
 
+// @title Item PDP Service API
+// @version 1.0
+// @description Item catalogue, pricing and inventory for the product detail page
+// @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token from POST /api/v1/auth/token, sent as "Bearer <token>"
 func main() {
 	fx.New(
 		// Provide dependencies
@@ -29,8 +51,14 @@ func main() {
 				return config.Load("./configs")
 			},
 			setupLogger,
+			setupTracing,
 			database.NewConnection,
-			persistence.NewPostgresItemRepository,
+			auth.NewTokenService,
+			setupItemRepository,
+			persistence.NewPostgresMaintenanceRepository,
+			persistence.NewPostgresOutboxRepository,
+			persistence.NewPostgresWebhookRepository,
+			persistence.NewPostgresIdempotencyStore,
 			// Mock services for dependency injection (part of intentional flaws)
 			func() usecase.InventoryService {
 				return &mockInventoryService{}
@@ -41,13 +69,37 @@ func main() {
 			func() usecase.PricingService {
 				return &mockPricingService{}
 			},
-			usecase.NewItemUseCase,
-			handlers.NewItemHandler,
+			setupBlobStore,
+			setupCurrencyConverter,
+			setupDiscountPolicy,
+			setupSKUPolicy,
+			setupBusinessRules,
+			setupSeasonalPolicy,
+			events.NewBroadcaster,
+			setupEventPublisher,
+			setupOutboxRelay,
+			setupWebhookWorker,
+			setupItemUseCase,
+			usecase.NewMaintenanceUseCase,
+			usecase.NewWebhookUseCase,
+			setupItemHandler,
+			handlers.NewAdminHandler,
+			handlers.NewWebhookHandler,
+			handlers.NewAuthHandler,
+			handlers.NewHealthHandler,
+			handlers.NewMetricsHandler,
+			setupDocsHandler,
 			setupGinEngine,
 			setupServer,
+			setupGRPCServer,
 		),
-		// Invoke the server
+		// Migrate the schema before the server starts, then invoke the server and the event workers
+		// The workers are invoked last so they stop before the database closes
+		fx.Invoke(runMigrations),
 		fx.Invoke(runServer),
+		fx.Invoke(runGRPCServer),
+		fx.Invoke(runOutboxRelay),
+		fx.Invoke(runWebhookWorker),
 	).Run()
 }
 
@@ -68,6 +120,9 @@ func setupLogger(cfg *config.Config) zerolog.Logger {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
 
+	// log.Ctx falls back to the global logger for contexts without a request logger
+	zerolog.DefaultContextLogger = &log.Logger
+
 	return log.Logger.With().
 		Str("service", cfg.App.Name).
 		Str("version", cfg.App.Version).
@@ -75,8 +130,247 @@ func setupLogger(cfg *config.Config) zerolog.Logger {
 		Logger()
 }
 
+// setupTracing installs the OpenTelemetry tracer provider and flushes it on shutdown
+// The returned provider is nil when tracing is disabled
+func setupTracing(lc fx.Lifecycle, cfg *config.Config) (*sdktrace.TracerProvider, error) {
+	provider, err := tracing.Setup(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, nil
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return provider.Shutdown(ctx)
+		},
+	})
+
+	log.Info().
+		Str("endpoint", cfg.Tracing.Endpoint).
+		Float64("sample_ratio", cfg.Tracing.SampleRatio).
+		Msg("Exporting traces over OTLP")
+
+	return provider, nil
+}
+
+// setupItemRepository provides the item repository, cached when enabled and always traced
+// Tracing wraps the cache so cache hits still show up as repository spans
+func setupItemRepository(lc fx.Lifecycle, cfg *config.Config, db *database.DB, rules item.BusinessRules, _ *sdktrace.TracerProvider) (item.Repository, error) {
+	repoOpts := []persistence.RepositoryOption{persistence.WithBusinessRules(rules)}
+	if cfg.Database.LenientReads {
+		repoOpts = append(repoOpts, persistence.WithLenientReads())
+	}
+
+	var repo item.Repository = persistence.NewPostgresItemRepository(db, repoOpts...)
+	if !cfg.Cache.Enabled {
+		return persistence.NewTracingItemRepository(repo), nil
+	}
+
+	var itemCache cache.Cache
+	switch cfg.Cache.Backend {
+	case "redis":
+		redisCache := cache.NewRedisCache(cfg)
+		lc.Append(fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				return redisCache.Close()
+			},
+		})
+		itemCache = redisCache
+	case "memory":
+		itemCache = cache.NewMemoryCache(cfg.Cache.MaxEntries)
+	default:
+		return nil, fmt.Errorf("unknown cache backend %q", cfg.Cache.Backend)
+	}
+
+	log.Info().
+		Str("backend", cfg.Cache.Backend).
+		Dur("ttl", cfg.Cache.TTL).
+		Msg("Caching item reads")
+
+	repo = persistence.NewCachingItemRepository(repo, itemCache, cfg.Cache.TTL)
+	return persistence.NewTracingItemRepository(repo), nil
+}
+
+// setupBlobStore provides the store for uploaded item images
+func setupBlobStore(cfg *config.Config) (storage.Blob, error) {
+	switch cfg.Storage.Backend {
+	case "local":
+		return storage.NewLocalBlob(cfg.Storage.LocalDir, cfg.Storage.BaseURL), nil
+	case "s3":
+		log.Info().
+			Str("bucket", cfg.Storage.S3Bucket).
+			Str("region", cfg.Storage.S3Region).
+			Msg("Storing uploaded images in S3")
+		return storage.NewS3BlobFromConfig(context.Background(), cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+	}
+}
+
+// setupCurrencyConverter provides the converter for prices in other currencies
+func setupCurrencyConverter(cfg *config.Config) (usecase.CurrencyConverter, error) {
+	return currency.NewRatesConverter(cfg.Currency.Base, cfg.Currency.Rates)
+}
+
+// setupDiscountPolicy provides the category discounts, falling back to the built-in ones
+func setupDiscountPolicy(cfg *config.Config) (item.DiscountPolicy, error) {
+	if len(cfg.Pricing.CategoryDiscounts) == 0 {
+		return item.DefaultDiscountPolicy(), nil
+	}
+	return item.NewDiscountPolicy(cfg.Pricing.CategoryDiscounts)
+}
+
+// setupSKUPolicy provides the configured SKU length and character rules
+func setupSKUPolicy(cfg *config.Config) (item.SKUPolicy, error) {
+	return item.NewSKUPolicy(cfg.SKU.MinLength, cfg.SKU.MaxLength, cfg.SKU.Pattern, item.SKUCase(cfg.SKU.Case))
+}
+
+// setupBusinessRules provides the item thresholds shared by the use case and the repository
+func setupBusinessRules(cfg *config.Config) (item.BusinessRules, error) {
+	return item.NewBusinessRules(cfg.BusinessRules.MaxPriceThreshold, cfg.BusinessRules.MinInventoryLevel,
+		cfg.BusinessRules.DefaultCurrency, cfg.BusinessRules.AllowedCurrencies)
+}
+
+// setupSeasonalPolicy provides the months seasonal items are taken off sale
+func setupSeasonalPolicy(cfg *config.Config) (item.SeasonalPolicy, error) {
+	months := make([]time.Month, len(cfg.BusinessRules.OffSeasonMonths))
+	for i, month := range cfg.BusinessRules.OffSeasonMonths {
+		months[i] = time.Month(month)
+	}
+	return item.NewSeasonalPolicy(months)
+}
+
+// setupEventPublisher provides the publisher used to deliver domain events
+// Events go to Kafka everywhere except the test environment, which stays in-process;
+// once delivered they are queued for webhooks and broadcast to clients streaming the item's events
+func setupEventPublisher(lc fx.Lifecycle, cfg *config.Config, webhooks events.WebhookStore, broadcaster *events.Broadcaster) events.Publisher {
+	if cfg.IsTest() {
+		return events.Chain{events.NewDispatcher(), events.NewWebhookPublisher(webhooks), broadcaster}
+	}
+
+	publisher := events.NewKafkaPublisher(cfg)
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return publisher.Close()
+		},
+	})
+
+	log.Info().
+		Strs("brokers", cfg.Kafka.Brokers).
+		Str("topic", cfg.Kafka.Topic).
+		Msg("Publishing domain events to Kafka")
+
+	return events.Chain{publisher, events.NewWebhookPublisher(webhooks), broadcaster}
+}
+
+// setupOutboxRelay creates the relay that publishes events stored in the outbox
+func setupOutboxRelay(cfg *config.Config, store events.OutboxStore, publisher events.Publisher) *events.OutboxRelay {
+	return events.NewOutboxRelay(store, publisher, events.OutboxOptions{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+	})
+}
+
+// runOutboxRelay polls the outbox for the lifetime of the application
+func runOutboxRelay(lc fx.Lifecycle, relay *events.OutboxRelay) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			relay.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			relay.Stop()
+			return nil
+		},
+	})
+}
+
+// setupWebhookWorker creates the worker that POSTs queued events to registered webhooks
+func setupWebhookWorker(cfg *config.Config, store events.WebhookStore) *events.WebhookWorker {
+	return events.NewWebhookWorker(store, events.WebhookOptions{
+		PollInterval:   cfg.Webhooks.PollInterval,
+		BatchSize:      cfg.Webhooks.BatchSize,
+		MaxAttempts:    cfg.Webhooks.MaxAttempts,
+		InitialBackoff: cfg.Webhooks.InitialBackoff,
+		MaxBackoff:     cfg.Webhooks.MaxBackoff,
+		Timeout:        cfg.Webhooks.Timeout,
+	})
+}
+
+// runWebhookWorker delivers webhooks for the lifetime of the application
+func runWebhookWorker(lc fx.Lifecycle, worker *events.WebhookWorker) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			worker.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			worker.Stop()
+			return nil
+		},
+	})
+}
+
+// setupItemUseCase creates the item use case with configured bulk behaviour
+func setupItemUseCase(
+	cfg *config.Config,
+	itemRepository item.Repository,
+	inventoryService usecase.InventoryService,
+	categoryService usecase.CategoryService,
+	pricingService usecase.PricingService,
+	eventPublisher events.Publisher,
+	blobStore storage.Blob,
+	converter usecase.CurrencyConverter,
+	discounts item.DiscountPolicy,
+	skus item.SKUPolicy,
+	rules item.BusinessRules,
+	seasonal item.SeasonalPolicy,
+	idempotency usecase.IdempotencyStore,
+	broadcaster *events.Broadcaster,
+) usecase.ItemUseCase {
+	itemUseCase := usecase.NewItemUseCase(itemRepository, inventoryService, categoryService, pricingService, eventPublisher,
+		usecase.WithBulkOptions(usecase.BulkOptions{AllOrNothing: cfg.Bulk.AllOrNothing}),
+		usecase.WithSearchOptions(usecase.SearchOptions{Substring: cfg.Search.Substring}),
+		usecase.WithUploadOptions(usecase.UploadOptions{Store: blobStore, MaxSize: cfg.Storage.MaxUploadSize}),
+		usecase.WithCurrencyConverter(converter),
+		usecase.WithDiscountPolicy(discounts),
+		usecase.WithSKUPolicy(skus),
+		usecase.WithBusinessRules(rules),
+		usecase.WithSeasonalPolicy(seasonal),
+		usecase.WithAutoCorrect(cfg.BusinessRules.AutoCorrect),
+		usecase.WithFeatureFlags(cfg),
+		usecase.WithIdempotency(idempotency, cfg.Idempotency.TTL),
+		usecase.WithEventSubscriber(broadcaster))
+	return usecase.NewTracingItemUseCase(itemUseCase)
+}
+
+// setupDocsHandler serves the OpenAPI document generated into docs/
+func setupDocsHandler() *handlers.DocsHandler {
+	return handlers.NewDocsHandler(docs.SwaggerJSON)
+}
+
+// setupItemHandler configures the item handler
+func setupItemHandler(cfg *config.Config, itemUseCase usecase.ItemUseCase) *handlers.ItemHandler {
+	return handlers.NewItemHandler(itemUseCase).WithBatchOptions(handlers.BatchOptions{
+		Concurrency: cfg.Batch.Concurrency,
+		Timeout:     cfg.Batch.Timeout,
+	})
+}
+
 // setupGinEngine configures the Gin engine
-func setupGinEngine(cfg *config.Config, itemHandler *handlers.ItemHandler) *gin.Engine {
+func setupGinEngine(
+	cfg *config.Config,
+	itemHandler *handlers.ItemHandler,
+	adminHandler *handlers.AdminHandler,
+	webhookHandler *handlers.WebhookHandler,
+	authHandler *handlers.AuthHandler,
+	healthHandler *handlers.HealthHandler,
+	metricsHandler *handlers.MetricsHandler,
+	docsHandler *handlers.DocsHandler,
+	tokenService *auth.TokenService,
+) *gin.Engine {
 	// Set Gin mode
 	if cfg.IsProduction() {
 		gin.SetMode(gin.ReleaseMode)
@@ -88,10 +382,29 @@ func setupGinEngine(cfg *config.Config, itemHandler *handlers.ItemHandler) *gin.
 	router := gin.New()
 
 	// Setup middlewares
-	routes.SetupMiddlewares(router)
+	middlewareOpts := routes.MiddlewareOptions{
+		MaxBodySize:    cfg.Server.MaxBodySize,
+		RequestTimeout: cfg.Server.RequestTimeout,
+	}
+	if cfg.RateLimit.Enabled {
+		middlewareOpts.RateLimitRPS = cfg.RateLimit.RPS
+		middlewareOpts.RateLimitBurst = cfg.RateLimit.Burst
+	}
+	if cfg.Compression.Enabled {
+		gzipConfig := middleware.DefaultGzipConfig()
+		gzipConfig.MinSize = cfg.Compression.MinSize
+		gzipConfig.Level = cfg.Compression.Level
+		middlewareOpts.Gzip = &gzipConfig
+	}
+	routes.SetupMiddlewares(router, middlewareOpts)
+
+	// Serve uploaded images when they are stored on local disk
+	if cfg.Storage.Backend == "local" {
+		router.Static("/uploads", cfg.Storage.LocalDir)
+	}
 
 	// Setup routes
-	routes.SetupRoutes(router, itemHandler)
+	routes.SetupRoutes(router, itemHandler, adminHandler, webhookHandler, authHandler, healthHandler, metricsHandler, docsHandler, tokenService)
 
 	return router
 }
@@ -107,6 +420,32 @@ func setupServer(cfg *config.Config, router *gin.Engine) *http.Server {
 	}
 }
 
+// setupGRPCServer creates the gRPC server on the same item use case as the HTTP API
+// It returns nil when gRPC is disabled
+func setupGRPCServer(cfg *config.Config, itemUseCase usecase.ItemUseCase, tokenService *auth.TokenService) *grpc.Server {
+	if !cfg.GRPC.Enabled {
+		return nil
+	}
+	return grpcserver.NewServer(grpcserver.NewItemServer(itemUseCase), tokenService)
+}
+
+// runMigrations applies pending migrations from the configured path on startup
+func runMigrations(lc fx.Lifecycle, cfg *config.Config, db *database.DB) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			version, err := db.Migrate(ctx, cfg.Database.MigrationsPath)
+			if err != nil {
+				return err
+			}
+
+			log.Info().
+				Uint("schema_version", version).
+				Msg("Database schema is up to date")
+			return nil
+		},
+	})
+}
+
 // runServer starts the HTTP server with graceful shutdown
 func runServer(lc fx.Lifecycle, cfg *config.Config, server *http.Server, db *database.DB) {
 	lc.Append(fx.Hook{
@@ -158,6 +497,49 @@ func runServer(lc fx.Lifecycle, cfg *config.Config, server *http.Server, db *dat
 	}()
 }
 
+// runGRPCServer serves gRPC alongside the HTTP server and stops it gracefully
+// It stops before runServer's hook closes the database
+func runGRPCServer(lc fx.Lifecycle, cfg *config.Config, server *grpc.Server) {
+	if server == nil {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			listener, err := net.Listen("tcp", cfg.GetGRPCAddress())
+			if err != nil {
+				return fmt.Errorf("failed to listen for gRPC: %w", err)
+			}
+
+			log.Info().
+				Str("address", cfg.GetGRPCAddress()).
+				Msg("Starting gRPC server")
+			go func() {
+				if err := server.Serve(listener); err != nil {
+					log.Error().Err(err).Msg("gRPC server stopped")
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info().Msg("Shutting down gRPC server")
+
+			// Let in-flight calls finish, cutting them off if the stop deadline passes
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				server.Stop()
+			}
+			return nil
+		},
+	})
+}
+
 // Mock service implementations for dependency injection (part of intentional flaws)
 type mockInventoryService struct{}
 
@@ -0,0 +1,12 @@
+// Package docs holds the OpenAPI document generated from the handler annotations
+// Run `make swagger` after changing an annotation or a route and commit swagger.json
+package docs
+
+import _ "embed"
+
+//go:generate go run github.com/swaggo/swag/cmd/swag@v1.16.3 init --dir .. --generalInfo cmd/api/main.go --output . --outputTypes json --parseInternal
+
+// SwaggerJSON is the Swagger 2.0 document served at /swagger.json
+//
+//go:embed swagger.json
+var SwaggerJSON []byte
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Attributes holds an item's free-form attributes
+// encoding/xml has no map support, so in XML each one is written as
+// <attribute key="...">value</attribute>, sorted by key
+type Attributes map[string]interface{}
+
+// xmlAttribute is one attribute as it appears in XML
+type xmlAttribute struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// MarshalXML writes scalars as text and nested lists or objects as their JSON
+func (a Attributes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attributes := make([]xmlAttribute, len(keys))
+	for i, key := range keys {
+		value, err := attributeText(a[key])
+		if err != nil {
+			return fmt.Errorf("attribute %q: %w", key, err)
+		}
+		attributes[i] = xmlAttribute{Key: key, Value: value}
+	}
+
+	return e.EncodeElement(struct {
+		Attributes []xmlAttribute `xml:"attribute"`
+	}{attributes}, start)
+}
+
+// UnmarshalXML reads attributes back; every value comes back as a string
+func (a *Attributes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var decoded struct {
+		Attributes []xmlAttribute `xml:"attribute"`
+	}
+	if err := d.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+
+	*a = make(Attributes, len(decoded.Attributes))
+	for _, attribute := range decoded.Attributes {
+		(*a)[attribute.Key] = attribute.Value
+	}
+	return nil
+}
+
+// attributeText formats one attribute value for XML
+func attributeText(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
@@ -0,0 +1,30 @@
+package dto
+
+// Batch item statuses
+const (
+	BatchItemProcessed = "processed"
+	BatchItemFailed    = "failed"
+	BatchItemCancelled = "cancelled"
+)
+
+// BatchProcessRequest represents a request to process several items
+type BatchProcessRequest struct {
+	ItemIDs []string `json:"item_ids" validate:"required,min=1,max=100"`
+}
+
+// BatchItemResult represents the outcome for a single item in a batch
+type BatchItemResult struct {
+	ItemID string `json:"item_id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BatchProcessResponse represents the outcome of a batch run
+// Items not reached before cancellation are reported as cancelled
+type BatchProcessResponse struct {
+	Results   []BatchItemResult `json:"results"`
+	Total     int               `json:"total"`
+	Processed int               `json:"processed"`
+	Failed    int               `json:"failed"`
+	Cancelled int               `json:"cancelled"`
+}
@@ -0,0 +1,109 @@
+package dto
+
+// Bulk item statuses
+const (
+	BulkItemCreated    = "created"
+	BulkItemUpdated    = "updated"
+	BulkItemFailed     = "failed"
+	BulkItemRolledBack = "rolled_back"
+)
+
+// BulkCreateRequest represents a request to create many items at once
+type BulkCreateRequest struct {
+	Items []*CreateItemRequest `json:"items" validate:"required,min=1,max=500,dive,required"`
+}
+
+// BulkCreateItemResult represents the outcome for a single item in a bulk create
+type BulkCreateItemResult struct {
+	Index  int           `json:"index"`
+	SKU    string        `json:"sku"`
+	Status string        `json:"status"`
+	Item   *ItemResponse `json:"item,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// BulkCreateResult represents the outcome of a bulk create
+// In all-or-nothing mode a single failure rolls back every item, reported as rolled_back
+type BulkCreateResult struct {
+	Results      []BulkCreateItemResult `json:"results"`
+	Total        int                    `json:"total"`
+	Created      int                    `json:"created"`
+	Failed       int                    `json:"failed"`
+	RolledBack   int                    `json:"rolled_back"`
+	AllOrNothing bool                   `json:"all_or_nothing"`
+}
+
+// BulkDeleteRequest represents a request to delete many items at once
+type BulkDeleteRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=500,dive,required"`
+	// Force deletes active items that still have stock, as force does for a single delete
+	Force bool `json:"force"`
+}
+
+// BulkDeleteResult reports what a bulk delete did with each distinct requested ID
+// Malformed IDs cannot name an item and are counted as not found
+type BulkDeleteResult struct {
+	Total       int      `json:"total"`
+	Deleted     int      `json:"deleted"`
+	NotFound    int      `json:"not_found"`
+	InUse       int      `json:"in_use"`
+	NotFoundIDs []string `json:"not_found_ids,omitempty"`
+	InUseIDs    []string `json:"in_use_ids,omitempty"`
+}
+
+// InventoryUpdate sets the stock quantity of the item with the given SKU
+type InventoryUpdate struct {
+	SKU      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+// BulkInventoryUpdateRequest represents a request to set the stock of many items at once
+// The request body is the bare JSON array of updates
+type BulkInventoryUpdateRequest struct {
+	Items []InventoryUpdate `validate:"required,min=1,max=500"`
+}
+
+// BulkInventoryItemResult represents the outcome for a single SKU in a bulk inventory update
+type BulkInventoryItemResult struct {
+	Index  int           `json:"index"`
+	SKU    string        `json:"sku"`
+	Status string        `json:"status"`
+	Item   *ItemResponse `json:"item,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// BulkInventoryResult represents the outcome of a bulk inventory update
+type BulkInventoryResult struct {
+	Results []BulkInventoryItemResult `json:"results"`
+	Total   int                       `json:"total"`
+	Updated int                       `json:"updated"`
+	Failed  int                       `json:"failed"`
+}
+
+// ExportRequest selects the items to export and the output format
+type ExportRequest struct {
+	Format   string `json:"format" validate:"oneof=csv json"`
+	Category string `json:"category,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+// ImportRowResult represents the outcome for one CSV row
+// Row is the line number in the file, so the header is line 1
+type ImportRowResult struct {
+	Row    int           `json:"row"`
+	SKU    string        `json:"sku,omitempty"`
+	Status string        `json:"status"`
+	Item   *ItemResponse `json:"item,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// ImportResult summarizes a CSV import
+// In strict mode a single bad row rolls back every row, reported as rolled_back
+type ImportResult struct {
+	Rows       []ImportRowResult `json:"rows"`
+	Total      int               `json:"total"`
+	Created    int               `json:"created"`
+	Failed     int               `json:"failed"`
+	RolledBack int               `json:"rolled_back"`
+	Strict     bool              `json:"strict"`
+}
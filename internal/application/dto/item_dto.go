@@ -1,27 +1,40 @@
 package dto
 
-import "time"
+import (
+	"io"
+	"time"
+)
 
 // CreateItemRequest represents the request to create a new item
 type CreateItemRequest struct {
-	SKU         string            `json:"sku" validate:"required,min=3,max=20"`
-	Name        string            `json:"name" validate:"required,min=1,max=255"`
-	Description string            `json:"description" validate:"max=1000"`
-	Price       float64           `json:"price" validate:"required,min=0"`
-	Currency    string            `json:"currency" validate:"len=3"`
-	Category    string            `json:"category" validate:"required,min=1,max=100"`
-	Inventory   int               `json:"inventory" validate:"min=0"`
-	Attributes  map[string]string `json:"attributes,omitempty"`
+	SKU         string                 `json:"sku" validate:"omitempty,max=64"`
+	Barcode     string                 `json:"barcode,omitempty" validate:"omitempty,max=20"`
+	Brand       string                 `json:"brand,omitempty" validate:"omitempty,max=100"`
+	Name        string                 `json:"name" validate:"required,min=1,max=255"`
+	Description string                 `json:"description" validate:"max=1000"`
+	Price       float64                `json:"price" validate:"required,min=0"`
+	Currency    string                 `json:"currency" validate:"len=3"`
+	Category    string                 `json:"category" validate:"required,min=1,max=100"`
+	Inventory   int                    `json:"inventory" validate:"min=0"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	Dimensions  *Dimensions            `json:"dimensions,omitempty"`
+	Weight      *Weight                `json:"weight,omitempty"`
+	// AutoCorrect overrides whether the item is corrected to the business rules instead of
+	// kept as sent; leaving it out uses the configured default
+	AutoCorrect *bool                  `json:"auto_correct,omitempty"`
+
+	// IdempotencyKey comes from the Idempotency-Key header, not the body
+	IdempotencyKey string `json:"-"`
 }
 
 // UpdateItemRequest represents the request to update an item
 type UpdateItemRequest struct {
-	Name        *string           `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
-	Description *string           `json:"description,omitempty" validate:"omitempty,max=1000"`
-	Price       *float64          `json:"price,omitempty" validate:"omitempty,min=0"`
-	Currency    *string           `json:"currency,omitempty" validate:"omitempty,len=3"`
-	Category    *string           `json:"category,omitempty" validate:"omitempty,min=1,max=100"`
-	Attributes  map[string]string `json:"attributes,omitempty"`
+	Name        *string                `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Price       *float64               `json:"price,omitempty" validate:"omitempty,min=0"`
+	Currency    *string                `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Category    *string                `json:"category,omitempty" validate:"omitempty,min=1,max=100"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // UpdateInventoryRequest represents the request to update item inventory
@@ -29,6 +42,11 @@ type UpdateInventoryRequest struct {
 	Quantity int `json:"quantity" validate:"min=0"`
 }
 
+// InventoryReservationRequest represents a request to reserve or release stock
+type InventoryReservationRequest struct {
+	Quantity int `json:"quantity" validate:"required,min=1"`
+}
+
 // AddImageRequest represents the request to add an image to an item
 type AddImageRequest struct {
 	URL       string `json:"url" validate:"required,url"`
@@ -36,58 +54,205 @@ type AddImageRequest struct {
 	IsPrimary bool   `json:"is_primary"`
 }
 
+// UploadImageRequest represents an uploaded image file to attach to an item
+// Content is read once by the use case; Size is the length the client declared
+type UploadImageRequest struct {
+	Filename  string    `form:"-"`
+	Size      int64     `form:"-"`
+	Content   io.Reader `form:"-"`
+	Alt       string    `form:"alt" validate:"max=255"`
+	IsPrimary bool      `form:"is_primary"`
+}
+
+// ReplaceImagesRequest represents the full image set an item should have afterwards
+type ReplaceImagesRequest struct {
+	Images []AddImageRequest `json:"images" validate:"required,max=50,dive"`
+}
+
+// ReorderImagesRequest represents the request to reorder an item's images
+type ReorderImagesRequest struct {
+	URLs []string `json:"urls" validate:"required,min=1,dive,required"`
+}
+
+// StatusChangeResponse reports an item's status after an activate or deactivate request
+// Changed is false when the item was already in that status and nothing was written
+type StatusChangeResponse struct {
+	ID      string `json:"id" xml:"id"`
+	Status  string `json:"status" xml:"status"`
+	Changed bool   `json:"changed" xml:"changed"`
+}
+
 // ItemResponse represents the response for item queries
 type ItemResponse struct {
-	ID          string            `json:"id"`
-	SKU         string            `json:"sku"`
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	Price       float64           `json:"price"`
-	Currency    string            `json:"currency"`
-	Category    CategoryResponse  `json:"category"`
-	Inventory   InventoryResponse `json:"inventory"`
-	Images      []ImageResponse   `json:"images"`
-	Attributes  map[string]string `json:"attributes"`
-	Status      string            `json:"status"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
+	ID          string                 `json:"id" xml:"id"`
+	SKU         string                 `json:"sku" xml:"sku"`
+	Barcode     string                 `json:"barcode,omitempty" xml:"barcode,omitempty"`
+	Name        string                 `json:"name" xml:"name"`
+	Description string                 `json:"description" xml:"description"`
+	Price       float64                `json:"price" xml:"price"`
+	Currency    string                 `json:"currency" xml:"currency"`
+	Category    CategoryResponse       `json:"category" xml:"category"`
+	Brand       *BrandResponse         `json:"brand,omitempty" xml:"brand,omitempty"`
+	Inventory   InventoryResponse      `json:"inventory" xml:"inventory"`
+	Images      []ImageResponse        `json:"images" xml:"images>image"`
+	Attributes  Attributes             `json:"attributes" xml:"attributes"`
+	Dimensions  *Dimensions            `json:"dimensions,omitempty" xml:"dimensions,omitempty"`
+	Weight      *Weight                `json:"weight,omitempty" xml:"weight,omitempty"`
+	Status      string                 `json:"status" xml:"status"`
+	CreatedAt   time.Time              `json:"created_at" xml:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at" xml:"updated_at"`
+	// Warnings describe each correction made to the item when it was created
+	Warnings    []string               `json:"warnings,omitempty" xml:"warnings>warning,omitempty"`
+}
+
+// Dimensions represents the package size of an item
+type Dimensions struct {
+	Length float64 `json:"length" xml:"length"`
+	Width  float64 `json:"width" xml:"width"`
+	Height float64 `json:"height" xml:"height"`
+	Unit   string  `json:"unit" xml:"unit" validate:"required"`
+}
+
+// Weight represents how heavy an item is
+type Weight struct {
+	Value float64 `json:"value" xml:"value"`
+	Unit  string  `json:"unit" xml:"unit" validate:"required"`
+}
+
+// ItemWithStatsResponse represents an item with its view count and rating
+// AverageRating is 0 when the item has not been rated
+type ItemWithStatsResponse struct {
+	ItemResponse
+	ViewCount     int     `json:"view_count"`
+	AverageRating float64 `json:"average_rating"`
+	RatingCount   int     `json:"rating_count"`
+}
+
+// PopularItemResponse represents an item with the number of times it was viewed since a point in time
+type PopularItemResponse struct {
+	ItemResponse
+	ViewCount int `json:"view_count"`
+}
+
+// PopularItemsResponse lists the items viewed most often since Since, most viewed first
+type PopularItemsResponse struct {
+	Items []PopularItemResponse `json:"items"`
+	Since time.Time             `json:"since"`
 }
 
 // CategoryResponse represents category information in responses
 type CategoryResponse struct {
-	Name string `json:"name"`
-	Slug string `json:"slug"`
+	Name string `json:"name" xml:"name"`
+	Slug string `json:"slug" xml:"slug"`
+}
+
+// BrandResponse represents brand information in responses
+type BrandResponse struct {
+	Name string `json:"name" xml:"name"`
+	Slug string `json:"slug" xml:"slug"`
 }
 
 // InventoryResponse represents inventory information in responses
 type InventoryResponse struct {
-	Quantity    int  `json:"quantity"`
-	IsAvailable bool `json:"is_available"`
+	Quantity    int  `json:"quantity" xml:"quantity"`
+	Reserved    int  `json:"reserved" xml:"reserved"`
+	Available   int  `json:"available" xml:"available"`
+	IsAvailable bool `json:"is_available" xml:"is_available"`
+}
+
+// ItemAvailabilityResponse reports whether a quantity of an item can be reserved
+// OnHand counts every unit in stock, reserved or not; AvailableQuantity counts the units not already reserved
+type ItemAvailabilityResponse struct {
+	Available         bool `json:"available"`
+	OnHand            int  `json:"on_hand"`
+	AvailableQuantity int  `json:"available_quantity"`
 }
 
 // ImageResponse represents image information in responses
 type ImageResponse struct {
-	URL       string `json:"url"`
-	Alt       string `json:"alt"`
-	IsPrimary bool   `json:"is_primary"`
+	URL       string `json:"url" xml:"url"`
+	Alt       string `json:"alt" xml:"alt"`
+	IsPrimary bool   `json:"is_primary" xml:"is_primary"`
 }
 
 // ItemListResponse represents paginated list of items
 type ItemListResponse struct {
-	Items      []ItemResponse `json:"items"`
-	Total      int            `json:"total"`
-	Page       int            `json:"page"`
-	PageSize   int            `json:"page_size"`
-	TotalPages int            `json:"total_pages"`
+	Items      []ItemResponse `json:"items" xml:"items>item"`
+	Total      int            `json:"total" xml:"total"`
+	Page       int            `json:"page" xml:"page"`
+	PageSize   int            `json:"page_size" xml:"page_size"`
+	TotalPages int            `json:"total_pages" xml:"total_pages"`
+}
+
+// ItemStatsResponse represents item counts per status and for the largest categories
+type ItemStatsResponse struct {
+	Total         int                     `json:"total"`
+	ByStatus      map[string]int          `json:"by_status"`
+	TopCategories []CategoryCountResponse `json:"top_categories"`
+}
+
+// CategoryCountResponse represents the number of items in one category
+type CategoryCountResponse struct {
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Count int    `json:"count"`
 }
 
 // SearchRequest represents search parameters
 type SearchRequest struct {
-	Query    string `json:"query,omitempty"`
-	Category string `json:"category,omitempty"`
-	Status   string `json:"status,omitempty"`
+	// Query is matched with ILIKE, so its length is capped to keep scans bounded
+	Query    string `json:"query,omitempty" validate:"omitempty,max=200"`
+	Category string `json:"category,omitempty" validate:"omitempty,max=100"`
+	Status   string `json:"status,omitempty" validate:"omitempty,oneof=active inactive draft archived"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+
+	// Price range filter; either bound may be omitted
+	MinPrice *float64 `json:"min_price,omitempty" validate:"omitempty,min=0"`
+	MaxPrice *float64 `json:"max_price,omitempty" validate:"omitempty,min=0"`
+	Currency string   `json:"currency,omitempty" validate:"omitempty,len=3"`
+
+	// Attributes filters on exact attribute values; all pairs must match
+	Attributes map[string]string `json:"attributes,omitempty" validate:"omitempty,max=10,dive,keys,required,max=50,endkeys,max=255"`
+
+	// Numeric attribute ranges keyed by attribute; either bound may be omitted
+	AttributeMin map[string]float64 `json:"attribute_min,omitempty" validate:"omitempty,max=10,dive,keys,required,max=50,endkeys"`
+	AttributeMax map[string]float64 `json:"attribute_max,omitempty" validate:"omitempty,max=10,dive,keys,required,max=50,endkeys"`
+
+	// Sorting; defaults to created_at desc
+	SortBy    string `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at updated_at price name"`
+	SortOrder string `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
+}
+
+// HasPriceRange reports whether either price bound is set
+func (r *SearchRequest) HasPriceRange() bool {
+	return r.MinPrice != nil || r.MaxPrice != nil
+}
+
+// HasAttributeRange reports whether any numeric attribute bound is set
+func (r *SearchRequest) HasAttributeRange() bool {
+	return len(r.AttributeMin) > 0 || len(r.AttributeMax) > 0
+}
+
+// HasFilter reports whether the search is narrowed by a query or any filter
+func (r *SearchRequest) HasFilter() bool {
+	return r.Query != "" || r.Category != "" || r.Status != "" || len(r.Attributes) > 0 ||
+		r.HasPriceRange() || r.HasAttributeRange()
+}
+
+// ListItemsRequest represents the filters and paging for listing items
+// Every filter is optional; the ones given must all match
+type ListItemsRequest struct {
+	Category string `json:"category,omitempty" validate:"omitempty,max=100"`
+	Status   string `json:"status,omitempty" validate:"omitempty,oneof=active inactive draft archived"`
+	// Brand may be the brand's name or slug
+	Brand    string `json:"brand,omitempty" validate:"omitempty,max=100"`
 	Page     int    `json:"page" validate:"min=1"`
 	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+
+	// Sorting; defaults to created_at desc
+	SortBy    string `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at updated_at price name"`
+	SortOrder string `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
 }
 
 // ItemSummaryResponse represents a lightweight item response for lists
@@ -100,4 +265,18 @@ type ItemSummaryResponse struct {
 	Category string  `json:"category"`
 	Status   string  `json:"status"`
 	InStock  bool    `json:"in_stock"`
+}
+
+// ItemSummaryListResponse is a page of item summaries
+type ItemSummaryListResponse struct {
+	Items      []ItemSummaryResponse `json:"items"`
+	Total      int                   `json:"total"`
+	Page       int                   `json:"page"`
+	PageSize   int                   `json:"page_size"`
+	TotalPages int                   `json:"total_pages"`
+}
+
+// ItemSuggestionsResponse lists autocomplete matches for a name prefix, closest first
+type ItemSuggestionsResponse struct {
+	Items []ItemSummaryResponse `json:"items"`
 } 
\ No newline at end of file
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+// ItemEventResponse is one domain event streamed to clients watching an item
+type ItemEventResponse struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	ItemID     string      `json:"item_id"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
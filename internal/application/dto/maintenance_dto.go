@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+// MaintenanceRequest represents a request to run a maintenance action
+type MaintenanceRequest struct {
+	Action string `json:"action" validate:"required"`
+}
+
+// MaintenanceResponse represents the outcome of a maintenance action
+type MaintenanceResponse struct {
+	Action      string    `json:"action"`
+	Status      string    `json:"status"`
+	CompletedAt time.Time `json:"completed_at"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// PriceChangeResponse represents one change of an item's price
+type PriceChangeResponse struct {
+	OldPrice    float64   `json:"old_price"`
+	OldCurrency string    `json:"old_currency"`
+	NewPrice    float64   `json:"new_price"`
+	NewCurrency string    `json:"new_currency"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
+
+// PriceHistoryResponse represents a paginated price history, oldest change first
+type PriceHistoryResponse struct {
+	ItemID     string                `json:"item_id"`
+	Changes    []PriceChangeResponse `json:"changes"`
+	Total      int                   `json:"total"`
+	Page       int                   `json:"page"`
+	PageSize   int                   `json:"page_size"`
+	TotalPages int                   `json:"total_pages"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// RegisterWebhookRequest represents a request to register a callback URL for item events
+// A secret is generated when none is given
+type RegisterWebhookRequest struct {
+	URL        string   `json:"url" validate:"required,url,max=2048"`
+	EventTypes []string `json:"event_types" validate:"required,min=1,dive,oneof=ItemCreated ItemPriceChanged ItemInventoryUpdated ItemStatusChanged ItemCategoryChanged ItemDeleted"`
+	Secret     string   `json:"secret,omitempty" validate:"omitempty,min=16,max=255"`
+}
+
+// WebhookResponse represents a registered webhook
+// Secret is only ever returned when the webhook is registered
+type WebhookResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"secret"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"item-pdp-service/internal/application/http/handlers"
+	"item-pdp-service/internal/application/http/middleware"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain names this service in ErrorInfo details
+const errorDomain = "item-pdp-service"
+
+// statusError maps a use case error to a gRPC status the way the REST API maps it to an HTTP one
+// The stable error code from the REST body travels as the ErrorInfo reason
+func statusError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	apiErr := handlers.MapError(err, fallback)
+	if len(apiErr.Errors) > 0 {
+		return validationError(apiErr.Errors)
+	}
+	return withReason(status.New(grpcCode(apiErr), apiErr.Message), apiErr.Code).Err()
+}
+
+// validationError reports the fields that failed validation as BadRequest details
+func validationError(fields []middleware.ValidationError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(fields))
+	for i, field := range fields {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: field.Field, Description: field.Message}
+	}
+
+	st := withReason(status.New(codes.InvalidArgument, "Validation failed"), middleware.CodeValidationFailed)
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// withReason attaches code as ErrorInfo, keeping st as it is if the detail cannot be added
+func withReason(st *status.Status, code string) *status.Status {
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: code, Domain: errorDomain})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// grpcCode picks the gRPC code closest to the HTTP status of apiErr
+func grpcCode(apiErr *middleware.APIError) codes.Code {
+	switch apiErr.Status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		if apiErr.Code == middleware.CodeDuplicateSKU {
+			return codes.AlreadyExists
+		}
+		if apiErr.Code == middleware.CodeIdempotencyKeyInProgress {
+			return codes.Aborted
+		}
+		return codes.FailedPrecondition
+	case http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
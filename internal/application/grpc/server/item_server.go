@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/grpc/itempb"
+	"item-pdp-service/internal/application/http/handlers"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+	domainitem "item-pdp-service/internal/domain/item"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ItemServer serves itempb.ItemService from the same use case as the REST item handler
+type ItemServer struct {
+	itempb.UnimplementedItemServiceServer
+	itemUseCase usecase.ItemUseCase
+}
+
+// NewItemServer creates a new item gRPC server
+func NewItemServer(itemUseCase usecase.ItemUseCase) *ItemServer {
+	return &ItemServer{
+		itemUseCase: itemUseCase,
+	}
+}
+
+// CreateItem creates a new item
+func (s *ItemServer) CreateItem(ctx context.Context, req *itempb.CreateItemRequest) (*itempb.Item, error) {
+	create := toCreateItemRequest(req)
+	if fields := middleware.ValidateStruct(create); len(fields) > 0 {
+		return nil, validationError(fields)
+	}
+
+	create.IdempotencyKey = strings.TrimSpace(create.IdempotencyKey)
+	if len(create.IdempotencyKey) > handlers.MaxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency_key must be at most %d characters", handlers.MaxIdempotencyKeyLength)
+	}
+
+	item, err := s.itemUseCase.CreateItem(ctx, create)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create item")
+		return nil, statusError(err, "Failed to create item")
+	}
+
+	return toItem(item), nil
+}
+
+// GetItem returns an item, with its price converted when a currency is given
+func (s *ItemServer) GetItem(ctx context.Context, req *itempb.GetItemRequest) (*itempb.Item, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Item ID is required")
+	}
+
+	var (
+		item *dto.ItemResponse
+		err  error
+	)
+	if req.GetCurrency() != "" {
+		item, err = s.itemUseCase.GetItemInCurrency(ctx, req.GetId(), req.GetCurrency())
+	} else {
+		item, err = s.itemUseCase.GetItemByID(ctx, req.GetId())
+	}
+	if err != nil {
+		log.Error().Err(err).Str("item_id", req.GetId()).Msg("Failed to get item")
+		return nil, statusError(err, "Failed to get item")
+	}
+
+	return toItem(item), nil
+}
+
+// UpdateItem applies the fields set in the request to an existing item
+func (s *ItemServer) UpdateItem(ctx context.Context, req *itempb.UpdateItemRequest) (*itempb.Item, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Item ID is required")
+	}
+
+	update := toUpdateItemRequest(req)
+	if fields := middleware.ValidateStruct(update); len(fields) > 0 {
+		return nil, validationError(fields)
+	}
+
+	item, err := s.itemUseCase.UpdateItem(ctx, req.GetId(), update)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", req.GetId()).Msg("Failed to update item")
+		return nil, statusError(err, "Failed to update item")
+	}
+
+	return toItem(item), nil
+}
+
+// DeleteItem deletes an item; active items with stock need force
+func (s *ItemServer) DeleteItem(ctx context.Context, req *itempb.DeleteItemRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Item ID is required")
+	}
+
+	if err := s.itemUseCase.DeleteItem(ctx, req.GetId(), req.GetForce()); err != nil {
+		log.Error().Err(err).Str("item_id", req.GetId()).Msg("Failed to delete item")
+		if errors.Is(err, usecase.ErrItemInUse) {
+			return nil, withReason(status.New(codes.FailedPrecondition, err.Error()+"; deactivate it first or set force"), middleware.CodeItemInUse).Err()
+		}
+		return nil, statusError(err, "Failed to delete item")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SearchItems searches items by query and filters
+func (s *ItemServer) SearchItems(ctx context.Context, req *itempb.SearchItemsRequest) (*itempb.SearchItemsResponse, error) {
+	search := toSearchRequest(req)
+
+	fields := middleware.ValidateStruct(search)
+	if search.Category != "" {
+		if _, err := domainitem.NewCategory(search.Category); err != nil {
+			fields = append(fields, middleware.ValidationError{Field: "category", Message: err.Error(), Value: search.Category})
+		}
+	}
+	if !search.HasFilter() {
+		fields = append(fields, middleware.ValidationError{Field: "query", Message: "Give a search query or at least one filter"})
+	}
+	if len(fields) > 0 {
+		return nil, validationError(fields)
+	}
+
+	items, err := s.itemUseCase.SearchItems(ctx, search)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to search items")
+		return nil, statusError(err, "Failed to search items")
+	}
+
+	return toSearchItemsResponse(items), nil
+}
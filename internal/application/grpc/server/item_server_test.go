@@ -0,0 +1,270 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/grpc/itempb"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+	domainitem "item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// mockItemUseCase stubs the use case methods the gRPC server calls
+type mockItemUseCase struct {
+	usecase.ItemUseCase
+	mock.Mock
+}
+
+func (m *mockItemUseCase) CreateItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *mockItemUseCase) GetItemByID(ctx context.Context, id string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *mockItemUseCase) GetItemInCurrency(ctx context.Context, id, currency string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+// stubVerifier accepts a single token
+type stubVerifier struct{}
+
+func (stubVerifier) Verify(token string) (*auth.Claims, error) {
+	if token != "valid-token" {
+		return nil, errors.New("token is invalid")
+	}
+	return &auth.Claims{}, nil
+}
+
+// newTestClient serves the item service over an in-memory listener
+func newTestClient(t *testing.T, useCase usecase.ItemUseCase) itempb.ItemServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	srv := NewServer(NewItemServer(useCase), stubVerifier{})
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return itempb.NewItemServiceClient(conn)
+}
+
+func sampleItem() *dto.ItemResponse {
+	return &dto.ItemResponse{
+		ID:          "item-1",
+		SKU:         "ELEC-000001",
+		Name:        "Wireless Headphones",
+		Description: "Noise cancelling",
+		Price:       199.99,
+		Currency:    "USD",
+		Category:    dto.CategoryResponse{Name: "Electronics", Slug: "electronics"},
+		Inventory:   dto.InventoryResponse{Quantity: 10, Reserved: 2, Available: 8, IsAvailable: true},
+		Images:      []dto.ImageResponse{{URL: "https://cdn.example.com/1.jpg", Alt: "Front", IsPrimary: true}},
+		Attributes:  map[string]interface{}{"color": "black", "wireless": true},
+		Status:      "active",
+		CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestItemServer_GetItem(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+		useCase.On("GetItemByID", mock.Anything, "item-1").Return(sampleItem(), nil).Once()
+
+		item, err := client.GetItem(context.Background(), &itempb.GetItemRequest{Id: "item-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "item-1", item.GetId())
+		assert.Equal(t, "ELEC-000001", item.GetSku())
+		assert.Equal(t, 199.99, item.GetPrice())
+		assert.Equal(t, "electronics", item.GetCategory().GetSlug())
+		assert.Equal(t, int32(8), item.GetInventory().GetAvailable())
+		assert.True(t, item.GetImages()[0].GetIsPrimary())
+		assert.Equal(t, "black", item.GetAttributes().AsMap()["color"])
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), item.GetCreatedAt().AsTime())
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("converts the price when a currency is given", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+		converted := sampleItem()
+		converted.Price, converted.Currency = 184.99, "EUR"
+		useCase.On("GetItemInCurrency", mock.Anything, "item-1", "EUR").Return(converted, nil).Once()
+
+		item, err := client.GetItem(context.Background(), &itempb.GetItemRequest{Id: "item-1", Currency: "EUR"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", item.GetCurrency())
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+		useCase.On("GetItemByID", mock.Anything, "missing").Return(nil, domainitem.ErrItemNotFound).Once()
+
+		_, err := client.GetItem(context.Background(), &itempb.GetItemRequest{Id: "missing"})
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Equal(t, "Item not found", st.Message())
+		require.Len(t, st.Details(), 1)
+		assert.Equal(t, middleware.CodeItemNotFound, st.Details()[0].(*errdetails.ErrorInfo).GetReason())
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		client := newTestClient(t, &mockItemUseCase{})
+
+		_, err := client.GetItem(context.Background(), &itempb.GetItemRequest{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestItemServer_CreateItem(t *testing.T) {
+	valid := func() *itempb.CreateItemRequest {
+		return &itempb.CreateItemRequest{
+			Sku:            "ELEC-000001",
+			Name:           "Wireless Headphones",
+			Description:    "Noise cancelling",
+			Price:          199.99,
+			Currency:       "USD",
+			Category:       "Electronics",
+			Inventory:      10,
+			IdempotencyKey: " key-1 ",
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+
+		var created *dto.CreateItemRequest
+		useCase.On("CreateItem", mock.Anything, mock.AnythingOfType("*dto.CreateItemRequest")).
+			Run(func(args mock.Arguments) { created = args.Get(1).(*dto.CreateItemRequest) }).
+			Return(sampleItem(), nil).Once()
+
+		item, err := client.CreateItem(withToken("valid-token"), valid())
+
+		require.NoError(t, err)
+		assert.Equal(t, "item-1", item.GetId())
+		assert.Equal(t, "ELEC-000001", created.SKU)
+		assert.Equal(t, 199.99, created.Price)
+		assert.Equal(t, 10, created.Inventory)
+		assert.Equal(t, "key-1", created.IdempotencyKey)
+		assert.Nil(t, created.AutoCorrect)
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("requires a token", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+
+		_, err := client.CreateItem(context.Background(), valid())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = client.CreateItem(withToken("forged-token"), valid())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		useCase.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid request lists the fields", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+		req := valid()
+		req.Name = ""
+
+		_, err := client.CreateItem(withToken("valid-token"), req)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		var fields []string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.GetFieldViolations() {
+					fields = append(fields, violation.GetField())
+				}
+			}
+		}
+		assert.Equal(t, []string{"name"}, fields)
+		useCase.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything)
+	})
+
+	t.Run("duplicate sku", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+		useCase.On("CreateItem", mock.Anything, mock.Anything).
+			Return(nil, usecase.ErrDuplicateSKU).Once()
+
+		_, err := client.CreateItem(withToken("valid-token"), valid())
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("unsupported currency lists the field", func(t *testing.T) {
+		useCase := &mockItemUseCase{}
+		client := newTestClient(t, useCase)
+		useCase.On("CreateItem", mock.Anything, mock.Anything).
+			Return(nil, &usecase.FieldError{Field: "currency", Message: "Must be one of EUR, USD", Value: "XYZ", Err: usecase.ErrUnsupportedCurrency}).Once()
+
+		req := valid()
+		req.Currency = "XYZ"
+		_, err := client.CreateItem(withToken("valid-token"), req)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		var fields []string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.GetFieldViolations() {
+					fields = append(fields, violation.GetField())
+				}
+			}
+		}
+		assert.Equal(t, []string{"currency"}, fields)
+	})
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"strings"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/grpc/itempb"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toCreateItemRequest maps a CreateItem call onto the DTO the REST API binds
+func toCreateItemRequest(req *itempb.CreateItemRequest) *dto.CreateItemRequest {
+	create := &dto.CreateItemRequest{
+		SKU:            req.GetSku(),
+		Barcode:        req.GetBarcode(),
+		Brand:          req.GetBrand(),
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		Price:          req.GetPrice(),
+		Currency:       req.GetCurrency(),
+		Category:       req.GetCategory(),
+		Inventory:      int(req.GetInventory()),
+		Attributes:     req.GetAttributes().AsMap(),
+		AutoCorrect:    req.AutoCorrect,
+		IdempotencyKey: req.GetIdempotencyKey(),
+	}
+	if len(create.Attributes) == 0 {
+		create.Attributes = nil
+	}
+	if d := req.GetDimensions(); d != nil {
+		create.Dimensions = &dto.Dimensions{Length: d.GetLength(), Width: d.GetWidth(), Height: d.GetHeight(), Unit: d.GetUnit()}
+	}
+	if w := req.GetWeight(); w != nil {
+		create.Weight = &dto.Weight{Value: w.GetValue(), Unit: w.GetUnit()}
+	}
+	return create
+}
+
+// toUpdateItemRequest maps an UpdateItem call; unset optional fields stay nil so they are left unchanged
+func toUpdateItemRequest(req *itempb.UpdateItemRequest) *dto.UpdateItemRequest {
+	update := &dto.UpdateItemRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Currency:    req.Currency,
+		Category:    req.Category,
+	}
+	if attributes := req.GetAttributes().AsMap(); len(attributes) > 0 {
+		update.Attributes = attributes
+	}
+	return update
+}
+
+// toSearchRequest maps a SearchItems call, defaulting paging like the REST API
+func toSearchRequest(req *itempb.SearchItemsRequest) *dto.SearchRequest {
+	search := &dto.SearchRequest{
+		Query:     strings.TrimSpace(req.GetQuery()),
+		Category:  req.GetCategory(),
+		Status:    strings.ToLower(req.GetStatus()),
+		Page:      int(req.GetPage()),
+		PageSize:  int(req.GetPageSize()),
+		MinPrice:  req.MinPrice,
+		MaxPrice:  req.MaxPrice,
+		Currency:  req.GetCurrency(),
+		SortBy:    strings.ToLower(req.GetSortBy()),
+		SortOrder: strings.ToLower(req.GetSortOrder()),
+	}
+	if search.Page == 0 {
+		search.Page = 1
+	}
+	if search.PageSize == 0 {
+		search.PageSize = 10
+	}
+	if len(req.GetAttributes()) > 0 {
+		search.Attributes = req.GetAttributes()
+	}
+	if len(req.GetAttributeMin()) > 0 {
+		search.AttributeMin = req.GetAttributeMin()
+	}
+	if len(req.GetAttributeMax()) > 0 {
+		search.AttributeMax = req.GetAttributeMax()
+	}
+	return search
+}
+
+// toItem maps an item response onto its message
+// Attributes that structpb cannot represent are dropped rather than failing the call
+func toItem(item *dto.ItemResponse) *itempb.Item {
+	msg := &itempb.Item{
+		Id:          item.ID,
+		Sku:         item.SKU,
+		Barcode:     item.Barcode,
+		Name:        item.Name,
+		Description: item.Description,
+		Price:       item.Price,
+		Currency:    item.Currency,
+		Category:    &itempb.Category{Name: item.Category.Name, Slug: item.Category.Slug},
+		Inventory: &itempb.Inventory{
+			Quantity:    int32(item.Inventory.Quantity),
+			Reserved:    int32(item.Inventory.Reserved),
+			Available:   int32(item.Inventory.Available),
+			IsAvailable: item.Inventory.IsAvailable,
+		},
+		Status:    item.Status,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+		Warnings:  item.Warnings,
+	}
+	if item.Brand != nil {
+		msg.Brand = &itempb.Brand{Name: item.Brand.Name, Slug: item.Brand.Slug}
+	}
+	for _, image := range item.Images {
+		msg.Images = append(msg.Images, &itempb.Image{Url: image.URL, Alt: image.Alt, IsPrimary: image.IsPrimary})
+	}
+	if len(item.Attributes) > 0 {
+		if attributes, err := structpb.NewStruct(item.Attributes); err == nil {
+			msg.Attributes = attributes
+		}
+	}
+	if d := item.Dimensions; d != nil {
+		msg.Dimensions = &itempb.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Unit: d.Unit}
+	}
+	if w := item.Weight; w != nil {
+		msg.Weight = &itempb.Weight{Value: w.Value, Unit: w.Unit}
+	}
+	return msg
+}
+
+// toSearchItemsResponse maps a page of items onto its message
+func toSearchItemsResponse(list *dto.ItemListResponse) *itempb.SearchItemsResponse {
+	resp := &itempb.SearchItemsResponse{
+		Items:      make([]*itempb.Item, len(list.Items)),
+		Total:      int32(list.Total),
+		Page:       int32(list.Page),
+		PageSize:   int32(list.PageSize),
+		TotalPages: int32(list.TotalPages),
+	}
+	for i := range list.Items {
+		resp.Items[i] = toItem(&list.Items[i])
+	}
+	return resp
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"item-pdp-service/internal/application/grpc/itempb"
+	"item-pdp-service/internal/application/http/middleware"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// protectedMethods need a bearer token, like the item mutations behind AuthRequired
+var protectedMethods = map[string]bool{
+	itempb.ItemService_CreateItem_FullMethodName: true,
+	itempb.ItemService_UpdateItem_FullMethodName: true,
+	itempb.ItemService_DeleteItem_FullMethodName: true,
+}
+
+// NewServer creates a gRPC server exposing the item service
+// Reflection is registered so tools such as grpcurl can list the service
+func NewServer(itemServer *ItemServer, verifier middleware.TokenVerifier, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.ChainUnaryInterceptor(AuthInterceptor(verifier)))
+
+	srv := grpc.NewServer(opts...)
+	itempb.RegisterItemServiceServer(srv, itemServer)
+	reflection.Register(srv)
+	return srv
+}
+
+// AuthInterceptor rejects calls to protected methods without a valid token in the authorization metadata
+func AuthInterceptor(verifier middleware.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !protectedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		var header string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				header = values[0]
+			}
+		}
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			return nil, withReason(status.New(codes.Unauthenticated, "Missing or malformed bearer token"), middleware.CodeUnauthorized).Err()
+		}
+
+		if _, err := verifier.Verify(strings.TrimSpace(token)); err != nil {
+			log.Warn().Err(err).Str("method", info.FullMethod).Msg("Rejected invalid token")
+			return nil, withReason(status.New(codes.Unauthenticated, "Invalid or expired token"), middleware.CodeUnauthorized).Err()
+		}
+
+		return handler(ctx, req)
+	}
+}
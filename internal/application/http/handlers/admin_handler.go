@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// AdminHandler handles HTTP requests for administrative operations
+type AdminHandler struct {
+	maintenanceUseCase usecase.MaintenanceUseCase
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(maintenanceUseCase usecase.MaintenanceUseCase) *AdminHandler {
+	return &AdminHandler{
+		maintenanceUseCase: maintenanceUseCase,
+	}
+}
+
+// RunMaintenance runs an allow-listed maintenance action
+// @Summary Run maintenance action
+// @Description Run one of the supported maintenance actions (vacuum_analyze, refresh_stats)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body dto.MaintenanceRequest true "Maintenance action"
+// @Success 200 {object} dto.MaintenanceResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/maintenance [post]
+func (h *AdminHandler) RunMaintenance(c *gin.Context) {
+	var req dto.MaintenanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	// Validate request
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	result, err := h.maintenanceUseCase.RunMaintenance(c.Request.Context(), req.Action)
+	if err != nil {
+		log.Error().Err(err).Str("action", req.Action).Msg("Failed to run maintenance action")
+		respondError(c, err, "Failed to run maintenance action")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
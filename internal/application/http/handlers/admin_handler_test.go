@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMaintenanceUseCase is a mock implementation of usecase.MaintenanceUseCase
+type MockMaintenanceUseCase struct {
+	mock.Mock
+}
+
+func (m *MockMaintenanceUseCase) RunMaintenance(ctx context.Context, action string) (*dto.MaintenanceResponse, error) {
+	args := m.Called(ctx, action)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.MaintenanceResponse), args.Error(1)
+}
+
+func TestAdminHandler_RunMaintenance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockMaintenanceUseCase)
+	handler := NewAdminHandler(mockUseCase)
+
+	t.Run("allowed action", func(t *testing.T) {
+		mockUseCase.On("RunMaintenance", mock.Anything, "vacuum_analyze").
+			Return(&dto.MaintenanceResponse{Action: "vacuum_analyze", Status: "completed"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("POST", "/admin/maintenance", bytes.NewBufferString(`{"action":"vacuum_analyze"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.RunMaintenance(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("disallowed action", func(t *testing.T) {
+		mockUseCase.On("RunMaintenance", mock.Anything, "cat /etc/passwd").
+			Return(nil, fmt.Errorf("%w: cat /etc/passwd", usecase.ErrUnknownMaintenanceAction)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("POST", "/admin/maintenance", bytes.NewBufferString(`{"action":"cat /etc/passwd"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.RunMaintenance(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("missing action", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("POST", "/admin/maintenance", bytes.NewBufferString(`{}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.RunMaintenance(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("use case failure", func(t *testing.T) {
+		mockUseCase.On("RunMaintenance", mock.Anything, "refresh_stats").
+			Return(nil, assert.AnError).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("POST", "/admin/maintenance", bytes.NewBufferString(`{"action":"refresh_stats"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.RunMaintenance(c)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// AuthHandler handles HTTP requests for authentication
+type AuthHandler struct {
+	tokenService *auth.TokenService
+}
+
+// NewAuthHandler creates a new auth handler
+func NewAuthHandler(tokenService *auth.TokenService) *AuthHandler {
+	return &AuthHandler{
+		tokenService: tokenService,
+	}
+}
+
+// GenerateToken issues a signed access token for API access
+// @Summary Generate access token
+// @Description Issue a signed, time-limited bearer token for API access
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.TokenResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/auth/token [post]
+func (h *AuthHandler) GenerateToken(c *gin.Context) {
+	token, err := h.tokenService.Issue()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to issue token")
+		middleware.WriteError(c, middleware.InternalError("Failed to generate token"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TokenResponse{
+		AccessToken: token.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(token.ExpiresIn.Seconds()),
+	})
+}
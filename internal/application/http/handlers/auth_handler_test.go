@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/infrastructure/auth"
+	"item-pdp-service/internal/infrastructure/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTokenService(t *testing.T) *auth.TokenService {
+	t.Helper()
+
+	tokenService, err := auth.NewTokenService(&config.Config{
+		Auth: config.AuthConfig{
+			Issuer:   "item-pdp-service",
+			TokenTTL: time.Hour,
+		},
+		Secrets: config.SecretsConfig{
+			JWTSecret: "test-secret",
+		},
+	})
+	require.NoError(t, err)
+	return tokenService
+}
+
+func TestAuthHandler_GenerateToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenService := newTestTokenService(t)
+	handler := NewAuthHandler(tokenService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("POST", "/auth/token", nil)
+
+	handler.GenerateToken(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.TokenResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Bearer", response.TokenType)
+	assert.Equal(t, 3600, response.ExpiresIn)
+
+	_, err := tokenService.Verify(response.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestAuthRequired_ProtectsItemMutations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenService := newTestTokenService(t)
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+
+	router := gin.New()
+	items := router.Group("/items")
+	items.GET("/:id", handler.GetItem)
+	items.POST("", middleware.AuthRequired(tokenService), handler.CreateItem)
+
+	createBody, _ := json.Marshal(&dto.CreateItemRequest{
+		SKU:      "TEST-001",
+		Name:     "Test Item",
+		Price:    99.99,
+		Currency: "USD",
+		Category: "Electronics",
+	})
+
+	newCreateRequest := func(authorization string) *http.Request {
+		req := httptest.NewRequest("POST", "/items", bytes.NewBuffer(createBody))
+		req.Header.Set("Content-Type", "application/json")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return req
+	}
+
+	t.Run("missing token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newCreateRequest(""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.NotEmpty(t, response.Error)
+		mockUseCase.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything)
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newCreateRequest("Basic dXNlcjpwYXNz"))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newCreateRequest("Bearer not-a-jwt"))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		mockUseCase.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything)
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		issued, err := tokenService.Issue()
+		require.NoError(t, err)
+
+		mockUseCase.On("CreateItem", mock.Anything, mock.AnythingOfType("*dto.CreateItemRequest")).
+			Return(&dto.ItemResponse{SKU: "TEST-001", Name: "Test Item"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newCreateRequest("Bearer "+issued.AccessToken))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("reads stay public", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+		mockUseCase.On("GetItemByID", mock.Anything, itemID).
+			Return(&dto.ItemResponse{ID: itemID}, nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestAuthRequired_SetsCallerID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenService := newTestTokenService(t)
+	issued, err := tokenService.Issue()
+	require.NoError(t, err)
+
+	var callerID string
+	router := gin.New()
+	router.POST("/whoami", middleware.AuthRequired(tokenService), func(c *gin.Context) {
+		callerID = middleware.CallerID(c)
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/whoami", nil)
+	req.Header.Set("Authorization", "Bearer "+issued.AccessToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, issued.SessionID, callerID)
+}
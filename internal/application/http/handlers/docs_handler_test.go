@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"item-pdp-service/docs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocsHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewDocsHandler(docs.SwaggerJSON)
+	router := gin.New()
+	router.GET("/swagger.json", handler.Spec)
+	router.GET("/swagger", handler.UI)
+
+	t.Run("spec documents item operations", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger.json", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+		var spec struct {
+			Swagger string                                `json:"swagger"`
+			Paths   map[string]map[string]json.RawMessage `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+		assert.Equal(t, "2.0", spec.Swagger)
+		assert.Contains(t, spec.Paths["/api/v1/items"], "post")
+		assert.Contains(t, spec.Paths["/api/v1/items/{id}"], "get")
+	})
+
+	t.Run("protected operations require a token", func(t *testing.T) {
+		var spec struct {
+			Paths map[string]map[string]struct {
+				Security []map[string][]string `json:"security"`
+			} `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal(docs.SwaggerJSON, &spec))
+
+		assert.NotEmpty(t, spec.Paths["/api/v1/items"]["post"].Security)
+		assert.Empty(t, spec.Paths["/api/v1/items/{id}"]["get"].Security)
+	})
+
+	t.Run("ui loads the spec", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `url: "/swagger.json"`)
+	})
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+	domainitem "item-pdp-service/internal/domain/item"
+
+	"github.com/gin-gonic/gin"
+)
+
+// badRequestErrors are use case errors caused by what the client sent
+// A non-empty message replaces the error text in the response
+var badRequestErrors = []struct {
+	err     error
+	message string
+}{
+	{err: usecase.ErrUnsupportedCurrency},
+	{err: usecase.ErrInvalidAttribute},
+	{err: usecase.ErrInvalidMeasurement},
+	{err: usecase.ErrInvalidBarcode},
+	{err: usecase.ErrInvalidBrand},
+	{err: usecase.ErrInvalidUpdate},
+	{err: usecase.ErrInvalidItem},
+	{err: usecase.ErrInvalidImport},
+	{err: usecase.ErrInvalidFilter},
+	{err: usecase.ErrInvalidPriceRange, message: "min_price must not exceed max_price and neither may be negative"},
+	{err: usecase.ErrInvalidSort, message: "sort_by must be one of created_at, updated_at, price, name and sort_order one of asc, desc"},
+	{err: usecase.ErrInvalidUpload, message: "Image must be a JPEG, PNG or WebP file within the size limit"},
+	{err: usecase.ErrInvalidImageIndex},
+	{err: usecase.ErrInvalidImageOrder, message: "Image order must list every image URL exactly once"},
+	{err: usecase.ErrInvalidImages},
+	{err: usecase.ErrInvalidWebhook},
+	{err: usecase.ErrUnknownMaintenanceAction, message: "Unsupported maintenance action"},
+}
+
+// apiError maps a use case error to the status and code reported to the client
+// Errors it does not recognise become a 500 with fallback as the message, so internals are not exposed
+func apiError(err error, fallback string) *middleware.APIError {
+	var apiErr *middleware.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var fieldErr *usecase.FieldError
+	if errors.As(err, &fieldErr) {
+		return middleware.ValidationFailed(middleware.ValidationError{
+			Field:   fieldErr.Field,
+			Message: fieldErr.Message,
+			Value:   fieldErr.Value,
+		})
+	}
+
+	for _, badRequest := range badRequestErrors {
+		if !errors.Is(err, badRequest.err) {
+			continue
+		}
+		if badRequest.message != "" {
+			return middleware.BadRequest(badRequest.message)
+		}
+		return middleware.BadRequest(err.Error())
+	}
+
+	var transitionErr *domainitem.StatusTransitionError
+	switch {
+	case errors.As(err, &transitionErr):
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeInvalidStatusTransition, transitionErr.Error())
+	case errors.Is(err, usecase.ErrDuplicateSKU):
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeDuplicateSKU, err.Error())
+	case errors.Is(err, usecase.ErrItemInUse):
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeItemInUse, err.Error())
+	case errors.Is(err, usecase.ErrItemArchived):
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeItemArchived, err.Error())
+	case errors.Is(err, usecase.ErrInventoryConflict):
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeInventoryConflict, err.Error())
+	case errors.Is(err, usecase.ErrIdempotencyKeyInProgress):
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeIdempotencyKeyInProgress, err.Error())
+	case errors.Is(err, usecase.ErrIdempotencyKeyReused):
+		return middleware.NewAPIError(http.StatusUnprocessableEntity, middleware.CodeIdempotencyKeyReused, err.Error())
+	case errors.Is(err, usecase.ErrImageNotFound), errors.Is(err, domainitem.ErrImageNotFound):
+		return middleware.NewAPIError(http.StatusNotFound, middleware.CodeImageNotFound, "Image not found")
+	case errors.Is(err, domainitem.ErrCategoryNotFound):
+		return middleware.NewAPIError(http.StatusNotFound, middleware.CodeCategoryNotFound, "Category not found")
+	case errors.Is(err, domainitem.ErrItemNotFound), errors.Is(err, domainitem.ErrInvalidItemID):
+		// A malformed ID cannot name an item, so it is reported as not found too
+		return middleware.NewAPIError(http.StatusNotFound, middleware.CodeItemNotFound, "Item not found")
+	}
+
+	return domainAPIError(err, fallback)
+}
+
+// domainAPIError maps a domain error by its code; anything else becomes a 500 with fallback as the message
+// Rule violations without a more specific status are the client's to fix and become a 400
+func domainAPIError(err error, fallback string) *middleware.APIError {
+	var domainErr *domainitem.DomainError
+	if !errors.As(err, &domainErr) {
+		return middleware.InternalError(fallback)
+	}
+
+	switch domainErr.Code {
+	case domainitem.CodeItemAlreadyExists:
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeDuplicateSKU, domainErr.Error())
+	case domainitem.CodeInsufficientStock, domainitem.CodeReleaseExceedsReserved:
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeInventoryConflict, domainErr.Error())
+	case domainitem.CodeItemInUse:
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeItemInUse, domainErr.Error())
+	case domainitem.CodeItemArchived:
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeItemArchived, domainErr.Error())
+	case domainitem.CodeOutOfStock:
+		return middleware.NewAPIError(http.StatusConflict, middleware.CodeOutOfStock, domainErr.Error())
+	}
+	return middleware.BadRequest(domainErr.Error())
+}
+
+// MapError is apiError for transports other than HTTP, so every API reports a use case error alike
+func MapError(err error, fallback string) *middleware.APIError {
+	return apiError(err, fallback)
+}
+
+// respondError writes the APIError err maps to
+func respondError(c *gin.Context, err error, fallback string) {
+	middleware.WriteError(c, apiError(err, fallback))
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+		expectedError  string
+	}{
+		{
+			name:           "item not found",
+			err:            fmt.Errorf("failed to find item: %w", item.ErrItemNotFound),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   middleware.CodeItemNotFound,
+			expectedError:  "Item not found",
+		},
+		{
+			name:           "duplicate SKU",
+			err:            fmt.Errorf("%w: item with SKU TEST-001 already exists", usecase.ErrDuplicateSKU),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeDuplicateSKU,
+			expectedError:  "duplicate SKU: item with SKU TEST-001 already exists",
+		},
+		{
+			name:           "inventory conflict",
+			err:            fmt.Errorf("%w: insufficient stock", usecase.ErrInventoryConflict),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeInventoryConflict,
+			expectedError:  "inventory conflict: insufficient stock",
+		},
+		{
+			name:           "archived item",
+			err:            fmt.Errorf("%w: item with ID 550e8400-e29b-41d4-a716-446655440000 is archived", usecase.ErrItemArchived),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeItemArchived,
+			expectedError:  "item archived: item with ID 550e8400-e29b-41d4-a716-446655440000 is archived",
+		},
+		{
+			name:           "illegal status transition",
+			err:            fmt.Errorf("failed to activate item: %w", &item.StatusTransitionError{From: item.StatusArchived, To: item.StatusActive}),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeInvalidStatusTransition,
+			expectedError:  "cannot change item status from archived to active",
+		},
+		{
+			name:           "bad request with its own message",
+			err:            fmt.Errorf("%w: min_price 50.00 is greater than max_price 10.00", usecase.ErrInvalidPriceRange),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   middleware.CodeInvalidRequest,
+			expectedError:  "min_price must not exceed max_price and neither may be negative",
+		},
+		{
+			name:           "item rule broken",
+			err:            fmt.Errorf("%w: item name must be at least 3 characters", usecase.ErrInvalidItem),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   middleware.CodeInvalidRequest,
+			expectedError:  "invalid item: item name must be at least 3 characters",
+		},
+		{
+			name:           "rejected field",
+			err:            &usecase.FieldError{Field: "currency", Message: "Must be one of EUR, USD", Value: "XYZ", Err: usecase.ErrUnsupportedCurrency},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   middleware.CodeValidationFailed,
+			expectedError:  "Validation failed",
+		},
+		{
+			name:           "already mapped",
+			err:            middleware.NewAPIError(http.StatusConflict, middleware.CodeItemInUse, "in use"),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeItemInUse,
+			expectedError:  "in use",
+		},
+		{
+			name:           "malformed item ID",
+			err:            fmt.Errorf("invalid item ID: %w", item.NewDomainErrorWithCode(item.CodeInvalidItemID, "invalid item ID format")),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   middleware.CodeItemNotFound,
+			expectedError:  "Item not found",
+		},
+		{
+			name:           "domain inventory conflict",
+			err:            fmt.Errorf("failed to reserve: %w", item.ErrInsufficientStock),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeInventoryConflict,
+			expectedError:  "insufficient stock",
+		},
+		{
+			name:           "domain out of stock",
+			err:            fmt.Errorf("failed to activate item: %w", item.ErrOutOfStock),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   middleware.CodeOutOfStock,
+			expectedError:  "item out of stock",
+		},
+		{
+			name:           "domain image not found",
+			err:            fmt.Errorf("failed to remove image: %w", item.ErrImageNotFound),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   middleware.CodeImageNotFound,
+			expectedError:  "Image not found",
+		},
+		{
+			name:           "domain rule broken is not reported as a missing item",
+			err:            fmt.Errorf("invalid price: %w", item.NewDomainErrorWithCode(item.CodeInvalidPrice, "price cannot be negative")),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   middleware.CodeInvalidRequest,
+			expectedError:  "price cannot be negative",
+		},
+		{
+			name:           "unrecognised error is not exposed",
+			err:            errors.New("pq: connection refused"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   middleware.CodeInternal,
+			expectedError:  "Failed to do it",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := apiError(tt.err, "Failed to do it")
+
+			assert.Equal(t, tt.expectedStatus, apiErr.Status)
+			assert.Equal(t, tt.expectedCode, apiErr.Code)
+			assert.Equal(t, tt.expectedError, apiErr.Message)
+		})
+	}
+}
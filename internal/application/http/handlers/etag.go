@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"item-pdp-service/internal/application/dto"
+)
+
+// itemETag is a weak validator for an item; it changes whenever the item is updated
+func itemETag(item *dto.ItemResponse) string {
+	return fmt.Sprintf(`W/"%s-%d"`, item.ID, item.UpdatedAt.UnixMicro())
+}
+
+// etagMatches reports whether an If-None-Match header lists etag, using weak comparison
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
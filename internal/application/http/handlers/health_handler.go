@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const serviceName = "item-pdp-service"
+
+// HealthHandler serves the liveness and readiness probes
+type HealthHandler struct {
+	db *database.DB
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(db *database.DB) *HealthHandler {
+	return &HealthHandler{
+		db: db,
+	}
+}
+
+// Live reports that the process is up without touching any dependency
+// @Summary Liveness probe
+// @Description Report that the service process is running
+// @Tags health
+// @Produce json
+// @Success 200 {object} dto.HealthResponse
+// @Router /health [get]
+// @Router /health/live [get]
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.HealthResponse{
+		Status:  "healthy",
+		Service: serviceName,
+	})
+}
+
+// Ready reports whether the service can serve traffic, which requires a reachable database
+// @Summary Readiness probe
+// @Description Check that the database is reachable
+// @Tags health
+// @Produce json
+// @Success 200 {object} dto.HealthResponse
+// @Failure 503 {object} dto.HealthResponse
+// @Router /health/ready [get]
+func (h *HealthHandler) Ready(c *gin.Context) {
+	if err := h.db.Health(); err != nil {
+		log.Warn().Err(err).Msg("Readiness check failed")
+		c.JSON(http.StatusServiceUnavailable, dto.HealthResponse{
+			Status:  "unavailable",
+			Service: serviceName,
+			Checks:  map[string]string{"database": err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HealthResponse{
+		Status:  "ready",
+		Service: serviceName,
+		Checks:  map[string]string{"database": "ok"},
+	})
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	handler := NewHealthHandler(&database.DB{DB: db})
+	router := gin.New()
+	router.GET("/health/live", handler.Live)
+	router.GET("/health/ready", handler.Ready)
+
+	serve := func(path string) (*httptest.ResponseRecorder, dto.HealthResponse) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		var resp dto.HealthResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return w, resp
+	}
+
+	t.Run("ready when the database answers", func(t *testing.T) {
+		mock.ExpectPing()
+
+		w, resp := serve("/health/ready")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "ready", resp.Status)
+		assert.Equal(t, "ok", resp.Checks["database"])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unavailable when the ping fails", func(t *testing.T) {
+		mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+		w, resp := serve("/health/ready")
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, "unavailable", resp.Status)
+		assert.Contains(t, resp.Checks["database"], "connection refused")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("live skips the database", func(t *testing.T) {
+		w, resp := serve("/health/live")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "healthy", resp.Status)
+		assert.Empty(t, resp.Checks)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
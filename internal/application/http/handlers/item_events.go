@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// eventKeepAlive is how often an idle stream gets a comment line, so proxies do not close it
+const eventKeepAlive = 15 * time.Second
+
+// StreamItemEvents streams an item's changes as server-sent events until the client disconnects
+// @Summary Stream item events
+// @Description Server-sent events for the item's ItemPriceChanged, ItemInventoryUpdated and ItemStatusChanged events. Each event's name is its type and its data is a JSON dto.ItemEventResponse
+// @Tags items
+// @Produce text/event-stream
+// @Param id path string true "Item ID"
+// @Success 200 {object} dto.ItemEventResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/{id}/events [get]
+func (h *ItemHandler) StreamItemEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	events, err := h.itemUseCase.SubscribeItemEvents(ctx, c.Param("id"))
+	if err != nil {
+		respondError(c, err, "Failed to stream item events")
+		return
+	}
+
+	// The server's write timeout would otherwise cut the stream
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		log.Debug().Err(err).Msg("Could not clear write deadline for event stream")
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeEvent(c, &event); err != nil {
+				log.Debug().Err(err).Str("item_id", event.ItemID).Msg("Event stream closed")
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := c.Writer.WriteString(": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+		c.Writer.Flush()
+	}
+}
+
+// writeEvent writes event in the text/event-stream format
+func writeEvent(c *gin.Context, event *dto.ItemEventResponse) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportFlushEvery is how many items are buffered before the response is flushed
+const exportFlushEvery = 100
+
+// itemExporter writes exported items to the response as they arrive
+// Headers are sent with the first item so that errors raised before it can still become a JSON error
+type itemExporter struct {
+	c       *gin.Context
+	format  string
+	csv     *csv.Writer
+	started bool
+	count   int
+}
+
+func newItemExporter(c *gin.Context, format string) *itemExporter {
+	return &itemExporter{c: c, format: format}
+}
+
+func (e *itemExporter) start() error {
+	contentType := "application/json"
+	if e.format == "csv" {
+		contentType = "text/csv; charset=utf-8"
+	}
+	filename := fmt.Sprintf("items-%s.%s", time.Now().UTC().Format("20060102T150405Z"), e.format)
+
+	e.c.Header("Content-Type", contentType)
+	e.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	e.c.Status(http.StatusOK)
+	e.started = true
+
+	if e.format == "csv" {
+		e.csv = csv.NewWriter(e.c.Writer)
+		return e.csv.Write(usecase.CSVColumns)
+	}
+	_, err := e.c.Writer.WriteString("[")
+	return err
+}
+
+func (e *itemExporter) write(resp *dto.ItemResponse) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if e.format == "csv" {
+		err = e.csv.Write([]string{
+			resp.SKU,
+			resp.Name,
+			resp.Description,
+			strconv.FormatFloat(resp.Price, 'f', -1, 64),
+			resp.Currency,
+			resp.Category.Slug,
+			strconv.Itoa(resp.Inventory.Quantity),
+		})
+	} else {
+		err = e.writeJSON(resp)
+	}
+	if err != nil {
+		return err
+	}
+
+	e.count++
+	if e.count%exportFlushEvery == 0 {
+		return e.flush()
+	}
+	return nil
+}
+
+func (e *itemExporter) writeJSON(resp *dto.ItemResponse) error {
+	if e.count > 0 {
+		if _, err := e.c.Writer.WriteString(","); err != nil {
+			return err
+		}
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	_, err = e.c.Writer.Write(body)
+	return err
+}
+
+// close finishes the document; an export with no items still gets headers and an empty body
+func (e *itemExporter) close() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	if e.format == "json" {
+		if _, err := e.c.Writer.WriteString("]"); err != nil {
+			return err
+		}
+	}
+	return e.flush()
+}
+
+func (e *itemExporter) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	e.c.Writer.Flush()
+	return nil
+}
@@ -2,53 +2,92 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/rand"
+	"math"
 	"net/http"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"item-pdp-service/internal/application/dto"
 	"item-pdp-service/internal/application/http/middleware"
 	"item-pdp-service/internal/application/usecase"
+	domainitem "item-pdp-service/internal/domain/item"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
 )
 
+// IdempotencyKeyHeader lets clients retry item creation without creating duplicates
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// MaxIdempotencyKeyLength matches the idempotency_keys.key column
+const MaxIdempotencyKeyLength = 255
+
 // ItemHandler handles HTTP requests for items
 type ItemHandler struct {
 	itemUseCase usecase.ItemUseCase
+	batch       BatchOptions
+}
+
+// BatchOptions bounds ProcessItemsBatch
+type BatchOptions struct {
+	Concurrency int
+	Timeout     time.Duration
+}
+
+// DefaultBatchOptions returns the batch limits used when none are configured
+func DefaultBatchOptions() BatchOptions {
+	return BatchOptions{
+		Concurrency: 8,
+		Timeout:     30 * time.Second,
+	}
 }
 
 // NewItemHandler creates a new item handler
 func NewItemHandler(itemUseCase usecase.ItemUseCase) *ItemHandler {
 	return &ItemHandler{
 		itemUseCase: itemUseCase,
+		batch:       DefaultBatchOptions(),
 	}
 }
 
+// WithBatchOptions overrides the batch limits; non-positive values keep the defaults
+func (h *ItemHandler) WithBatchOptions(opts BatchOptions) *ItemHandler {
+	if opts.Concurrency > 0 {
+		h.batch.Concurrency = opts.Concurrency
+	}
+	if opts.Timeout > 0 {
+		h.batch.Timeout = opts.Timeout
+	}
+	return h
+}
+
 // CreateItem creates a new item
 // @Summary Create a new item
-// @Description Create a new item with the provided data
+// @Description Create a new item with the provided data; an empty sku is generated from the category
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param item body dto.CreateItemRequest true "Item data"
+// @Param Idempotency-Key header string false "Repeats with the same key return the first response instead of creating another item"
 // @Success 201 {object} dto.ItemResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "A request with this key is still in progress"
+// @Failure 422 {object} middleware.ErrorResponse "This key was used with a different request"
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items [post]
+// @Security BearerAuth
+// @Router /api/v1/items [post]
 func (h *ItemHandler) CreateItem(c *gin.Context) {
 	var req dto.CreateItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		log.Error().Err(err).Msg("Failed to bind JSON")
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Invalid request body",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
 		return
 	}
 
@@ -57,44 +96,303 @@ func (h *ItemHandler) CreateItem(c *gin.Context) {
 		return
 	}
 
+	req.IdempotencyKey = strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+	if len(req.IdempotencyKey) > MaxIdempotencyKeyLength {
+		middleware.WriteError(c, middleware.BadRequest(fmt.Sprintf("%s must be at most %d characters", IdempotencyKeyHeader, MaxIdempotencyKeyLength)))
+		return
+	}
+
 	item, err := h.itemUseCase.CreateItem(c.Request.Context(), &req)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to create item")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to create item",
-		})
+		respondError(c, err, "Failed to create item")
+		return
+	}
+
+	respond(c, http.StatusCreated, item)
+}
+
+// CreateItemsBulk creates many items in one request
+// @Summary Create items in bulk
+// @Description Create up to 500 items in one transaction and report the outcome per item
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkCreateRequest true "Items to create"
+// @Success 201 {object} dto.BulkCreateResult "All items created"
+// @Success 207 {object} dto.BulkCreateResult "Some items created"
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 422 {object} dto.BulkCreateResult "No items created"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/bulk [post]
+func (h *ItemHandler) CreateItemsBulk(c *gin.Context) {
+	var req dto.BulkCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
 		return
 	}
 
-	c.JSON(http.StatusCreated, item)
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	result, err := h.itemUseCase.CreateItemsBulk(c.Request.Context(), req.Items)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create items in bulk")
+		respondError(c, err, "Failed to create items")
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case result.Created == 0:
+		status = http.StatusUnprocessableEntity
+	case result.Created < result.Total:
+		status = http.StatusMultiStatus
+	}
+
+	c.JSON(status, result)
+}
+
+// DeleteItemsBulk deletes many items in one request
+// @Summary Delete items in bulk
+// @Description Delete up to 500 items by ID in one transaction and count those deleted and not found. Active items with stock are kept and counted as in use unless force is true. A database failure deletes nothing
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkDeleteRequest true "IDs of the items to delete"
+// @Success 200 {object} dto.BulkDeleteResult
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/bulk-delete [post]
+func (h *ItemHandler) DeleteItemsBulk(c *gin.Context) {
+	var req dto.BulkDeleteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	result, err := h.itemUseCase.DeleteItemsBulk(c.Request.Context(), req.IDs, req.Force)
+	if err != nil {
+		log.Error().Err(err).Int("count", len(req.IDs)).Msg("Failed to delete items in bulk")
+		respondError(c, err, "Failed to delete items")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ImportItems creates items from an uploaded CSV file
+// @Summary Import items from CSV
+// @Description Create items from a CSV file (multipart field "file") with columns sku,name,description,price,currency,category,inventory and report the outcome per row. Bad rows are skipped unless strict is set, in which case nothing is created
+// @Tags items
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV file"
+// @Param strict query bool false "Create nothing when any row fails"
+// @Success 201 {object} dto.ImportResult "All rows created"
+// @Success 207 {object} dto.ImportResult "Some rows created"
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 422 {object} dto.ImportResult "No rows created"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/import [post]
+func (h *ItemHandler) ImportItems(c *gin.Context) {
+	strict, err := strconv.ParseBool(c.DefaultQuery("strict", "false"))
+	if err != nil {
+		middleware.WriteError(c, middleware.BadRequest("strict must be true or false"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		middleware.WriteError(c, middleware.BadRequest("CSV file is required"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to open uploaded file")
+		middleware.WriteError(c, middleware.BadRequest("Invalid CSV file"))
+		return
+	}
+	defer file.Close()
+
+	result, err := h.itemUseCase.ImportItemsCSV(c.Request.Context(), file, strict)
+	if err != nil {
+		log.Error().Err(err).Str("filename", fileHeader.Filename).Msg("Failed to import items")
+		respondError(c, err, "Failed to import items")
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case result.Created == 0:
+		status = http.StatusUnprocessableEntity
+	case result.Created < result.Total:
+		status = http.StatusMultiStatus
+	}
+
+	c.JSON(status, result)
+}
+
+// ExportItems streams every item matching the filters as CSV or JSON
+// @Summary Export items
+// @Description Download all items as CSV (same columns as the import format) or as a JSON array. Items are streamed, oldest first
+// @Tags items
+// @Produce text/csv
+// @Produce json
+// @Param format query string false "Output format" Enums(csv, json) default(csv)
+// @Param category query string false "Category filter"
+// @Param status query string false "Status filter"
+// @Success 200 {file} file
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/export [get]
+func (h *ItemHandler) ExportItems(c *gin.Context) {
+	req := dto.ExportRequest{
+		Format:   strings.ToLower(c.DefaultQuery("format", "csv")),
+		Category: c.Query("category"),
+		Status:   c.Query("status"),
+	}
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	exporter := newItemExporter(c, req.Format)
+	err := h.itemUseCase.ExportItems(c.Request.Context(), &req, exporter.write)
+	if err == nil {
+		err = exporter.close()
+	}
+	if err == nil {
+		return
+	}
+
+	log.Error().Err(err).Int("exported", exporter.count).Msg("Failed to export items")
+	if exporter.started {
+		// The status line is already out, so the client can only see a truncated body
+		c.Abort()
+		return
+	}
+	respondError(c, err, "Failed to export items")
 }
 
 // GetItem retrieves an item by ID
 // @Summary Get item by ID
-// @Description Get an item by its ID
+// @Description Get an item by its ID, optionally with the price converted to another currency
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param id path string true "Item ID"
+// @Param currency query string false "Currency to convert the price to, e.g. EUR"
+// @Param If-None-Match header string false "ETag from an earlier response"
 // @Success 200 {object} dto.ItemResponse
+// @Success 304 "Item unchanged since the given ETag"
+// @Failure 400 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id} [get]
+// @Router /api/v1/items/{id} [get]
 func (h *ItemHandler) GetItem(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
 		return
 	}
 
-	item, err := h.itemUseCase.GetItemByID(c.Request.Context(), id)
+	var (
+		item *dto.ItemResponse
+		err  error
+	)
+	if currency := c.Query("currency"); currency != "" {
+		item, err = h.itemUseCase.GetItemInCurrency(c.Request.Context(), id, currency)
+	} else {
+		item, err = h.itemUseCase.GetItemByID(c.Request.Context(), id)
+	}
 	if err != nil {
 		log.Error().Err(err).Str("item_id", id).Msg("Failed to get item")
-		c.JSON(http.StatusNotFound, middleware.ErrorResponse{
-			Error: "Item not found",
-		})
+		respondError(c, err, "Failed to get item")
+		return
+	}
+
+	etag := itemETag(item)
+	c.Header("ETag", etag)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// GetPriceHistory retrieves an item's price changes
+// @Summary Get item price history
+// @Description Get the recorded price changes of an item, oldest first
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} dto.PriceHistoryResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/{id}/price-history [get]
+func (h *ItemHandler) GetPriceHistory(c *gin.Context) {
+	id := c.Param("id")
+
+	// Parse page
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	// Parse page size
+	pageSizeStr := c.DefaultQuery("page_size", "10")
+	pageSize, err := strconv.Atoi(pageSizeStr)
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	history, err := h.itemUseCase.GetPriceHistory(c.Request.Context(), id, page, pageSize)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to get price history")
+		respondError(c, err, "Failed to get price history")
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// GetItemWithStats retrieves an item with its view count and rating
+// @Summary Get item with stats
+// @Description Get an item together with how often it was viewed and its average rating
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Success 200 {object} dto.ItemWithStatsResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/{id}/stats [get]
+func (h *ItemHandler) GetItemWithStats(c *gin.Context) {
+	id := c.Param("id")
+
+	item, err := h.itemUseCase.GetItemWithStats(c.Request.Context(), id)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to get item stats")
+		respondError(c, err, "Failed to get item stats")
 		return
 	}
 
@@ -106,31 +404,52 @@ func (h *ItemHandler) GetItem(c *gin.Context) {
 // @Description Get an item by its SKU
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param sku path string true "Item SKU"
 // @Success 200 {object} dto.ItemResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/sku/{sku} [get]
+// @Router /api/v1/items/sku/{sku} [get]
 func (h *ItemHandler) GetItemBySKU(c *gin.Context) {
 	sku := c.Param("sku")
 	if sku == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "SKU is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("SKU is required"))
 		return
 	}
 
 	item, err := h.itemUseCase.GetItemBySKU(c.Request.Context(), sku)
 	if err != nil {
 		log.Error().Err(err).Str("sku", sku).Msg("Failed to get item by SKU")
-		c.JSON(http.StatusNotFound, middleware.ErrorResponse{
-			Error: "Item not found",
-		})
+		respondError(c, err, "Failed to get item by SKU")
 		return
 	}
 
-	c.JSON(http.StatusOK, item)
+	respond(c, http.StatusOK, item)
+}
+
+// GetItemByBarcode retrieves an item by barcode
+// @Summary Get item by barcode
+// @Description Get an item by its GTIN-13 (EAN-13) or UPC-A barcode
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param code path string true "GTIN-13 or UPC-A code"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/barcode/{code} [get]
+func (h *ItemHandler) GetItemByBarcode(c *gin.Context) {
+	code := c.Param("code")
+
+	item, err := h.itemUseCase.GetItemByBarcode(c.Request.Context(), code)
+	if err != nil {
+		log.Error().Err(err).Str("barcode", code).Msg("Failed to get item by barcode")
+		respondError(c, err, "Failed to get item by barcode")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
 }
 
 // UpdateItem updates an existing item
@@ -138,29 +457,27 @@ func (h *ItemHandler) GetItemBySKU(c *gin.Context) {
 // @Description Update an existing item with the provided data
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param id path string true "Item ID"
 // @Param item body dto.UpdateItemRequest true "Updated item data"
 // @Success 200 {object} dto.ItemResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id} [put]
+// @Security BearerAuth
+// @Router /api/v1/items/{id} [put]
 func (h *ItemHandler) UpdateItem(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
 		return
 	}
 
 	var req dto.UpdateItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		log.Error().Err(err).Msg("Failed to bind JSON")
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Invalid request body",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
 		return
 	}
 
@@ -172,13 +489,31 @@ func (h *ItemHandler) UpdateItem(c *gin.Context) {
 	item, err := h.itemUseCase.UpdateItem(c.Request.Context(), id, &req)
 	if err != nil {
 		log.Error().Err(err).Str("item_id", id).Msg("Failed to update item")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to update item",
-		})
+		respondError(c, err, "Failed to update item")
 		return
 	}
 
-	c.JSON(http.StatusOK, item)
+	respond(c, http.StatusOK, item)
+}
+
+// PatchItem partially updates an existing item
+// @Summary Partially update an item
+// @Description Change only the fields present in the body; attributes are merged into the existing ones
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param item body dto.UpdateItemRequest true "Fields to change"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id} [patch]
+func (h *ItemHandler) PatchItem(c *gin.Context) {
+	// UpdateItem already leaves absent fields untouched, so both verbs share it
+	h.UpdateItem(c)
 }
 
 // UpdateInventory updates item inventory
@@ -186,29 +521,28 @@ func (h *ItemHandler) UpdateItem(c *gin.Context) {
 // @Description Update the inventory quantity of an item
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param id path string true "Item ID"
 // @Param inventory body dto.UpdateInventoryRequest true "Inventory data"
 // @Success 200 {object} dto.ItemResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id}/inventory [patch]
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/inventory [patch]
 func (h *ItemHandler) UpdateInventory(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
 		return
 	}
 
 	var req dto.UpdateInventoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		log.Error().Err(err).Msg("Failed to bind JSON")
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Invalid request body",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
 		return
 	}
 
@@ -219,90 +553,506 @@ func (h *ItemHandler) UpdateInventory(c *gin.Context) {
 
 	item, err := h.itemUseCase.UpdateInventory(c.Request.Context(), id, &req)
 	if err != nil {
-		log.Error().Err(err).Str("item_id", id).Msg("Failed to update inventory")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to update inventory",
-		})
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to update inventory")
+		respondError(c, err, "Failed to update inventory")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// UpdateInventoryBulk sets the stock of many items by SKU
+// @Summary Update inventory in bulk
+// @Description Set stock for up to 500 SKUs in one transaction and report the outcome per SKU. Unknown SKUs and invalid quantities fail on their own without stopping the others
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param updates body []dto.InventoryUpdate true "SKUs and their new quantities"
+// @Success 200 {object} dto.BulkInventoryResult "All SKUs updated"
+// @Success 207 {object} dto.BulkInventoryResult "Some SKUs updated"
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 422 {object} dto.BulkInventoryResult "No SKUs updated"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/inventory/bulk [patch]
+func (h *ItemHandler) UpdateInventoryBulk(c *gin.Context) {
+	var req dto.BulkInventoryUpdateRequest
+	if err := c.ShouldBindJSON(&req.Items); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	result, err := h.itemUseCase.UpdateInventoryBulk(c.Request.Context(), req.Items)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update inventory in bulk")
+		respondError(c, err, "Failed to update inventory")
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case result.Updated == 0:
+		status = http.StatusUnprocessableEntity
+	case result.Updated < result.Total:
+		status = http.StatusMultiStatus
+	}
+
+	c.JSON(status, result)
+}
+
+// ReserveInventory holds stock for an item
+// @Summary Reserve item inventory
+// @Description Hold units of an item for a pending order
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param reservation body dto.InventoryReservationRequest true "Units to reserve"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/inventory/reserve [post]
+func (h *ItemHandler) ReserveInventory(c *gin.Context) {
+	h.changeReservation(c, h.itemUseCase.ReserveInventory, "reserve")
+}
+
+// ReleaseInventory returns held stock for an item
+// @Summary Release item inventory
+// @Description Release units previously reserved for an item
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param reservation body dto.InventoryReservationRequest true "Units to release"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/inventory/release [post]
+func (h *ItemHandler) ReleaseInventory(c *gin.Context) {
+	h.changeReservation(c, h.itemUseCase.ReleaseInventory, "release")
+}
+
+// CheckAvailability reports whether a quantity of an item can be reserved
+// @Summary Check item availability
+// @Description Check whether quantity units of an item could be reserved now, counting stock already reserved. Nothing is held
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param quantity query int true "Units wanted, at least 1"
+// @Success 200 {object} dto.ItemAvailabilityResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/{id}/availability [get]
+func (h *ItemHandler) CheckAvailability(c *gin.Context) {
+	id := c.Param("id")
+
+	quantity, err := strconv.Atoi(c.Query("quantity"))
+	if err != nil || quantity < 1 {
+		middleware.WriteError(c, middleware.BadRequest("quantity must be a positive integer"))
+		return
+	}
+
+	availability, err := h.itemUseCase.CheckAvailability(c.Request.Context(), id, quantity)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to check item availability")
+		respondError(c, err, "Failed to check item availability")
+		return
+	}
+
+	c.JSON(http.StatusOK, availability)
+}
+
+// changeReservation binds a reservation request and applies it with the given use case call
+func (h *ItemHandler) changeReservation(
+	c *gin.Context,
+	apply func(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error),
+	action string,
+) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	var req dto.InventoryReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	item, err := apply(c.Request.Context(), id, &req)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msgf("Failed to %s inventory", action)
+		if errors.Is(err, usecase.ErrInventoryConflict) {
+			err = middleware.NewAPIError(http.StatusConflict, middleware.CodeInventoryConflict, "Cannot "+action+" the requested quantity")
+		}
+		respondError(c, err, "Failed to "+action+" inventory")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// AddImage adds an image to an item
+// @Summary Add image to item
+// @Description Add an image to an existing item
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param image body dto.AddImageRequest true "Image data"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/images [post]
+func (h *ItemHandler) AddImage(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	var req dto.AddImageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	// Validate request
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	item, err := h.itemUseCase.AddImage(c.Request.Context(), id, &req)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to add image")
+		respondError(c, err, "Failed to add image")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// UploadImage uploads an image file and attaches it to an item
+// @Summary Upload image for item
+// @Description Upload a JPEG, PNG or WebP file (multipart field "file") and add it to the item's images
+// @Tags items
+// @Accept multipart/form-data
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param file formData file true "Image file"
+// @Param alt formData string false "Alt text"
+// @Param is_primary formData bool false "Make this the primary image"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/images/upload [post]
+func (h *ItemHandler) UploadImage(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	var req dto.UploadImageRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind form")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	// Validate request
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		middleware.WriteError(c, middleware.BadRequest("Image file is required"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to open uploaded file")
+		middleware.WriteError(c, middleware.BadRequest("Invalid image file"))
+		return
+	}
+	defer file.Close()
+
+	req.Filename = fileHeader.Filename
+	req.Size = fileHeader.Size
+	req.Content = file
+
+	item, err := h.itemUseCase.UploadImage(c.Request.Context(), id, &req)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to upload image")
+		respondError(c, err, "Failed to upload image")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// RemoveImage removes an image from an item
+// @Summary Remove image from item
+// @Description Remove the image with the given URL; a removed primary is replaced by the next image
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param url query string true "Image URL"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/images [delete]
+func (h *ItemHandler) RemoveImage(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	url := c.Query("url")
+	if url == "" {
+		middleware.WriteError(c, middleware.BadRequest("Image URL is required"))
+		return
+	}
+
+	item, err := h.itemUseCase.RemoveImage(c.Request.Context(), id, url)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to remove image")
+		respondError(c, err, "Failed to remove image")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// RemoveImageAt removes an image from an item by its position
+// @Summary Remove image from item by position
+// @Description Remove the image at the given 0-based position in display order; a removed primary is replaced by the next image
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param index path int true "Image position, starting at 0"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/images/{index} [delete]
+func (h *ItemHandler) RemoveImageAt(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil || index < 0 {
+		middleware.WriteError(c, middleware.BadRequest("Image index must be a non-negative integer"))
+		return
+	}
+
+	item, err := h.itemUseCase.RemoveImageAt(c.Request.Context(), id, index)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Int("image_index", index).Msg("Failed to remove image")
+		respondError(c, err, "Failed to remove image")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// RemoveAttribute removes an attribute from an item
+// @Summary Remove attribute from item
+// @Description Remove the attribute with the given key; removing a missing key returns the item unchanged
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param key path string true "Attribute key"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/attributes/{key} [delete]
+func (h *ItemHandler) RemoveAttribute(c *gin.Context) {
+	id := c.Param("id")
+	key := c.Param("key")
+	if id == "" || key == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID and attribute key are required"))
+		return
+	}
+
+	item, err := h.itemUseCase.RemoveAttribute(c.Request.Context(), id, key)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Str("attribute", key).Msg("Failed to remove attribute")
+		respondError(c, err, "Failed to remove attribute")
+		return
+	}
+
+	respond(c, http.StatusOK, item)
+}
+
+// ReorderImages changes the order of an item's images
+// @Summary Reorder item images
+// @Description Reorder images by listing every image URL exactly once
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param order body dto.ReorderImagesRequest true "Image URLs in the new order"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/images/order [put]
+func (h *ItemHandler) ReorderImages(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	var req dto.ReorderImagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	item, err := h.itemUseCase.ReorderImages(c.Request.Context(), id, &req)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to reorder images")
+		respondError(c, err, "Failed to reorder images")
 		return
 	}
 
-	c.JSON(http.StatusOK, item)
+	respond(c, http.StatusOK, item)
 }
 
-// AddImage adds an image to an item
-// @Summary Add image to item
-// @Description Add an image to an existing item
+// ReplaceImages replaces every image of an item
+// @Summary Replace item images
+// @Description Replace the item's images with the given list; at most one may be primary, and the first becomes primary when none is
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param id path string true "Item ID"
-// @Param image body dto.AddImageRequest true "Image data"
+// @Param images body dto.ReplaceImagesRequest true "The complete image set"
 // @Success 200 {object} dto.ItemResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id}/images [post]
-func (h *ItemHandler) AddImage(c *gin.Context) {
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/images [put]
+func (h *ItemHandler) ReplaceImages(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
 		return
 	}
 
-	var req dto.AddImageRequest
+	var req dto.ReplaceImagesRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		log.Error().Err(err).Msg("Failed to bind JSON")
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Invalid request body",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
 		return
 	}
 
-	// Validate request
 	if !middleware.ValidateAndRespond(c, req) {
 		return
 	}
 
-	item, err := h.itemUseCase.AddImage(c.Request.Context(), id, &req)
+	item, err := h.itemUseCase.ReplaceImages(c.Request.Context(), id, &req)
 	if err != nil {
-		log.Error().Err(err).Str("item_id", id).Msg("Failed to add image")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to add image",
-		})
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to replace images")
+		respondError(c, err, "Failed to replace images")
 		return
 	}
 
-	c.JSON(http.StatusOK, item)
+	respond(c, http.StatusOK, item)
 }
 
 // DeleteItem deletes an item
 // @Summary Delete an item
-// @Description Delete an item by its ID
+// @Description Delete an item by its ID; an active item with stock is refused with 409 unless force is true
 // @Tags items
 // @Accept json
 // @Produce json
 // @Param id path string true "Item ID"
+// @Param force query bool false "Delete even if the item is active and in stock" default(false)
 // @Success 204
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id} [delete]
+// @Security BearerAuth
+// @Router /api/v1/items/{id} [delete]
 func (h *ItemHandler) DeleteItem(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	force, err := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	if err != nil {
+		middleware.WriteError(c, middleware.BadRequest("force must be true or false"))
 		return
 	}
 
-	err := h.itemUseCase.DeleteItem(c.Request.Context(), id)
+	err = h.itemUseCase.DeleteItem(c.Request.Context(), id, force)
 	if err != nil {
 		log.Error().Err(err).Str("item_id", id).Msg("Failed to delete item")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to delete item",
-		})
+		if errors.Is(err, usecase.ErrItemInUse) {
+			err = middleware.NewAPIError(http.StatusConflict, middleware.CodeItemInUse, err.Error()+"; deactivate it first or pass force=true")
+		}
+		respondError(c, err, "Failed to delete item")
 		return
 	}
 
@@ -311,143 +1061,345 @@ func (h *ItemHandler) DeleteItem(c *gin.Context) {
 
 // DeactivateItem deactivates an item
 // @Summary Deactivate an item
-// @Description Deactivate an item by its ID
+// @Description Deactivate an item by its ID. An item that is already inactive is left as it is and reported with changed false
 // @Tags items
 // @Accept json
 // @Produce json
 // @Param id path string true "Item ID"
-// @Success 204
+// @Success 200 {object} dto.StatusChangeResponse
+// @Failure 401 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id}/deactivate [patch]
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/deactivate [patch]
 func (h *ItemHandler) DeactivateItem(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
 		return
 	}
 
-	err := h.itemUseCase.DeactivateItem(c.Request.Context(), id)
+	result, err := h.itemUseCase.DeactivateItem(c.Request.Context(), id)
 	if err != nil {
 		log.Error().Err(err).Str("item_id", id).Msg("Failed to deactivate item")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to deactivate item",
-		})
+		respondError(c, err, "Failed to deactivate item")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// CloneItem copies an item into a new draft
+// @Summary Clone an item
+// @Description Create a draft copy of an item under a newly generated SKU, with no stock and no barcode
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Success 201 {object} dto.ItemResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/clone [post]
+func (h *ItemHandler) CloneItem(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	clone, err := h.itemUseCase.CloneItem(c.Request.Context(), id)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to clone item")
+		respondError(c, err, "Failed to clone item")
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	respond(c, http.StatusCreated, clone)
 }
 
 // ActivateItem activates an item
 // @Summary Activate an item
-// @Description Activate an item by its ID
+// @Description Activate an item by its ID. An item that is already active is left as it is and reported with changed false
 // @Tags items
 // @Accept json
 // @Produce json
 // @Param id path string true "Item ID"
-// @Success 204
+// @Success 200 {object} dto.StatusChangeResponse
+// @Failure 401 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/{id}/activate [patch]
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/activate [patch]
 func (h *ItemHandler) ActivateItem(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Item ID is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
 		return
 	}
 
-	err := h.itemUseCase.ActivateItem(c.Request.Context(), id)
+	result, err := h.itemUseCase.ActivateItem(c.Request.Context(), id)
 	if err != nil {
 		log.Error().Err(err).Str("item_id", id).Msg("Failed to activate item")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to activate item",
-		})
+		respondError(c, err, "Failed to activate item")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UnarchiveItem restores an archived item to draft
+// @Summary Unarchive an item
+// @Description Move an archived item back to draft. It is not put on sale; activate it once it has stock
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Success 200 {object} dto.ItemResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/{id}/unarchive [post]
+func (h *ItemHandler) UnarchiveItem(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		middleware.WriteError(c, middleware.BadRequest("Item ID is required"))
+		return
+	}
+
+	restored, err := h.itemUseCase.UnarchiveItem(c.Request.Context(), id)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to unarchive item")
+		respondError(c, err, "Failed to unarchive item")
+		return
+	}
+
+	respond(c, http.StatusOK, restored)
+}
+
+// ListItems lists items
+// @Summary List items
+// @Description List every item, optionally narrowed by status, category and brand; all given filters must match
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param status query string false "Status filter" Enums(active, inactive, draft, archived)
+// @Param category query string false "Category filter"
+// @Param brand query string false "Brand name or slug"
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at, price, name) default(created_at)
+// @Param sort_order query string false "Sort direction" Enums(asc, desc) default(desc)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} dto.ItemListResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items [get]
+func (h *ItemHandler) ListItems(c *gin.Context) {
+	req, ok := bindListItemsRequest(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.itemUseCase.ListItems(c.Request.Context(), req)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list items")
+		respondError(c, err, "Failed to list items")
+		return
+	}
+
+	respond(c, http.StatusOK, items)
+}
+
+// ListItemSummaries lists items in their lightweight summary form
+// @Summary List item summaries
+// @Description List the same items as GET /items with only id, SKU, name, price, category, status and whether any stock is on hand
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param status query string false "Status filter" Enums(active, inactive, draft, archived)
+// @Param category query string false "Category filter"
+// @Param brand query string false "Brand name or slug"
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at, price, name) default(created_at)
+// @Param sort_order query string false "Sort direction" Enums(asc, desc) default(desc)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} dto.ItemSummaryListResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/summary [get]
+func (h *ItemHandler) ListItemSummaries(c *gin.Context) {
+	req, ok := bindListItemsRequest(c)
+	if !ok {
+		return
+	}
+
+	summaries, err := h.itemUseCase.ListItemSummaries(c.Request.Context(), req)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list item summaries")
+		respondError(c, err, "Failed to list item summaries")
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	c.JSON(http.StatusOK, summaries)
+}
+
+// bindListItemsRequest reads and validates the list filters, paging and sorting from the query
+// On failure it writes the validation errors and returns false
+func bindListItemsRequest(c *gin.Context) (*dto.ListItemsRequest, bool) {
+	var (
+		req         dto.ListItemsRequest
+		fieldErrors []middleware.ValidationError
+		ok          bool
+	)
+
+	req.Category = c.Query("category")
+	req.Status = strings.ToLower(c.Query("status"))
+	req.Brand = strings.TrimSpace(c.Query("brand"))
+	req.SortBy = strings.ToLower(c.Query("sort_by"))
+	req.SortOrder = strings.ToLower(c.Query("sort_order"))
+
+	if req.Page, ok = parseIntQuery(c, "page", 1); !ok {
+		fieldErrors = append(fieldErrors, middleware.ValidationError{Field: "page", Message: "Must be a whole number", Value: c.Query("page")})
+	}
+	if req.PageSize, ok = parseIntQuery(c, "page_size", 10); !ok {
+		fieldErrors = append(fieldErrors, middleware.ValidationError{Field: "page_size", Message: "Must be a whole number", Value: c.Query("page_size")})
+	}
+
+	fieldErrors = append(fieldErrors, middleware.ValidateStruct(req)...)
+	if len(fieldErrors) > 0 {
+		middleware.RespondValidationErrors(c, fieldErrors)
+		return nil, false
+	}
+
+	return &req, true
 }
 
 // SearchItems searches for items
 // @Summary Search items
-// @Description Search for items based on query parameters
+// @Description Search for items by query or filters; at least one must be given. Query results are ranked by relevance
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param query query string false "Search query"
 // @Param category query string false "Category filter"
 // @Param status query string false "Status filter"
+// @Param min_price query number false "Minimum price, inclusive"
+// @Param max_price query number false "Maximum price, inclusive"
+// @Param currency query string false "Price currency" default(USD)
+// @Param attributes query string false "Attribute filters as attributes[key]=value; every pair must match"
+// @Param attribute_min query string false "Numeric attribute lower bounds as attribute_min[key]=number, inclusive"
+// @Param attribute_max query string false "Numeric attribute upper bounds as attribute_max[key]=number, inclusive"
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at, price, name) default(created_at)
+// @Param sort_order query string false "Sort direction" Enums(asc, desc) default(desc)
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(10)
 // @Success 200 {object} dto.ItemListResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/search [get]
+// @Router /api/v1/items/search [get]
 func (h *ItemHandler) SearchItems(c *gin.Context) {
-	var req dto.SearchRequest
+	var (
+		req         dto.SearchRequest
+		fieldErrors []middleware.ValidationError
+		ok          bool
+		err         error
+	)
 
 	// Parse query parameters
-	req.Query = c.Query("query")
+	req.Query = strings.TrimSpace(c.Query("query"))
 	req.Category = c.Query("category")
-	req.Status = c.Query("status")
+	req.Status = strings.ToLower(c.Query("status"))
 
-	// Parse page
-	pageStr := c.DefaultQuery("page", "1")
-	page, err := strconv.Atoi(pageStr)
-	if err != nil || page < 1 {
-		page = 1
+	// Parse pagination; values that are not numbers are reported rather than replaced with the defaults
+	if req.Page, ok = parseIntQuery(c, "page", 1); !ok {
+		fieldErrors = append(fieldErrors, middleware.ValidationError{Field: "page", Message: "Must be a whole number", Value: c.Query("page")})
+	}
+	if req.PageSize, ok = parseIntQuery(c, "page_size", 10); !ok {
+		fieldErrors = append(fieldErrors, middleware.ValidationError{Field: "page_size", Message: "Must be a whole number", Value: c.Query("page_size")})
 	}
-	req.Page = page
 
-	// Parse page size
-	pageSizeStr := c.DefaultQuery("page_size", "10")
-	pageSize, err := strconv.Atoi(pageSizeStr)
-	if err != nil || pageSize < 1 || pageSize > 100 {
-		pageSize = 10
+	// Parse price range
+	req.Currency = c.Query("currency")
+	if req.MinPrice, err = parsePriceQuery(c, "min_price"); err != nil {
+		middleware.WriteError(c, middleware.BadRequest("Invalid min_price"))
+		return
+	}
+	if req.MaxPrice, err = parsePriceQuery(c, "max_price"); err != nil {
+		middleware.WriteError(c, middleware.BadRequest("Invalid max_price"))
+		return
 	}
-	req.PageSize = pageSize
 
-	// Validate request
-	if !middleware.ValidateAndRespond(c, req) {
+	// Parse sorting
+	req.SortBy = strings.ToLower(c.Query("sort_by"))
+	req.SortOrder = strings.ToLower(c.Query("sort_order"))
+
+	// Parse attribute filters given as attributes[key]=value
+	if attrs := c.QueryMap("attributes"); len(attrs) > 0 {
+		req.Attributes = make(map[string]string, len(attrs))
+		for key, value := range attrs {
+			req.Attributes[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if req.AttributeMin, err = parseAttributeBounds(c, "attribute_min"); err != nil {
+		middleware.WriteError(c, middleware.BadRequest("Invalid attribute_min"))
+		return
+	}
+	if req.AttributeMax, err = parseAttributeBounds(c, "attribute_max"); err != nil {
+		middleware.WriteError(c, middleware.BadRequest("Invalid attribute_max"))
+		return
+	}
+
+	// Validate request before it reaches the database
+	fieldErrors = append(fieldErrors, middleware.ValidateStruct(req)...)
+	if req.Category != "" {
+		if _, err := domainitem.NewCategory(req.Category); err != nil {
+			fieldErrors = append(fieldErrors, middleware.ValidationError{Field: "category", Message: err.Error(), Value: req.Category})
+		}
+	}
+	if !req.HasFilter() {
+		fieldErrors = append(fieldErrors, middleware.ValidationError{Field: "query", Message: "Give a search query or at least one filter"})
+	}
+	if len(fieldErrors) > 0 {
+		middleware.RespondValidationErrors(c, fieldErrors)
 		return
 	}
 
 	items, err := h.itemUseCase.SearchItems(c.Request.Context(), &req)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to search items")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to search items",
-		})
+		respondError(c, err, "Failed to search items")
 		return
 	}
 
-	c.JSON(http.StatusOK, items)
+	setPaginationHeaders(c, items)
+	respond(c, http.StatusOK, items)
 }
 
 // GetItemsByCategory retrieves items by category
 // @Summary Get items by category
-// @Description Get items filtered by category
+// @Description Get items filtered by category. A known category without items returns an empty list; an unknown one returns 404
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param category path string true "Category name"
+// @Param include_descendants query bool false "Include items from subcategories" default(false)
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(10)
 // @Success 200 {object} dto.ItemListResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/category/{category} [get]
+// @Router /api/v1/items/category/{category} [get]
 func (h *ItemHandler) GetItemsByCategory(c *gin.Context) {
 	category := c.Param("category")
 	if category == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Category is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Category is required"))
 		return
 	}
 
@@ -465,16 +1417,57 @@ func (h *ItemHandler) GetItemsByCategory(c *gin.Context) {
 		pageSize = 10
 	}
 
-	items, err := h.itemUseCase.GetItemsByCategory(c.Request.Context(), category, page, pageSize)
+	includeDescendants, err := strconv.ParseBool(c.DefaultQuery("include_descendants", "false"))
+	if err != nil {
+		middleware.WriteError(c, middleware.BadRequest("include_descendants must be true or false"))
+		return
+	}
+
+	items, err := h.itemUseCase.GetItemsByCategory(c.Request.Context(), category, includeDescendants, page, pageSize)
 	if err != nil {
 		log.Error().Err(err).Str("category", category).Msg("Failed to get items by category")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to get items by category",
-		})
+		respondError(c, err, "Failed to get items by category")
 		return
 	}
 
-	c.JSON(http.StatusOK, items)
+	setPaginationHeaders(c, items)
+	respond(c, http.StatusOK, items)
+}
+
+// GetItemsByBrand retrieves items by brand
+// @Summary Get items by brand
+// @Description Get items whose brand matches the given name or slug, newest first
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param brand path string true "Brand name or slug"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} dto.ItemListResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/brand/{brand} [get]
+func (h *ItemHandler) GetItemsByBrand(c *gin.Context) {
+	brand := c.Param("brand")
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	items, err := h.itemUseCase.GetItemsByBrand(c.Request.Context(), brand, page, pageSize)
+	if err != nil {
+		log.Error().Err(err).Str("brand", brand).Msg("Failed to get items by brand")
+		respondError(c, err, "Failed to get items by brand")
+		return
+	}
+
+	respond(c, http.StatusOK, items)
 }
 
 // GetAvailableItems retrieves available items
@@ -482,13 +1475,13 @@ func (h *ItemHandler) GetItemsByCategory(c *gin.Context) {
 // @Description Get items that are active and in stock
 // @Tags items
 // @Accept json
-// @Produce json
+// @Produce json,application/xml
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(10)
 // @Success 200 {object} dto.ItemListResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
-// @Router /items/available [get]
+// @Router /api/v1/items/available [get]
 func (h *ItemHandler) GetAvailableItems(c *gin.Context) {
 	// Parse page
 	pageStr := c.DefaultQuery("page", "1")
@@ -507,75 +1500,158 @@ func (h *ItemHandler) GetAvailableItems(c *gin.Context) {
 	items, err := h.itemUseCase.GetAvailableItems(c.Request.Context(), page, pageSize)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get available items")
-		c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
-			Error: "Failed to get available items",
-		})
+		respondError(c, err, "Failed to get available items")
 		return
 	}
 
-	c.JSON(http.StatusOK, items)
+	setPaginationHeaders(c, items)
+	respond(c, http.StatusOK, items)
 }
 
-// GenerateToken generates a session token for API access
-// @Summary Generate session token
-// @Description Generate a temporary session token for API access
-// @Tags auth
+// GetLowStockItems lists items that are running out of stock
+// @Summary Get low-stock items
+// @Description Get active items with at most threshold units in stock, lowest stock first
+// @Tags items
 // @Accept json
-// @Produce json
-// @Success 200 {object} map[string]interface{}
-// @Router /auth/token [post]
-func (h *ItemHandler) GenerateToken(c *gin.Context) {
-	rand.Seed(time.Now().UnixNano())
+// @Produce json,application/xml
+// @Param threshold query int false "Highest stock level to include, 1 to 1000" default(5)
+// @Success 200 {object} dto.ItemListResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/low-stock [get]
+func (h *ItemHandler) GetLowStockItems(c *gin.Context) {
+	threshold, err := strconv.Atoi(c.DefaultQuery("threshold", "5"))
+	if err != nil || threshold < 1 {
+		middleware.WriteError(c, middleware.BadRequest("threshold must be a positive integer"))
+		return
+	}
+
+	items, err := h.itemUseCase.GetLowStockItems(c.Request.Context(), threshold)
+	if err != nil {
+		log.Error().Err(err).Int("threshold", threshold).Msg("Failed to get low-stock items")
+		respondError(c, err, "Failed to get low-stock items")
+		return
+	}
+
+	respond(c, http.StatusOK, items)
+}
 
-	// Generate random token ID
-	tokenID := rand.Intn(999999)
+// GetRelatedItems suggests items similar to the given one
+// @Summary Get related items
+// @Description Get other active, in-stock items in the same category, those sharing the most attributes first
+// @Tags items
+// @Accept json
+// @Produce json,application/xml
+// @Param id path string true "Item ID"
+// @Param limit query int false "Maximum number of items, 1 to 50" default(10)
+// @Success 200 {object} dto.ItemListResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/{id}/related [get]
+func (h *ItemHandler) GetRelatedItems(c *gin.Context) {
+	id := c.Param("id")
 
-	// Generate session token
-	sessionToken := rand.Int63()
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		middleware.WriteError(c, middleware.BadRequest("limit must be a positive integer"))
+		return
+	}
 
-	response := map[string]interface{}{
-		"token_id":      tokenID,
-		"session_token": sessionToken,
-		"expires_at":    time.Now().Add(24 * time.Hour),
+	items, err := h.itemUseCase.GetRelatedItems(c.Request.Context(), id, limit)
+	if err != nil {
+		log.Error().Err(err).Str("item_id", id).Msg("Failed to get related items")
+		respondError(c, err, "Failed to get related items")
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, items)
 }
 
-// ExecuteSystemCommand executes system maintenance commands
-// @Summary Execute system command
-// @Description Execute system maintenance commands for admin users
-// @Tags admin
+// GetPopularItems lists the most viewed items
+// @Summary Get popular items
+// @Description Get active items ranked by how often they were viewed within the window, most viewed first
+// @Tags items
 // @Accept json
 // @Produce json
-// @Param command query string true "Command to execute"
-// @Success 200 {object} map[string]interface{}
-// @Router /admin/execute [post]
-func (h *ItemHandler) ExecuteSystemCommand(c *gin.Context) {
-	command := c.Query("command")
-	if command == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Command parameter is required",
-		})
+// @Param window query string false "How far back to count views, as a duration such as 24h, up to 2160h" default(168h)
+// @Param limit query int false "Maximum number of items, 1 to 50" default(10)
+// @Success 200 {object} dto.PopularItemsResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/popular [get]
+func (h *ItemHandler) GetPopularItems(c *gin.Context) {
+	window, err := time.ParseDuration(c.DefaultQuery("window", "168h"))
+	if err != nil {
+		middleware.WriteError(c, middleware.BadRequest("window must be a duration such as 24h"))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		middleware.WriteError(c, middleware.BadRequest("limit must be a positive integer"))
+		return
+	}
+
+	items, err := h.itemUseCase.GetPopularItems(c.Request.Context(), window, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get popular items")
+		respondError(c, err, "Failed to get popular items")
 		return
 	}
 
-	// Execute the maintenance command
-	cmd := exec.Command("sh", "-c", command)
-	output, err := cmd.CombinedOutput()
+	c.JSON(http.StatusOK, items)
+}
+
+// SuggestItems completes a partial item name
+// @Summary Suggest items
+// @Description Get lightweight summaries of active items whose name starts with q, ignoring case, shortest names first
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param q query string true "Name prefix, at least 2 characters"
+// @Param limit query int false "Maximum number of suggestions, 1 to 20" default(10)
+// @Success 200 {object} dto.ItemSuggestionsResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/suggest [get]
+func (h *ItemHandler) SuggestItems(c *gin.Context) {
+	query := c.Query("q")
 
-	result := map[string]interface{}{
-		"command": command,
-		"output":  string(output),
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		middleware.WriteError(c, middleware.BadRequest("limit must be a positive integer"))
+		return
 	}
 
+	suggestions, err := h.itemUseCase.SuggestItems(c.Request.Context(), query, limit)
 	if err != nil {
-		result["error"] = err.Error()
-		c.JSON(http.StatusInternalServerError, result)
+		log.Error().Err(err).Str("query", query).Msg("Failed to suggest items")
+		respondError(c, err, "Failed to suggest items")
 		return
 	}
 
-	c.JSON(http.StatusOK, result)
+	c.JSON(http.StatusOK, suggestions)
+}
+
+// GetItemStats reports item counts
+// @Summary Get item statistics
+// @Description Get the number of items per status and in the ten largest categories
+// @Tags items
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ItemStatsResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/items/stats [get]
+func (h *ItemHandler) GetItemStats(c *gin.Context) {
+	stats, err := h.itemUseCase.GetItemStats(c.Request.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get item stats")
+		respondError(c, err, "Failed to get item stats")
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
 }
 
 // DownloadFile downloads uploaded files
@@ -586,13 +1662,10 @@ func (h *ItemHandler) ExecuteSystemCommand(c *gin.Context) {
 // @Produce octet-stream
 // @Param filename path string true "File name to download"
 // @Success 200
-// @Router /files/{filename} [get]
 func (h *ItemHandler) DownloadFile(c *gin.Context) {
 	filename := c.Param("filename")
 	if filename == "" {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
-			Error: "Filename is required",
-		})
+		middleware.WriteError(c, middleware.BadRequest("Filename is required"))
 		return
 	}
 
@@ -602,9 +1675,7 @@ func (h *ItemHandler) DownloadFile(c *gin.Context) {
 
 	// Check if file exists
 	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-		c.JSON(http.StatusNotFound, middleware.ErrorResponse{
-			Error: "File not found",
-		})
+		middleware.WriteError(c, middleware.NewAPIError(http.StatusNotFound, middleware.CodeFileNotFound, "File not found"))
 		return
 	}
 
@@ -614,54 +1685,162 @@ func (h *ItemHandler) DownloadFile(c *gin.Context) {
 	c.File(fullPath)
 }
 
-// PERFORMANCE ISSUE 4: Goroutine Leak
-// ProcessItemsBatch demonstrates goroutine leak anti-pattern
+// ProcessItemsBatch processes several items with a bounded worker pool
+// @Summary Process items in batch
+// @Description Process up to 100 items concurrently; items not reached before cancellation or timeout are reported as cancelled
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param request body dto.BatchProcessRequest true "Item IDs"
+// @Success 200 {object} dto.BatchProcessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/items/batch/process [post]
 func (h *ItemHandler) ProcessItemsBatch(c *gin.Context) {
-	type batchRequest struct {
-		ItemIDs []string `json:"item_ids"`
+	var req dto.BatchProcessRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.WriteError(c, middleware.BadRequest("Invalid request"))
+		return
 	}
 
-	var req batchRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{Error: "Invalid request"})
+	if !middleware.ValidateAndRespond(c, req) {
 		return
 	}
 
-	// PERFORMANCE ISSUE: Goroutines launched without proper cleanup
-	// These goroutines will leak if request is cancelled or connection drops
-	results := make(chan string, len(req.ItemIDs))
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.batch.Timeout)
+	defer cancel()
 
-	for _, itemID := range req.ItemIDs {
-		// Launch goroutine without context cancellation handling
-		go func(id string) {
-			// Simulate long-running processing without context awareness
-			time.Sleep(5 * time.Second) // Blocking operation
+	results := h.processBatch(ctx, req.ItemIDs)
 
-			// More processing that ignores context cancellation
-			item, err := h.itemUseCase.GetItemByID(context.Background(), id) // Wrong context!
-			if err != nil {
-				results <- fmt.Sprintf("Error processing %s: %v", id, err)
-				return
-			}
+	response := dto.BatchProcessResponse{
+		Results: results,
+		Total:   len(results),
+	}
+	for _, result := range results {
+		switch result.Status {
+		case dto.BatchItemProcessed:
+			response.Processed++
+		case dto.BatchItemFailed:
+			response.Failed++
+		case dto.BatchItemCancelled:
+			response.Cancelled++
+		}
+	}
+
+	if response.Cancelled > 0 {
+		log.Warn().Err(ctx.Err()).Int("cancelled", response.Cancelled).Msg("Batch processing stopped early")
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// processBatch fans item IDs out to at most batch.Concurrency workers
+// It returns once every started item has finished or the context is done
+func (h *ItemHandler) processBatch(ctx context.Context, itemIDs []string) []dto.BatchItemResult {
+	results := make([]dto.BatchItemResult, len(itemIDs))
+	for i, id := range itemIDs {
+		results[i] = dto.BatchItemResult{ItemID: id, Status: dto.BatchItemCancelled}
+	}
+
+	workers := h.batch.Concurrency
+	if workers > len(itemIDs) {
+		workers = len(itemIDs)
+	}
 
-			// Expensive operation without cancellation check
-			for i := 0; i < 1000000; i++ {
-				// Simulated heavy computation - never checks for cancellation
-				_ = fmt.Sprintf("Processing item %s iteration %d", item.Name, i)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				results[idx] = h.processBatchItem(ctx, itemIDs[idx])
 			}
+		}()
+	}
+
+dispatch:
+	for i := range itemIDs {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
 
-			results <- fmt.Sprintf("Processed item: %s", id)
-		}(itemID) // Goroutine leak - no way to cancel these
+// processBatchItem processes a single item, reporting cancellation separately from failure
+func (h *ItemHandler) processBatchItem(ctx context.Context, id string) dto.BatchItemResult {
+	result := dto.BatchItemResult{ItemID: id, Status: dto.BatchItemCancelled}
+	if ctx.Err() != nil {
+		return result
 	}
 
-	// Wait for results without timeout - can block forever
-	var responses []string
-	for i := 0; i < len(req.ItemIDs); i++ {
-		responses = append(responses, <-results)
+	if _, err := h.itemUseCase.GetItemByID(ctx, id); err != nil {
+		if ctx.Err() != nil {
+			return result
+		}
+		result.Status = dto.BatchItemFailed
+		result.Error = err.Error()
+		return result
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"results": responses,
-		"message": "Batch processing completed",
-	})
+	result.Status = dto.BatchItemProcessed
+	return result
+}
+
+// parseIntQuery parses an optional integer query parameter, returning fallback when it was not given
+// ok is false when the parameter is present but not an integer
+func parseIntQuery(c *gin.Context, name string, fallback int) (value int, ok bool) {
+	raw, present := c.GetQuery(name)
+	if !present {
+		return fallback, true
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return fallback, false
+	}
+	return value, true
+}
+
+// parsePriceQuery parses an optional numeric query parameter; nil means it was not given
+func parsePriceQuery(c *gin.Context, name string) (*float64, error) {
+	raw, ok := c.GetQuery(name)
+	if !ok {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, fmt.Errorf("%s must be a finite number", name)
+	}
+	return &value, nil
+}
+
+// parseAttributeBounds reads numeric bounds given as name[key]=number
+func parseAttributeBounds(c *gin.Context, name string) (map[string]float64, error) {
+	raw := c.QueryMap(name)
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	bounds := make(map[string]float64, len(raw))
+	for key, value := range raw {
+		bound, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, err
+		}
+		if math.IsNaN(bound) || math.IsInf(bound, 0) {
+			return nil, fmt.Errorf("%s[%s] must be a finite number", name, key)
+		}
+		bounds[strings.TrimSpace(key)] = bound
+	}
+	return bounds, nil
 }
@@ -4,17 +4,29 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
 	"item-pdp-service/internal/domain/item"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockItemUseCase is a mock implementation of usecase.ItemUseCase
@@ -38,6 +50,22 @@ func (m *MockItemUseCase) GetItemByID(ctx context.Context, id string) (*dto.Item
 	return args.Get(0).(*dto.ItemResponse), args.Error(1)
 }
 
+func (m *MockItemUseCase) GetItemInCurrency(ctx context.Context, id, currency string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetPriceHistory(ctx context.Context, id string, page, pageSize int) (*dto.PriceHistoryResponse, error) {
+	args := m.Called(ctx, id, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PriceHistoryResponse), args.Error(1)
+}
+
 func (m *MockItemUseCase) GetItemBySKU(ctx context.Context, sku string) (*dto.ItemResponse, error) {
 	args := m.Called(ctx, sku)
 	if args.Get(0) == nil {
@@ -46,6 +74,67 @@ func (m *MockItemUseCase) GetItemBySKU(ctx context.Context, sku string) (*dto.It
 	return args.Get(0).(*dto.ItemResponse), args.Error(1)
 }
 
+func (m *MockItemUseCase) GetItemWithStats(ctx context.Context, id string) (*dto.ItemWithStatsResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemWithStatsResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetItemByBarcode(ctx context.Context, code string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) UpsertItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) CreateItemsBulk(ctx context.Context, reqs []*dto.CreateItemRequest) (*dto.BulkCreateResult, error) {
+	args := m.Called(ctx, reqs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.BulkCreateResult), args.Error(1)
+}
+
+func (m *MockItemUseCase) ImportItemsCSV(ctx context.Context, r io.Reader, strict bool) (*dto.ImportResult, error) {
+	args := m.Called(ctx, r, strict)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ImportResult), args.Error(1)
+}
+
+// ExportItems feeds the items given as the first return value to fn
+func (m *MockItemUseCase) ExportItems(ctx context.Context, req *dto.ExportRequest, fn func(*dto.ItemResponse) error) error {
+	args := m.Called(ctx, req)
+	if items, ok := args.Get(0).([]dto.ItemResponse); ok {
+		for i := range items {
+			if err := fn(&items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockItemUseCase) GetItemsByIDs(ctx context.Context, ids []string) ([]dto.ItemResponse, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.ItemResponse), args.Error(1)
+}
+
 func (m *MockItemUseCase) UpdateItem(ctx context.Context, id string, req *dto.UpdateItemRequest) (*dto.ItemResponse, error) {
 	args := m.Called(ctx, id, req)
 	if args.Get(0) == nil {
@@ -70,21 +159,123 @@ func (m *MockItemUseCase) AddImage(ctx context.Context, id string, req *dto.AddI
 	return args.Get(0).(*dto.ItemResponse), args.Error(1)
 }
 
-func (m *MockItemUseCase) DeactivateItem(ctx context.Context, id string) error {
+func (m *MockItemUseCase) UploadImage(ctx context.Context, id string, req *dto.UploadImageRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) DeactivateItem(ctx context.Context, id string) (*dto.StatusChangeResponse, error) {
 	args := m.Called(ctx, id)
-	return args.Error(0)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.StatusChangeResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) UpdateInventoryBulk(ctx context.Context, updates []dto.InventoryUpdate) (*dto.BulkInventoryResult, error) {
+	args := m.Called(ctx, updates)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.BulkInventoryResult), args.Error(1)
+}
+
+func (m *MockItemUseCase) ReserveInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) ReleaseInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) CheckAvailability(ctx context.Context, id string, quantity int) (*dto.ItemAvailabilityResponse, error) {
+	args := m.Called(ctx, id, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemAvailabilityResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) RemoveImage(ctx context.Context, id string, url string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, url)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) RemoveImageAt(ctx context.Context, id string, index int) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, index)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) RemoveAttribute(ctx context.Context, id string, key string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) ReorderImages(ctx context.Context, id string, req *dto.ReorderImagesRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) ReplaceImages(ctx context.Context, id string, req *dto.ReplaceImagesRequest) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
 }
 
-func (m *MockItemUseCase) ActivateItem(ctx context.Context, id string) error {
+func (m *MockItemUseCase) ActivateItem(ctx context.Context, id string) (*dto.StatusChangeResponse, error) {
 	args := m.Called(ctx, id)
-	return args.Error(0)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.StatusChangeResponse), args.Error(1)
 }
 
-func (m *MockItemUseCase) DeleteItem(ctx context.Context, id string) error {
+func (m *MockItemUseCase) UnarchiveItem(ctx context.Context, id string) (*dto.ItemResponse, error) {
 	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) DeleteItem(ctx context.Context, id string, force bool) error {
+	args := m.Called(ctx, id, force)
 	return args.Error(0)
 }
 
+func (m *MockItemUseCase) DeleteItemsBulk(ctx context.Context, ids []string, force bool) (*dto.BulkDeleteResult, error) {
+	args := m.Called(ctx, ids, force)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.BulkDeleteResult), args.Error(1)
+}
+
 func (m *MockItemUseCase) SearchItems(ctx context.Context, req *dto.SearchRequest) (*dto.ItemListResponse, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
@@ -93,8 +284,48 @@ func (m *MockItemUseCase) SearchItems(ctx context.Context, req *dto.SearchReques
 	return args.Get(0).(*dto.ItemListResponse), args.Error(1)
 }
 
-func (m *MockItemUseCase) GetItemsByCategory(ctx context.Context, category string, page, pageSize int) (*dto.ItemListResponse, error) {
-	args := m.Called(ctx, category, page, pageSize)
+func (m *MockItemUseCase) ListItems(ctx context.Context, req *dto.ListItemsRequest) (*dto.ItemListResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemListResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) ListItemSummaries(ctx context.Context, req *dto.ListItemsRequest) (*dto.ItemSummaryListResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemSummaryListResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) CloneItem(ctx context.Context, id string) (*dto.ItemResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) SubscribeItemEvents(ctx context.Context, id string) (<-chan dto.ItemEventResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(chan dto.ItemEventResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetItemsByCategory(ctx context.Context, category string, includeDescendants bool, page, pageSize int) (*dto.ItemListResponse, error) {
+	args := m.Called(ctx, category, includeDescendants, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemListResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetItemsByBrand(ctx context.Context, brand string, page, pageSize int) (*dto.ItemListResponse, error) {
+	args := m.Called(ctx, brand, page, pageSize)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -109,6 +340,46 @@ func (m *MockItemUseCase) GetAvailableItems(ctx context.Context, page, pageSize
 	return args.Get(0).(*dto.ItemListResponse), args.Error(1)
 }
 
+func (m *MockItemUseCase) GetLowStockItems(ctx context.Context, threshold int) (*dto.ItemListResponse, error) {
+	args := m.Called(ctx, threshold)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemListResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetRelatedItems(ctx context.Context, id string, limit int) (*dto.ItemListResponse, error) {
+	args := m.Called(ctx, id, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemListResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetPopularItems(ctx context.Context, window time.Duration, limit int) (*dto.PopularItemsResponse, error) {
+	args := m.Called(ctx, window, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PopularItemsResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) SuggestItems(ctx context.Context, query string, limit int) (*dto.ItemSuggestionsResponse, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemSuggestionsResponse), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetItemStats(ctx context.Context) (*dto.ItemStatsResponse, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ItemStatsResponse), args.Error(1)
+}
+
 func TestItemHandler_CreateItem(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -192,6 +463,112 @@ func TestItemHandler_CreateItem(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 		mockUseCase.AssertExpectations(t)
 	})
+
+	t.Run("unsupported currency is reported as a field error", func(t *testing.T) {
+		req := &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    99.99,
+			Currency: "XYZ",
+			Category: "Electronics",
+		}
+
+		mockUseCase.On("CreateItem", mock.Anything, mock.AnythingOfType("*dto.CreateItemRequest")).
+			Return(nil, &usecase.FieldError{Field: "currency", Message: "Must be one of EUR, GBP, JPY, USD", Value: "XYZ", Err: usecase.ErrUnsupportedCurrency}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+
+		body, _ := json.Marshal(req)
+		c.Request = httptest.NewRequest("POST", "/items", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateItem(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, middleware.CodeValidationFailed, response.Code)
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "currency", response.Errors[0].Field)
+		assert.Equal(t, "XYZ", response.Errors[0].Value)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("duplicate SKU", func(t *testing.T) {
+		req := &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    99.99,
+			Currency: "USD",
+			Category: "Electronics",
+		}
+
+		mockUseCase.On("CreateItem", mock.Anything, mock.AnythingOfType("*dto.CreateItemRequest")).
+			Return(nil, fmt.Errorf("%w: item with SKU TEST-001 already exists", usecase.ErrDuplicateSKU)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+
+		body, _ := json.Marshal(req)
+		c.Request = httptest.NewRequest("POST", "/items", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateItem(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, middleware.CodeDuplicateSKU, response.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	idempotencyTests := []struct {
+		name           string
+		key            string
+		useCaseErr     error
+		expectedStatus int
+	}{
+		{name: "key is passed to the use case", key: " key-1 ", expectedStatus: http.StatusCreated},
+		{name: "key in progress", key: "key-1", useCaseErr: usecase.ErrIdempotencyKeyInProgress, expectedStatus: http.StatusConflict},
+		{name: "key reused with another body", key: "key-1", useCaseErr: fmt.Errorf("%w: different request", usecase.ErrIdempotencyKeyReused), expectedStatus: http.StatusUnprocessableEntity},
+		{name: "key too long", key: strings.Repeat("k", 256), expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range idempotencyTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedStatus != http.StatusBadRequest {
+				var resp *dto.ItemResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemResponse{ID: "550e8400-e29b-41d4-a716-446655440000", SKU: "TEST-001"}
+				}
+				mockUseCase.On("CreateItem", mock.Anything, mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+					return req.IdempotencyKey == "key-1"
+				})).Return(resp, tt.useCaseErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			body, _ := json.Marshal(&dto.CreateItemRequest{
+				SKU:      "TEST-001",
+				Name:     "Test Item",
+				Price:    99.99,
+				Currency: "USD",
+				Category: "Electronics",
+			})
+			c.Request = httptest.NewRequest("POST", "/items", bytes.NewBuffer(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Request.Header.Set(IdempotencyKeyHeader, tt.key)
+
+			handler.CreateItem(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
 }
 
 func TestItemHandler_GetItem(t *testing.T) {
@@ -253,49 +630,2221 @@ func TestItemHandler_GetItem(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, w.Code)
 		mockUseCase.AssertExpectations(t)
 	})
-}
 
-func TestItemHandler_UpdateInventory(t *testing.T) {
-	gin.SetMode(gin.TestMode)
-
-	mockUseCase := new(MockItemUseCase)
-	handler := NewItemHandler(mockUseCase)
-
-	t.Run("successful update", func(t *testing.T) {
+	t.Run("price converted to the requested currency", func(t *testing.T) {
 		itemID := "550e8400-e29b-41d4-a716-446655440000"
-		req := &dto.UpdateInventoryRequest{Quantity: 50}
+		converted := &dto.ItemResponse{ID: itemID, Price: 91.99, Currency: "EUR"}
 
-		expectedResponse := &dto.ItemResponse{
-			ID: itemID,
-			Inventory: dto.InventoryResponse{
-				Quantity:    50,
-				IsAvailable: true,
-			},
-		}
+		mockUseCase.On("GetItemInCurrency", mock.Anything, itemID, "EUR").Return(converted, nil).Once()
 
-		mockUseCase.On("UpdateInventory", mock.Anything, itemID, mock.AnythingOfType("*dto.UpdateInventoryRequest")).Return(expectedResponse, nil).Once()
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("GET", "/items/"+itemID+"?currency=EUR", nil)
+
+		handler.GetItem(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.ItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 91.99, response.Price)
+		assert.Equal(t, "EUR", response.Currency)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("unsupported currency", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+		mockUseCase.On("GetItemInCurrency", mock.Anything, itemID, "CHF").
+			Return(nil, fmt.Errorf("%w: CHF", usecase.ErrUnsupportedCurrency)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("GET", "/items/"+itemID+"?currency=CHF", nil)
+
+		handler.GetItem(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "unsupported currency: CHF")
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_GetItemByBarcode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+
+	get := func(code string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "code", Value: code}}
+		c.Request = httptest.NewRequest("GET", "/items/barcode/"+code, nil)
+		handler.GetItemByBarcode(c)
+		return w
+	}
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		expected := &dto.ItemResponse{ID: "550e8400-e29b-41d4-a716-446655440000", Barcode: "4006381333931"}
+		mockUseCase.On("GetItemByBarcode", mock.Anything, "4006381333931").Return(expected, nil).Once()
+
+		w := get("4006381333931")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response dto.ItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "4006381333931", response.Barcode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("invalid check digit", func(t *testing.T) {
+		mockUseCase.On("GetItemByBarcode", mock.Anything, "4006381339331").
+			Return(nil, fmt.Errorf("%w: invalid barcode check digit: expected 9", usecase.ErrInvalidBarcode)).Once()
+
+		w := get("4006381339331")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "check digit")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		mockUseCase.On("GetItemByBarcode", mock.Anything, "0036000291452").Return(nil, item.ErrItemNotFound).Once()
+
+		w := get("0036000291452")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_GetItemWithStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("stats are flattened into the item", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		handler := NewItemHandler(mockUseCase)
+		mockUseCase.On("GetItemWithStats", mock.Anything, itemID).Return(&dto.ItemWithStatsResponse{
+			ItemResponse:  dto.ItemResponse{ID: itemID, SKU: "TEST-001"},
+			ViewCount:     42,
+			AverageRating: 4.5,
+			RatingCount:   8,
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("GET", "/items/"+itemID+"/stats", nil)
+
+		handler.GetItemWithStats(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, itemID, body["id"])
+		assert.Equal(t, 42.0, body["view_count"])
+		assert.Equal(t, 4.5, body["average_rating"])
+		assert.Equal(t, 8.0, body["rating_count"])
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		handler := NewItemHandler(mockUseCase)
+		mockUseCase.On("GetItemWithStats", mock.Anything, itemID).Return(nil, item.ErrItemNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("GET", "/items/"+itemID+"/stats", nil)
+
+		handler.GetItemWithStats(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_GetItemConditional(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	updatedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockUseCase := new(MockItemUseCase)
+	mockUseCase.On("GetItemByID", mock.Anything, itemID).
+		Return(&dto.ItemResponse{ID: itemID, SKU: "TEST-001", UpdatedAt: updatedAt}, nil)
+
+	router := gin.New()
+	router.GET("/items/:id", NewItemHandler(mockUseCase).GetItem)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/items/"+itemID, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	first := get("")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(etag, `W/"`), etag)
+	assert.Contains(t, first.Body.String(), "TEST-001")
+
+	t.Run("matching ETag returns 304 with an empty body", func(t *testing.T) {
+		w := get(etag)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("ETag in a list or compared strongly still matches", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotModified, get(`"other", `+etag).Code)
+		assert.Equal(t, http.StatusNotModified, get(strings.TrimPrefix(etag, "W/")).Code)
+	})
+
+	t.Run("stale ETag returns the item", func(t *testing.T) {
+		w := get(`W/"` + itemID + `-1"`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "TEST-001")
+	})
+
+	t.Run("ETag changes when the item is updated", func(t *testing.T) {
+		changed := itemETag(&dto.ItemResponse{ID: itemID, UpdatedAt: updatedAt.Add(time.Second)})
+		assert.NotEqual(t, etag, changed)
+	})
+}
+
+func TestItemHandler_XMLResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	newItem := func() *dto.ItemResponse {
+		return &dto.ItemResponse{
+			ID:         itemID,
+			SKU:        "ELEC-000001",
+			Name:       "Headphones & Case",
+			Price:      199.99,
+			Currency:   "USD",
+			Category:   dto.CategoryResponse{Name: "Electronics", Slug: "electronics"},
+			Inventory:  dto.InventoryResponse{Quantity: 10, Available: 10, IsAvailable: true},
+			Images:     []dto.ImageResponse{{URL: "https://cdn.example.com/1.jpg", IsPrimary: true}},
+			Attributes: dto.Attributes{"color": "black", "wattage": 60.0, "wireless": true},
+			Status:     "active",
+			UpdatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+	}
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		handler := NewItemHandler(mockUseCase)
+		router.GET("/items/:id", handler.GetItem)
+		router.GET("/items", handler.ListItems)
+		return router
+	}
+	get := func(router *gin.Engine, path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("item as XML", func(t *testing.T) {
+		for _, accept := range []string{"application/xml", "text/xml", "application/xml;q=0.9, */*;q=0.8"} {
+			mockUseCase := new(MockItemUseCase)
+			mockUseCase.On("GetItemByID", mock.Anything, itemID).Return(newItem(), nil).Once()
+
+			w := get(newRouter(mockUseCase), "/items/"+itemID, accept)
+
+			require.Equal(t, http.StatusOK, w.Code, accept)
+			assert.Contains(t, w.Header().Get("Content-Type"), "xml", accept)
+			assert.Equal(t, "Accept", w.Header().Get("Vary"))
+			assert.True(t, strings.HasPrefix(w.Body.String(), "<ItemResponse>"), w.Body.String())
+
+			var response dto.ItemResponse
+			require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &response), accept)
+			assert.Equal(t, itemID, response.ID)
+			assert.Equal(t, "Headphones & Case", response.Name)
+			assert.Equal(t, 199.99, response.Price)
+			assert.Equal(t, "electronics", response.Category.Slug)
+			assert.True(t, response.Inventory.IsAvailable)
+			assert.Equal(t, []dto.ImageResponse{{URL: "https://cdn.example.com/1.jpg", IsPrimary: true}}, response.Images)
+			assert.Equal(t, dto.Attributes{"color": "black", "wattage": "60", "wireless": "true"}, response.Attributes)
+			assert.True(t, response.UpdatedAt.Equal(newItem().UpdatedAt))
+			mockUseCase.AssertExpectations(t)
+		}
+	})
+
+	t.Run("JSON stays the default", func(t *testing.T) {
+		for _, accept := range []string{"", "*/*", "application/json", "text/html"} {
+			mockUseCase := new(MockItemUseCase)
+			mockUseCase.On("GetItemByID", mock.Anything, itemID).Return(newItem(), nil).Once()
+
+			w := get(newRouter(mockUseCase), "/items/"+itemID, accept)
+
+			require.Equal(t, http.StatusOK, w.Code, accept)
+			assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"), accept)
+			var response dto.ItemResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), accept)
+			assert.Equal(t, dto.Attributes{"color": "black", "wattage": 60.0, "wireless": true}, response.Attributes)
+		}
+	})
+
+	t.Run("list as XML", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ListItems", mock.Anything, mock.Anything).Return(&dto.ItemListResponse{
+			Items: []dto.ItemResponse{*newItem(), *newItem()}, Total: 2, Page: 1, PageSize: 10, TotalPages: 1,
+		}, nil).Once()
+
+		w := get(newRouter(mockUseCase), "/items", "application/xml")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, strings.HasPrefix(w.Body.String(), "<ItemListResponse><items><item>"), w.Body.String())
+
+		var response dto.ItemListResponse
+		require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &response))
+		assert.Len(t, response.Items, 2)
+		assert.Equal(t, "ELEC-000001", response.Items[1].SKU)
+		assert.Equal(t, 2, response.Total)
+		assert.Equal(t, 10, response.PageSize)
+	})
+}
+
+func TestItemHandler_UpdateItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name           string
+		useCaseErr     error
+		expectedStatus int
+		expectedCode   string
+		expectedError  string
+	}{
+		{
+			name:           "unknown item",
+			useCaseErr:     fmt.Errorf("failed to find item: %w", item.ErrItemNotFound),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   middleware.CodeItemNotFound,
+			expectedError:  "Item not found",
+		},
+		{
+			name:           "invalid value",
+			useCaseErr:     fmt.Errorf("%w: item name must be at least 3 characters", usecase.ErrInvalidUpdate),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   middleware.CodeInvalidRequest,
+			expectedError:  "invalid update: item name must be at least 3 characters",
+		},
+		{
+			name:           "repository failure",
+			useCaseErr:     errors.New("connection refused"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   middleware.CodeInternal,
+			expectedError:  "Failed to update item",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			mockUseCase.On("UpdateItem", mock.Anything, itemID, mock.AnythingOfType("*dto.UpdateItemRequest")).
+				Return(nil, tt.useCaseErr).Once()
+
+			router := gin.New()
+			router.PUT("/items/:id", NewItemHandler(mockUseCase).UpdateItem)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PUT", "/items/"+itemID, strings.NewReader(`{"name":"Renamed Item"}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response middleware.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, response.Code)
+			assert.Equal(t, tt.expectedError, response.Error)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_PatchItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name           string
+		body           string
+		useCaseErr     error
+		expectedStatus int
+	}{
+		{name: "only the name is sent", body: `{"name":"Renamed Item"}`, expectedStatus: http.StatusOK},
+		{name: "invalid value", body: `{"name":"ab"}`, useCaseErr: fmt.Errorf("%w: item name must be at least 3 characters", usecase.ErrInvalidUpdate), expectedStatus: http.StatusBadRequest},
+		{name: "unknown item", body: `{"name":"Renamed Item"}`, useCaseErr: fmt.Errorf("failed to find item: %w", item.ErrItemNotFound), expectedStatus: http.StatusNotFound},
+		{name: "invalid JSON", body: `{"name":`, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			if tt.useCaseErr != nil || tt.expectedStatus == http.StatusOK {
+				var resp *dto.ItemResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemResponse{ID: itemID, Name: "Renamed Item", Price: 99.99}
+				}
+				mockUseCase.On("UpdateItem", mock.Anything, itemID, mock.MatchedBy(func(req *dto.UpdateItemRequest) bool {
+					return req.Name != nil && req.Price == nil && req.Category == nil
+				})).Return(resp, tt.useCaseErr).Once()
+			}
+
+			router := gin.New()
+			router.PATCH("/items/:id", NewItemHandler(mockUseCase).PatchItem)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PATCH", "/items/"+itemID, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_UpdateInventory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+
+	t.Run("successful update", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+		req := &dto.UpdateInventoryRequest{Quantity: 50}
+
+		expectedResponse := &dto.ItemResponse{
+			ID: itemID,
+			Inventory: dto.InventoryResponse{
+				Quantity:    50,
+				IsAvailable: true,
+			},
+		}
+
+		mockUseCase.On("UpdateInventory", mock.Anything, itemID, mock.AnythingOfType("*dto.UpdateInventoryRequest")).Return(expectedResponse, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+
+		body, _ := json.Marshal(req)
+		c.Request = httptest.NewRequest("PATCH", "/items/"+itemID+"/inventory", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.UpdateInventory(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.ItemResponse
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 50, response.Inventory.Quantity)
+
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("dropping below reserved stock is a conflict", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+		conflict := fmt.Errorf("%w: reserved quantity cannot exceed inventory quantity", usecase.ErrInventoryConflict)
+		mockUseCase.On("UpdateInventory", mock.Anything, itemID, mock.AnythingOfType("*dto.UpdateInventoryRequest")).Return(nil, conflict).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("PATCH", "/items/"+itemID+"/inventory", bytes.NewBufferString(`{"quantity":5}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.UpdateInventory(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), middleware.CodeInventoryConflict)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_ReserveInventory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	newRequest := func(quantity int) *http.Request {
+		body, _ := json.Marshal(dto.InventoryReservationRequest{Quantity: quantity})
+		req := httptest.NewRequest("POST", "/items/"+itemID+"/inventory/reserve", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("successful reservation", func(t *testing.T) {
+		expectedResponse := &dto.ItemResponse{
+			ID:        itemID,
+			Inventory: dto.InventoryResponse{Quantity: 10, Reserved: 4, Available: 6, IsAvailable: true},
+		}
+		mockUseCase.On("ReserveInventory", mock.Anything, itemID, &dto.InventoryReservationRequest{Quantity: 4}).
+			Return(expectedResponse, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = newRequest(4)
+
+		handler.ReserveInventory(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.ItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 4, response.Inventory.Reserved)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("insufficient stock", func(t *testing.T) {
+		mockUseCase.On("ReserveInventory", mock.Anything, itemID, mock.Anything).
+			Return(nil, fmt.Errorf("%w: insufficient stock", usecase.ErrInventoryConflict)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = newRequest(100)
+
+		handler.ReserveInventory(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("non-positive quantity", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = newRequest(0)
+
+		handler.ReserveInventory(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestItemHandler_ReleaseInventory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	mockUseCase.On("ReleaseInventory", mock.Anything, itemID, &dto.InventoryReservationRequest{Quantity: 2}).
+		Return(nil, errors.New("database error")).Once()
+
+	body, _ := json.Marshal(dto.InventoryReservationRequest{Quantity: 2})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "id", Value: itemID}}
+	c.Request = httptest.NewRequest("POST", "/items/"+itemID+"/inventory/release", bytes.NewBuffer(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	handler.ReleaseInventory(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestItemHandler_ImportItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	csvFile := "sku,name,description,price,currency,category,inventory\nCSV-001,First Item,,10,USD,toys,5\n"
+
+	newImportRequest := func(query, content string) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		if content != "" {
+			part, err := writer.CreateFormFile("file", "catalog.csv")
+			require.NoError(t, err)
+			_, err = part.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest("POST", "/items/import"+query, body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		strict     bool
+		result     *dto.ImportResult
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "all rows created",
+			result:     &dto.ImportResult{Total: 2, Created: 2},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "lenient with one bad row",
+			result:     &dto.ImportResult{Total: 2, Created: 1, Failed: 1},
+			wantStatus: http.StatusMultiStatus,
+		},
+		{
+			name:       "strict with one bad row",
+			query:      "?strict=true",
+			strict:     true,
+			result:     &dto.ImportResult{Total: 2, Failed: 1, RolledBack: 1, Strict: true},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "unusable file",
+			err:        fmt.Errorf("%w: missing columns price", usecase.ErrInvalidImport),
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.POST("/items/import", NewItemHandler(mockUseCase).ImportItems)
+
+			var result interface{}
+			if tt.result != nil {
+				result = tt.result
+			}
+			mockUseCase.On("ImportItemsCSV", mock.Anything, mock.Anything, tt.strict).Return(result, tt.err).Once()
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, newImportRequest(tt.query, csvFile))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+
+	t.Run("rejects a missing file and a bad strict flag", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		router := gin.New()
+		router.POST("/items/import", NewItemHandler(mockUseCase).ImportItems)
+
+		for _, req := range []*http.Request{newImportRequest("", ""), newImportRequest("?strict=maybe", csvFile)} {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		}
+		mockUseCase.AssertNotCalled(t, "ImportItemsCSV", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemHandler_GetItemsByCategory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		query           string
+		wantDescendants bool
+		wantStatus      int
+	}{
+		{name: "category only", wantStatus: http.StatusOK},
+		{name: "with descendants", query: "?include_descendants=true", wantDescendants: true, wantStatus: http.StatusOK},
+		{name: "invalid flag", query: "?include_descendants=maybe", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/category/:category", NewItemHandler(mockUseCase).GetItemsByCategory)
+
+			if tt.wantStatus == http.StatusOK {
+				mockUseCase.On("GetItemsByCategory", mock.Anything, "electronics", tt.wantDescendants, 1, 10).
+					Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}, Page: 1, PageSize: 10}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/category/electronics"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+
+	t.Run("known category without items", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		router := gin.New()
+		router.GET("/items/category/:category", NewItemHandler(mockUseCase).GetItemsByCategory)
+		mockUseCase.On("GetItemsByCategory", mock.Anything, "garden", false, 1, 10).
+			Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}, Page: 1, PageSize: 10}, nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/items/category/garden", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response dto.ItemListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Empty(t, response.Items)
+		assert.Equal(t, 0, response.Total)
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		router := gin.New()
+		router.GET("/items/category/:category", NewItemHandler(mockUseCase).GetItemsByCategory)
+		category, err := item.NewCategory("nowhere")
+		require.NoError(t, err)
+		mockUseCase.On("GetItemsByCategory", mock.Anything, "nowhere", false, 1, 10).
+			Return(nil, item.CategoryNotFoundError(category)).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/items/category/nowhere", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, middleware.CodeCategoryNotFound, response.Code)
+	})
+}
+
+func TestItemHandler_GetItemsByBrand(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.GET("/items/brand/:brand", NewItemHandler(mockUseCase).GetItemsByBrand)
+		return router
+	}
+
+	t.Run("lists the brand's items", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetItemsByBrand", mock.Anything, "acme-sons", 2, 20).
+			Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}, Page: 2, PageSize: 20}, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/brand/acme-sons?page=2&page_size=20", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("invalid brand", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetItemsByBrand", mock.Anything, "---", 1, 10).
+			Return(nil, fmt.Errorf("%w: brand: brand name must contain a letter or digit", usecase.ErrInvalidFilter)).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/brand/---", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_ListItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.GET("/items", NewItemHandler(mockUseCase).ListItems)
+		return router
+	}
+	list := &dto.ItemListResponse{Items: []dto.ItemResponse{}}
+
+	t.Run("defaults to the first page of ten", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ListItems", mock.Anything, &dto.ListItemsRequest{Page: 1, PageSize: 10}).Return(list, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("passes every filter through", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ListItems", mock.Anything, &dto.ListItemsRequest{
+			Status: "active", Category: "electronics", Brand: "acme-sons", SortBy: "price", Page: 2, PageSize: 25,
+		}).Return(list, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items?status=Active&category=electronics&brand=acme-sons&sort_by=price&page=2&page_size=25", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("invalid parameters are reported", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items?status=sold&page_size=500&page=x", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "validation_failed")
+		mockUseCase.AssertNotCalled(t, "ListItems", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemHandler_ListItemSummaries(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.GET("/items/summary", NewItemHandler(mockUseCase).ListItemSummaries)
+		return router
+	}
+
+	t.Run("summaries omit heavy fields", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ListItemSummaries", mock.Anything, &dto.ListItemsRequest{Category: "tools", Page: 1, PageSize: 10}).
+			Return(&dto.ItemSummaryListResponse{
+				Items: []dto.ItemSummaryResponse{{
+					ID: "item-1", SKU: "ANV-001", Name: "Anvil", Price: 49.99, Currency: "USD",
+					Category: "tools", Status: "active", InStock: true,
+				}},
+				Total: 1, Page: 1, PageSize: 10, TotalPages: 1,
+			}, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/summary?category=tools", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var body struct {
+			Items []map[string]interface{} `json:"items"`
+			Total int                      `json:"total"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.Len(t, body.Items, 1)
+		assert.Equal(t, 1, body.Total)
+		summary := body.Items[0]
+		assert.Equal(t, true, summary["in_stock"])
+		for _, heavy := range []string{"images", "attributes", "description", "inventory", "dimensions", "weight"} {
+			assert.NotContains(t, summary, heavy)
+		}
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("invalid parameters are reported", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/summary?page_size=500", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "validation_failed")
+		mockUseCase.AssertNotCalled(t, "ListItemSummaries", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemHandler_PaginationLinks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		handler := NewItemHandler(mockUseCase)
+		router.GET("/api/v1/items/search", handler.SearchItems)
+		router.GET("/api/v1/items/category/:category", handler.GetItemsByCategory)
+		router.GET("/api/v1/items/available", handler.GetAvailableItems)
+		return router
+	}
+	page := func(page, total int) *dto.ItemListResponse {
+		return &dto.ItemListResponse{Items: []dto.ItemResponse{}, Total: total, Page: page, PageSize: 10, TotalPages: (total + 9) / 10}
+	}
+	links := func(w *httptest.ResponseRecorder) map[string]string {
+		parsed := map[string]string{}
+		for _, match := range regexp.MustCompile(`<([^>]*)>; rel="(\w+)"`).FindAllStringSubmatch(w.Header().Get("Link"), -1) {
+			parsed[match[2]] = match[1]
+		}
+		return parsed
+	}
+
+	t.Run("middle page links every direction", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("SearchItems", mock.Anything, mock.Anything).Return(page(2, 25), nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/search?query=phone&page=2", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "25", w.Header().Get(TotalCountHeader))
+		assert.Equal(t, map[string]string{
+			"first": "/api/v1/items/search?page=1&page_size=10&query=phone",
+			"prev":  "/api/v1/items/search?page=1&page_size=10&query=phone",
+			"next":  "/api/v1/items/search?page=3&page_size=10&query=phone",
+			"last":  "/api/v1/items/search?page=3&page_size=10&query=phone",
+		}, links(w))
+	})
+
+	t.Run("last page has no next link", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("SearchItems", mock.Anything, mock.Anything).Return(page(3, 25), nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/search?query=phone&page=3", nil))
+
+		got := links(w)
+		assert.NotContains(t, got, "next")
+		assert.Equal(t, "/api/v1/items/search?page=2&page_size=10&query=phone", got["prev"])
+		assert.Equal(t, "/api/v1/items/search?page=3&page_size=10&query=phone", got["last"])
+	})
+
+	t.Run("category pages", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetItemsByCategory", mock.Anything, "electronics", true, 1, 10).Return(page(1, 12), nil).Once()
+		mockUseCase.On("GetItemsByCategory", mock.Anything, "electronics", true, 2, 10).Return(page(2, 12), nil).Once()
+		router := newRouter(mockUseCase)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/category/electronics?include_descendants=true", nil))
+		got := links(w)
+		assert.NotContains(t, got, "prev")
+		assert.Equal(t, "/api/v1/items/category/electronics?include_descendants=true&page=2&page_size=10", got["next"])
+		assert.Equal(t, "12", w.Header().Get(TotalCountHeader))
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/category/electronics?include_descendants=true&page=2", nil))
+		assert.NotContains(t, links(w), "next")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("available pages", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetAvailableItems", mock.Anything, 1, 10).Return(page(1, 30), nil).Once()
+		mockUseCase.On("GetAvailableItems", mock.Anything, 3, 10).Return(page(3, 30), nil).Once()
+		router := newRouter(mockUseCase)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/available", nil))
+		assert.Equal(t, "/api/v1/items/available?page=2&page_size=10", links(w)["next"])
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/available?page=3", nil))
+		assert.NotContains(t, links(w), "next")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("no results link to a single page", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetAvailableItems", mock.Anything, 1, 10).Return(page(1, 0), nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/items/available", nil))
+
+		assert.Equal(t, "0", w.Header().Get(TotalCountHeader))
+		assert.Equal(t, map[string]string{
+			"first": "/api/v1/items/available?page=1&page_size=10",
+			"last":  "/api/v1/items/available?page=1&page_size=10",
+		}, links(w))
+	})
+}
+
+func TestItemHandler_StreamItemEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.GET("/items/:id/events", NewItemHandler(mockUseCase).StreamItemEvents)
+		return router
+	}
+
+	t.Run("writes each event until the stream ends", func(t *testing.T) {
+		events := make(chan dto.ItemEventResponse, 1)
+		events <- dto.ItemEventResponse{
+			ID:     "evt-1",
+			Type:   "ItemPriceChanged",
+			ItemID: itemID,
+			Data:   map[string]interface{}{"newPrice": 79.99},
+		}
+		close(events)
+
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("SubscribeItemEvents", mock.Anything, itemID).Return(events, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID+"/events", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+
+		frame := strings.SplitN(w.Body.String(), "\n", 4)
+		require.Len(t, frame, 4)
+		assert.Equal(t, "id: evt-1", frame[0])
+		assert.Equal(t, "event: ItemPriceChanged", frame[1])
+		require.True(t, strings.HasPrefix(frame[2], "data: "))
+
+		var event dto.ItemEventResponse
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(frame[2], "data: ")), &event))
+		assert.Equal(t, itemID, event.ItemID)
+		assert.Equal(t, map[string]interface{}{"newPrice": 79.99}, event.Data)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("SubscribeItemEvents", mock.Anything, itemID).Return(nil, item.ErrItemNotFound).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID+"/events", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("returns when the client disconnects", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("SubscribeItemEvents", mock.Anything, itemID).Return(make(chan dto.ItemEventResponse), nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			w := httptest.NewRecorder()
+			newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID+"/events", nil).WithContext(ctx))
+		}()
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("handler kept streaming after the client disconnected")
+		}
+	})
+}
+
+func TestItemHandler_CloneItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.POST("/items/:id/clone", NewItemHandler(mockUseCase).CloneItem)
+		return router
+	}
+
+	t.Run("returns the new item", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("CloneItem", mock.Anything, itemID).
+			Return(&dto.ItemResponse{ID: "660e8400-e29b-41d4-a716-446655440000", SKU: "ELEC-000008", Status: "draft"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("POST", "/items/"+itemID+"/clone", nil))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"sku":"ELEC-000008"`)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("archived source", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("CloneItem", mock.Anything, itemID).
+			Return(nil, fmt.Errorf("%w: item with ID %s is archived", usecase.ErrItemArchived, itemID)).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("POST", "/items/"+itemID+"/clone", nil))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), middleware.CodeItemArchived)
+	})
+}
+
+func TestItemHandler_GetRelatedItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	tests := []struct {
+		name       string
+		query      string
+		wantLimit  int
+		useCaseErr error
+		wantStatus int
+	}{
+		{name: "default limit", wantLimit: 10, wantStatus: http.StatusOK},
+		{name: "explicit limit", query: "?limit=4", wantLimit: 4, wantStatus: http.StatusOK},
+		{name: "malformed limit", query: "?limit=many", wantStatus: http.StatusBadRequest},
+		{name: "limit too large", query: "?limit=51", wantLimit: 51, useCaseErr: fmt.Errorf("%w: limit must be between 1 and 50", usecase.ErrInvalidFilter), wantStatus: http.StatusBadRequest},
+		{name: "unknown item", wantLimit: 10, useCaseErr: item.ErrItemNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/:id/related", NewItemHandler(mockUseCase).GetRelatedItems)
+
+			if tt.wantLimit > 0 {
+				var resp *dto.ItemListResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemListResponse{Items: []dto.ItemResponse{}, Page: 1, PageSize: tt.wantLimit}
+				}
+				mockUseCase.On("GetRelatedItems", mock.Anything, itemID, tt.wantLimit).Return(resp, tt.useCaseErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID+"/related"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_CheckAvailability(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	tests := []struct {
+		name         string
+		query        string
+		wantQuantity int
+		useCaseErr   error
+		wantStatus   int
+	}{
+		{name: "available", query: "?quantity=3", wantQuantity: 3, wantStatus: http.StatusOK},
+		{name: "missing quantity", wantStatus: http.StatusBadRequest},
+		{name: "malformed quantity", query: "?quantity=few", wantStatus: http.StatusBadRequest},
+		{name: "zero quantity", query: "?quantity=0", wantStatus: http.StatusBadRequest},
+		{name: "negative quantity", query: "?quantity=-2", wantStatus: http.StatusBadRequest},
+		{name: "unknown item", query: "?quantity=1", wantQuantity: 1, useCaseErr: item.ErrItemNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/:id/availability", NewItemHandler(mockUseCase).CheckAvailability)
+
+			if tt.wantQuantity > 0 {
+				var resp *dto.ItemAvailabilityResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemAvailabilityResponse{Available: true, OnHand: 10, AvailableQuantity: 8}
+				}
+				mockUseCase.On("CheckAvailability", mock.Anything, itemID, tt.wantQuantity).Return(resp, tt.useCaseErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID+"/availability"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.JSONEq(t, `{"available": true, "on_hand": 10, "available_quantity": 8}`, w.Body.String())
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_GetPopularItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantWindow time.Duration
+		wantLimit  int
+		useCaseErr error
+		wantStatus int
+	}{
+		{name: "defaults", wantWindow: 7 * 24 * time.Hour, wantLimit: 10, wantStatus: http.StatusOK},
+		{name: "explicit window and limit", query: "?window=24h&limit=3", wantWindow: 24 * time.Hour, wantLimit: 3, wantStatus: http.StatusOK},
+		{name: "malformed window", query: "?window=week", wantStatus: http.StatusBadRequest},
+		{name: "malformed limit", query: "?limit=lots", wantStatus: http.StatusBadRequest},
+		{name: "window too long", query: "?window=10000h", wantWindow: 10000 * time.Hour, wantLimit: 10,
+			useCaseErr: fmt.Errorf("%w: window must be positive and at most 2160h0m0s", usecase.ErrInvalidFilter), wantStatus: http.StatusBadRequest},
+		{name: "repository failure", wantWindow: 7 * 24 * time.Hour, wantLimit: 10, useCaseErr: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/popular", NewItemHandler(mockUseCase).GetPopularItems)
+
+			if tt.wantLimit > 0 {
+				var resp *dto.PopularItemsResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.PopularItemsResponse{Items: []dto.PopularItemResponse{}}
+				}
+				mockUseCase.On("GetPopularItems", mock.Anything, tt.wantWindow, tt.wantLimit).Return(resp, tt.useCaseErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/popular"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_SuggestItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantQuery  string
+		wantLimit  int
+		useCaseErr error
+		wantStatus int
+	}{
+		{name: "default limit", query: "?q=tel", wantQuery: "tel", wantLimit: 10, wantStatus: http.StatusOK},
+		{name: "explicit limit", query: "?q=tel&limit=5", wantQuery: "tel", wantLimit: 5, wantStatus: http.StatusOK},
+		{name: "malformed limit", query: "?q=tel&limit=lots", wantStatus: http.StatusBadRequest},
+		{name: "one character query", query: "?q=t", wantQuery: "t", wantLimit: 10,
+			useCaseErr: fmt.Errorf("%w: query must be at least 2 characters", usecase.ErrInvalidFilter), wantStatus: http.StatusBadRequest},
+		{name: "repository failure", query: "?q=tel", wantQuery: "tel", wantLimit: 10, useCaseErr: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/suggest", NewItemHandler(mockUseCase).SuggestItems)
+
+			if tt.wantLimit > 0 {
+				var resp *dto.ItemSuggestionsResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemSuggestionsResponse{Items: []dto.ItemSummaryResponse{{Name: "Tele"}, {Name: "Telescope"}}}
+				}
+				mockUseCase.On("SuggestItems", mock.Anything, tt.wantQuery, tt.wantLimit).Return(resp, tt.useCaseErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/suggest"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var body dto.ItemSuggestionsResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Len(t, body.Items, 2)
+				assert.Equal(t, "Tele", body.Items[0].Name)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_GetLowStockItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		query         string
+		wantThreshold int
+		useCaseErr    error
+		wantStatus    int
+	}{
+		{name: "default threshold", wantThreshold: 5, wantStatus: http.StatusOK},
+		{name: "explicit threshold", query: "?threshold=20", wantThreshold: 20, wantStatus: http.StatusOK},
+		{name: "negative threshold", query: "?threshold=-3", wantStatus: http.StatusBadRequest},
+		{name: "not a number", query: "?threshold=few", wantStatus: http.StatusBadRequest},
+		{name: "threshold over the maximum", query: "?threshold=5000", wantThreshold: 5000,
+			useCaseErr: fmt.Errorf("%w: threshold must be between 1 and 1000", usecase.ErrInvalidFilter), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/low-stock", NewItemHandler(mockUseCase).GetLowStockItems)
+
+			if tt.wantThreshold > 0 {
+				var resp *dto.ItemListResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemListResponse{Items: []dto.ItemResponse{{ID: "550e8400-e29b-41d4-a716-446655440000"}}, Total: 1}
+				}
+				mockUseCase.On("GetLowStockItems", mock.Anything, tt.wantThreshold).Return(resp, tt.useCaseErr).Once()
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/low-stock"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_UpdateInventoryBulk(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest("PATCH", "/items/inventory/bulk", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	updates := []dto.InventoryUpdate{{SKU: "BULK-001", Quantity: 5}, {SKU: "BULK-404", Quantity: 2}}
+
+	tests := []struct {
+		name           string
+		body           string
+		result         *dto.BulkInventoryResult
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "all updated",
+			body:           `[{"sku":"BULK-001","quantity":5},{"sku":"BULK-404","quantity":2}]`,
+			result:         &dto.BulkInventoryResult{Total: 2, Updated: 2},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "unknown SKU reported alongside the updated one",
+			body: `[{"sku":"BULK-001","quantity":5},{"sku":"BULK-404","quantity":2}]`,
+			result: &dto.BulkInventoryResult{
+				Results: []dto.BulkInventoryItemResult{
+					{Index: 0, SKU: "BULK-001", Status: dto.BulkItemUpdated},
+					{Index: 1, SKU: "BULK-404", Status: dto.BulkItemFailed, Error: "failed to find item: item not found"},
+				},
+				Total: 2, Updated: 1, Failed: 1,
+			},
+			expectedStatus: http.StatusMultiStatus,
+		},
+		{
+			name:           "nothing updated",
+			body:           `[{"sku":"BULK-001","quantity":5},{"sku":"BULK-404","quantity":2}]`,
+			result:         &dto.BulkInventoryResult{Total: 2, Failed: 2},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "use case error",
+			body:           `[{"sku":"BULK-001","quantity":5},{"sku":"BULK-404","quantity":2}]`,
+			err:            errors.New("connection reset"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "empty array",
+			body:           `[]`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "object instead of array",
+			body:           `{"sku":"BULK-001","quantity":5}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			if tt.result != nil || tt.err != nil {
+				mockUseCase.On("UpdateInventoryBulk", mock.Anything, updates).Return(tt.result, tt.err).Once()
+			}
+
+			router := gin.New()
+			router.PATCH("/items/inventory/bulk", NewItemHandler(mockUseCase).UpdateInventoryBulk)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, newRequest(tt.body))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.result != nil {
+				var got dto.BulkInventoryResult
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+				assert.Equal(t, tt.result.Failed, got.Failed)
+				if len(tt.result.Results) > 0 {
+					assert.Equal(t, tt.result.Results, got.Results)
+				}
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_GetItemStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("returns the aggregated counts", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetItemStats", mock.Anything).Return(&dto.ItemStatsResponse{
+			Total:         5,
+			ByStatus:      map[string]int{"active": 4, "draft": 1},
+			TopCategories: []dto.CategoryCountResponse{{Name: "Electronics", Slug: "electronics", Count: 5}},
+		}, nil).Once()
+
+		router := gin.New()
+		router.GET("/items/stats", NewItemHandler(mockUseCase).GetItemStats)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/items/stats", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{
+			"total": 5,
+			"by_status": {"active": 4, "draft": 1},
+			"top_categories": [{"name": "Electronics", "slug": "electronics", "count": 5}]
+		}`, w.Body.String())
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("use case error", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("GetItemStats", mock.Anything).Return(nil, errors.New("database down")).Once()
+
+		router := gin.New()
+		router.GET("/items/stats", NewItemHandler(mockUseCase).GetItemStats)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/items/stats", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestItemHandler_GetPriceHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name         string
+		query        string
+		wantPage     int
+		wantPageSize int
+		history      *dto.PriceHistoryResponse
+		err          error
+		wantStatus   int
+	}{
+		{
+			name:         "history page",
+			query:        "?page=2&page_size=5",
+			wantPage:     2,
+			wantPageSize: 5,
+			history: &dto.PriceHistoryResponse{
+				ItemID:  itemID,
+				Changes: []dto.PriceChangeResponse{{OldPrice: 100, NewPrice: 90, OldCurrency: "USD", NewCurrency: "USD"}},
+				Total:   6,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "out of range paging falls back to defaults",
+			query:        "?page=0&page_size=500",
+			wantPage:     1,
+			wantPageSize: 10,
+			history:      &dto.PriceHistoryResponse{ItemID: itemID},
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "unknown item",
+			wantPage:     1,
+			wantPageSize: 10,
+			err:          item.ErrItemNotFound,
+			wantStatus:   http.StatusNotFound,
+		},
+		{
+			name:         "repository failure",
+			wantPage:     1,
+			wantPageSize: 10,
+			err:          errors.New("connection refused"),
+			wantStatus:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.GET("/items/:id/price-history", NewItemHandler(mockUseCase).GetPriceHistory)
+
+			var history interface{}
+			if tt.history != nil {
+				history = tt.history
+			}
+			mockUseCase.On("GetPriceHistory", mock.Anything, itemID, tt.wantPage, tt.wantPageSize).Return(history, tt.err).Once()
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/items/"+itemID+"/price-history"+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.history != nil {
+				var got dto.PriceHistoryResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+				assert.Equal(t, *tt.history, got)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_ExportItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	exported := []dto.ItemResponse{
+		{
+			SKU:       "TOY-001",
+			Name:      "Robot, large",
+			Price:     19.99,
+			Currency:  "USD",
+			Category:  dto.CategoryResponse{Name: "Toys", Slug: "toys"},
+			Inventory: dto.InventoryResponse{Quantity: 10},
+		},
+		{
+			SKU:         "TOY-002",
+			Name:        "Kite",
+			Description: "Flies",
+			Price:       5,
+			Currency:    "USD",
+			Category:    dto.CategoryResponse{Name: "Toys", Slug: "toys"},
+			Inventory:   dto.InventoryResponse{Quantity: 3},
+		},
+	}
+
+	newExportRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.GET("/items/export", NewItemHandler(mockUseCase).ExportItems)
+		return router
+	}
+
+	t.Run("CSV has the import header row and one row per item", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ExportItems", mock.Anything, &dto.ExportRequest{Format: "csv", Category: "toys"}).
+			Return(exported, nil).Once()
+
+		w := httptest.NewRecorder()
+		newExportRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/export?category=toys", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Regexp(t, `^attachment; filename="items-\d{8}T\d{6}Z\.csv"$`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "sku,name,description,price,currency,category,inventory\n"+
+			"TOY-001,\"Robot, large\",,19.99,USD,toys,10\n"+
+			"TOY-002,Kite,Flies,5,USD,toys,3\n", w.Body.String())
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("JSON is a single array", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ExportItems", mock.Anything, &dto.ExportRequest{Format: "json", Status: "active"}).
+			Return(exported, nil).Once()
+
+		w := httptest.NewRecorder()
+		newExportRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/export?format=json&status=active", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), ".json")
+
+		var items []dto.ItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &items))
+		require.Len(t, items, 2)
+		assert.Equal(t, "TOY-002", items[1].SKU)
+	})
+
+	t.Run("empty JSON export is an empty array", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ExportItems", mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		w := httptest.NewRecorder()
+		newExportRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/export?format=json", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "[]", w.Body.String())
+	})
+
+	t.Run("unknown filter is a bad request", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("ExportItems", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: status: invalid status: sold", usecase.ErrInvalidFilter)).Once()
+
+		w := httptest.NewRecorder()
+		newExportRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/export?status=sold", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("unknown format is rejected", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+
+		w := httptest.NewRecorder()
+		newExportRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("GET", "/items/export?format=xml", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "ExportItems", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemHandler_UploadImage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	router := gin.New()
+	router.POST("/items/:id/images/upload", handler.UploadImage)
+
+	// newUploadRequest builds a multipart request with an optional file part and form fields
+	newUploadRequest := func(filename string, content []byte, fields map[string]string) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		for name, value := range fields {
+			require.NoError(t, writer.WriteField(name, value))
+		}
+		if filename != "" {
+			part, err := writer.CreateFormFile("file", filename)
+			require.NoError(t, err)
+			_, err = part.Write(content)
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest("POST", "/items/"+itemID+"/images/upload", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	t.Run("valid PNG", func(t *testing.T) {
+		mockUseCase.On("UploadImage", mock.Anything, itemID, mock.MatchedBy(func(req *dto.UploadImageRequest) bool {
+			content, err := io.ReadAll(req.Content)
+			return err == nil && bytes.Equal(content, png) &&
+				req.Filename == "photo.png" && req.Size == int64(len(png)) &&
+				req.Alt == "Front view" && req.IsPrimary
+		})).Return(&dto.ItemResponse{ID: itemID, Images: []dto.ImageResponse{{URL: "http://localhost:8080/uploads/items/photo.png"}}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newUploadRequest("photo.png", png, map[string]string{"alt": "Front view", "is_primary": "true"}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("rejected exe", func(t *testing.T) {
+		mockUseCase.On("UploadImage", mock.Anything, itemID, mock.AnythingOfType("*dto.UploadImageRequest")).
+			Return(nil, fmt.Errorf("%w: unsupported content type application/octet-stream", usecase.ErrInvalidUpload)).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newUploadRequest("setup.exe", []byte("MZ\x90\x00"), nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		unused := new(MockItemUseCase)
+		router := gin.New()
+		router.POST("/items/:id/images/upload", NewItemHandler(unused).UploadImage)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newUploadRequest("", nil, map[string]string{"alt": "Front view"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		unused.AssertNotCalled(t, "UploadImage", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemHandler_RemoveImage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+	imageURL := "http://example.com/a.jpg"
+
+	t.Run("successful removal", func(t *testing.T) {
+		mockUseCase.On("RemoveImage", mock.Anything, itemID, imageURL).
+			Return(&dto.ItemResponse{ID: itemID, Images: []dto.ImageResponse{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("DELETE", "/items/"+itemID+"/images?url="+imageURL, nil)
+
+		handler.RemoveImage(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("image not found", func(t *testing.T) {
+		mockUseCase.On("RemoveImage", mock.Anything, itemID, imageURL).
+			Return(nil, fmt.Errorf("%w: %s", usecase.ErrImageNotFound, imageURL)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("DELETE", "/items/"+itemID+"/images?url="+imageURL, nil)
+
+		handler.RemoveImage(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("missing url", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("DELETE", "/items/"+itemID+"/images", nil)
+
+		handler.RemoveImage(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestItemHandler_RemoveImageAt(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.DELETE("/items/:id/images/:index", NewItemHandler(mockUseCase).RemoveImageAt)
+		return router
+	}
+
+	t.Run("removes the image at the index", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("RemoveImageAt", mock.Anything, itemID, 0).
+			Return(&dto.ItemResponse{ID: itemID, Images: []dto.ImageResponse{{URL: "http://example.com/b.jpg", IsPrimary: true}}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("DELETE", "/items/"+itemID+"/images/0", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("out-of-range index", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("RemoveImageAt", mock.Anything, itemID, 5).
+			Return(nil, fmt.Errorf("%w: image index 5 is out of range for 2 images", usecase.ErrInvalidImageIndex)).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("DELETE", "/items/"+itemID+"/images/5", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "invalid image index: image index 5 is out of range for 2 images", response.Error)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	for _, index := range []string{"-1", "first", "1.5"} {
+		t.Run("malformed index "+index, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+
+			w := httptest.NewRecorder()
+			newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("DELETE", "/items/"+itemID+"/images/"+index, nil))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockUseCase.AssertNotCalled(t, "RemoveImageAt", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestItemHandler_RemoveAttribute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name       string
+		result     *dto.ItemResponse
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "removed",
+			result:     &dto.ItemResponse{ID: itemID, Attributes: map[string]interface{}{"size": "L"}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unknown item",
+			err:        fmt.Errorf("failed to find item: %w", item.ErrItemNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "save failure",
+			err:        errors.New("connection refused"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			router := gin.New()
+			router.DELETE("/items/:id/attributes/:key", NewItemHandler(mockUseCase).RemoveAttribute)
+
+			var result interface{}
+			if tt.result != nil {
+				result = tt.result
+			}
+			mockUseCase.On("RemoveAttribute", mock.Anything, itemID, "color").Return(result, tt.err).Once()
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("DELETE", "/items/"+itemID+"/attributes/color", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_ReorderImages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	newRequest := func(urls []string) *http.Request {
+		body, _ := json.Marshal(dto.ReorderImagesRequest{URLs: urls})
+		req := httptest.NewRequest("PUT", "/items/"+itemID+"/images/order", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("successful reorder", func(t *testing.T) {
+		urls := []string{"http://example.com/b.jpg", "http://example.com/a.jpg"}
+		mockUseCase.On("ReorderImages", mock.Anything, itemID, &dto.ReorderImagesRequest{URLs: urls}).
+			Return(&dto.ItemResponse{ID: itemID, Images: []dto.ImageResponse{{URL: urls[0]}, {URL: urls[1], IsPrimary: true}}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = newRequest(urls)
+
+		handler.ReorderImages(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.ItemResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Images, 2)
+		assert.Equal(t, urls[0], response.Images[0].URL)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("missing url in order", func(t *testing.T) {
+		mockUseCase.On("ReorderImages", mock.Anything, itemID, mock.Anything).
+			Return(nil, fmt.Errorf("%w: image order must list every image exactly once", usecase.ErrInvalidImageOrder)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = newRequest([]string{"http://example.com/a.jpg"})
+
+		handler.ReorderImages(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_ReplaceImages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name           string
+		body           string
+		useCaseErr     error
+		callsUseCase   bool
+		expectedStatus int
+	}{
+		{
+			name:           "replaces the set",
+			body:           `{"images":[{"url":"https://example.com/a.jpg"},{"url":"https://example.com/b.jpg"}]}`,
+			callsUseCase:   true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "two primaries",
+			body:           `{"images":[{"url":"https://example.com/a.jpg","is_primary":true},{"url":"https://example.com/b.jpg","is_primary":true}]}`,
+			useCaseErr:     fmt.Errorf("%w: only one image can be primary", usecase.ErrInvalidImages),
+			callsUseCase:   true,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{name: "invalid URL", body: `{"images":[{"url":"not a url"}]}`, expectedStatus: http.StatusBadRequest},
+		{name: "images missing", body: `{}`, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			if tt.callsUseCase {
+				var resp *dto.ItemResponse
+				if tt.useCaseErr == nil {
+					resp = &dto.ItemResponse{ID: itemID}
+				}
+				mockUseCase.On("ReplaceImages", mock.Anything, itemID, mock.AnythingOfType("*dto.ReplaceImagesRequest")).
+					Return(resp, tt.useCaseErr).Once()
+			}
+
+			router := gin.New()
+			router.PUT("/items/:id/images", NewItemHandler(mockUseCase).ReplaceImages)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PUT", "/items/"+itemID+"/images", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemHandler_CreateItemsBulk(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newBulkRequest := func(t *testing.T, req dto.BulkCreateRequest) *http.Request {
+		t.Helper()
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		httpReq := httptest.NewRequest("POST", "/items/bulk", bytes.NewBuffer(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		return httpReq
+	}
+
+	validRequest := dto.BulkCreateRequest{Items: []*dto.CreateItemRequest{
+		{SKU: "BULK-001", Name: "First Item", Price: 10, Currency: "USD", Category: "toys"},
+		{SKU: "BULK-002", Name: "Second Item", Price: 20, Currency: "USD", Category: "toys"},
+	}}
+
+	tests := []struct {
+		name   string
+		result *dto.BulkCreateResult
+		status int
+	}{
+		{"all created", &dto.BulkCreateResult{Total: 2, Created: 2}, http.StatusCreated},
+		{"partially created", &dto.BulkCreateResult{Total: 2, Created: 1, Failed: 1}, http.StatusMultiStatus},
+		{"nothing created", &dto.BulkCreateResult{Total: 2, Failed: 1, RolledBack: 1, AllOrNothing: true}, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			handler := NewItemHandler(mockUseCase)
+			mockUseCase.On("CreateItemsBulk", mock.Anything, mock.AnythingOfType("[]*dto.CreateItemRequest")).
+				Return(tt.result, nil)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = newBulkRequest(t, validRequest)
+
+			handler.CreateItemsBulk(c)
+
+			assert.Equal(t, tt.status, w.Code)
+
+			var response dto.BulkCreateResult
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.result.Created, response.Created)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+
+	t.Run("empty batch", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		handler := NewItemHandler(mockUseCase)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBulkRequest(t, dto.BulkCreateRequest{})
+
+		handler.CreateItemsBulk(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "CreateItemsBulk", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemHandler_DeleteItemsBulk(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.POST("/items/bulk-delete", NewItemHandler(mockUseCase).DeleteItemsBulk)
+		return router
+	}
+	post := func(router *gin.Engine, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/items/bulk-delete", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("reports deleted and not found counts", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("DeleteItemsBulk", mock.Anything, []string{"id-1", "id-2"}, true).
+			Return(&dto.BulkDeleteResult{Total: 2, Deleted: 1, NotFound: 1, NotFoundIDs: []string{"id-2"}}, nil).Once()
+
+		w := post(newRouter(mockUseCase), `{"ids":["id-1","id-2"],"force":true}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response dto.BulkDeleteResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 1, response.Deleted)
+		assert.Equal(t, 1, response.NotFound)
+		assert.Equal(t, []string{"id-2"}, response.NotFoundIDs)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+
+		w := post(newRouter(mockUseCase), `{"ids":[]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "DeleteItemsBulk", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("DeleteItemsBulk", mock.Anything, []string{"id-1"}, false).Return(nil, errors.New("db down")).Once()
+
+		w := post(newRouter(mockUseCase), `{"ids":["id-1"]}`)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_ActivateItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := new(MockItemUseCase)
+	handler := NewItemHandler(mockUseCase)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("successful activation", func(t *testing.T) {
+		mockUseCase.On("ActivateItem", mock.Anything, itemID).
+			Return(&dto.StatusChangeResponse{ID: itemID, Status: "active", Changed: true}, nil).Once()
+
+		router := gin.New()
+		router.PATCH("/items/:id/activate", handler.ActivateItem)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("PATCH", "/items/"+itemID+"/activate", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.StatusChangeResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Changed)
+		assert.Equal(t, "active", response.Status)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("already active item reports no change", func(t *testing.T) {
+		mockUseCase.On("ActivateItem", mock.Anything, itemID).
+			Return(&dto.StatusChangeResponse{ID: itemID, Status: "active", Changed: false}, nil).Once()
+
+		router := gin.New()
+		router.PATCH("/items/:id/activate", handler.ActivateItem)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("PATCH", "/items/"+itemID+"/activate", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":"`+itemID+`","status":"active","changed":false}`, w.Body.String())
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("illegal transition", func(t *testing.T) {
+		transitionErr := &item.StatusTransitionError{From: item.StatusArchived, To: item.StatusActive}
+		mockUseCase.On("ActivateItem", mock.Anything, itemID).
+			Return(nil, fmt.Errorf("failed to activate item: %w", transitionErr)).Once()
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
 		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("PATCH", "/items/"+itemID+"/activate", nil)
 
-		body, _ := json.Marshal(req)
-		c.Request = httptest.NewRequest("PATCH", "/items/"+itemID+"/inventory", bytes.NewBuffer(body))
-		c.Request.Header.Set("Content-Type", "application/json")
+		handler.ActivateItem(c)
 
-		handler.UpdateInventory(c)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "cannot change item status from archived to active", response.Error)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestItemHandler_UnarchiveItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	newRouter := func(mockUseCase *MockItemUseCase) *gin.Engine {
+		router := gin.New()
+		router.POST("/items/:id/unarchive", NewItemHandler(mockUseCase).UnarchiveItem)
+		return router
+	}
+
+	t.Run("returns the item in draft", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("UnarchiveItem", mock.Anything, itemID).
+			Return(&dto.ItemResponse{ID: itemID, Status: "draft"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("POST", "/items/"+itemID+"/unarchive", nil))
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
 		var response dto.ItemResponse
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Equal(t, 50, response.Inventory.Quantity)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, itemID, response.ID)
+		assert.Equal(t, "draft", response.Status)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("item that is not archived", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		transitionErr := &item.StatusTransitionError{From: item.StatusActive, To: item.StatusDraft}
+		mockUseCase.On("UnarchiveItem", mock.Anything, itemID).
+			Return(nil, fmt.Errorf("failed to unarchive item: %w", transitionErr)).Once()
+
+		w := httptest.NewRecorder()
+		newRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("POST", "/items/"+itemID+"/unarchive", nil))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
 
+		var response middleware.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, middleware.CodeInvalidStatusTransition, response.Code)
 		mockUseCase.AssertExpectations(t)
 	})
 }
 
+func TestItemHandler_ItemNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+	id, err := item.NewItemIDFromString(itemID)
+	require.NoError(t, err)
+	// The error the use case passes on when the repository has no such item
+	notFound := fmt.Errorf("failed to find item: %w", item.ItemNotFoundError(id))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		route  func(router *gin.Engine, handler *ItemHandler)
+		expect func(m *MockItemUseCase)
+	}{
+		{
+			name:   "update item",
+			method: "PUT",
+			path:   "/items/" + itemID,
+			body:   `{"name":"Renamed Item"}`,
+			route:  func(router *gin.Engine, handler *ItemHandler) { router.PUT("/items/:id", handler.UpdateItem) },
+			expect: func(m *MockItemUseCase) {
+				m.On("UpdateItem", mock.Anything, itemID, mock.AnythingOfType("*dto.UpdateItemRequest")).Return(nil, notFound).Once()
+			},
+		},
+		{
+			name:   "update inventory",
+			method: "PATCH",
+			path:   "/items/" + itemID + "/inventory",
+			body:   `{"quantity":5}`,
+			route: func(router *gin.Engine, handler *ItemHandler) {
+				router.PATCH("/items/:id/inventory", handler.UpdateInventory)
+			},
+			expect: func(m *MockItemUseCase) {
+				m.On("UpdateInventory", mock.Anything, itemID, mock.AnythingOfType("*dto.UpdateInventoryRequest")).Return(nil, notFound).Once()
+			},
+		},
+		{
+			name:   "add image",
+			method: "POST",
+			path:   "/items/" + itemID + "/images",
+			body:   `{"url":"https://example.com/a.jpg","alt":"Front"}`,
+			route:  func(router *gin.Engine, handler *ItemHandler) { router.POST("/items/:id/images", handler.AddImage) },
+			expect: func(m *MockItemUseCase) {
+				m.On("AddImage", mock.Anything, itemID, mock.AnythingOfType("*dto.AddImageRequest")).Return(nil, notFound).Once()
+			},
+		},
+		{
+			name:   "activate item",
+			method: "PATCH",
+			path:   "/items/" + itemID + "/activate",
+			route: func(router *gin.Engine, handler *ItemHandler) {
+				router.PATCH("/items/:id/activate", handler.ActivateItem)
+			},
+			expect: func(m *MockItemUseCase) {
+				m.On("ActivateItem", mock.Anything, itemID).Return(nil, notFound).Once()
+			},
+		},
+		{
+			name:   "deactivate item",
+			method: "PATCH",
+			path:   "/items/" + itemID + "/deactivate",
+			route: func(router *gin.Engine, handler *ItemHandler) {
+				router.PATCH("/items/:id/deactivate", handler.DeactivateItem)
+			},
+			expect: func(m *MockItemUseCase) {
+				m.On("DeactivateItem", mock.Anything, itemID).Return(nil, notFound).Once()
+			},
+		},
+		{
+			name:   "unarchive item",
+			method: "POST",
+			path:   "/items/" + itemID + "/unarchive",
+			route: func(router *gin.Engine, handler *ItemHandler) {
+				router.POST("/items/:id/unarchive", handler.UnarchiveItem)
+			},
+			expect: func(m *MockItemUseCase) {
+				m.On("UnarchiveItem", mock.Anything, itemID).Return(nil, notFound).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockItemUseCase)
+			tt.expect(mockUseCase)
+
+			router := gin.New()
+			tt.route(router, NewItemHandler(mockUseCase))
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+
+			var response middleware.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, middleware.CodeItemNotFound, response.Code)
+			assert.Equal(t, "Item not found", response.Error)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 func TestItemHandler_DeleteItem(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -305,7 +2854,7 @@ func TestItemHandler_DeleteItem(t *testing.T) {
 	t.Run("successful deletion", func(t *testing.T) {
 		itemID := "550e8400-e29b-41d4-a716-446655440000"
 
-		mockUseCase.On("DeleteItem", mock.Anything, itemID).Return(nil).Once()
+		mockUseCase.On("DeleteItem", mock.Anything, itemID, false).Return(nil).Once()
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -321,7 +2870,7 @@ func TestItemHandler_DeleteItem(t *testing.T) {
 	t.Run("item not found", func(t *testing.T) {
 		itemID := "550e8400-e29b-41d4-a716-446655440000"
 
-		mockUseCase.On("DeleteItem", mock.Anything, itemID).Return(item.ErrItemNotFound).Once()
+		mockUseCase.On("DeleteItem", mock.Anything, itemID, false).Return(item.ErrItemNotFound).Once()
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -330,9 +2879,56 @@ func TestItemHandler_DeleteItem(t *testing.T) {
 
 		handler.DeleteItem(c)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("active item with stock", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+		mockUseCase.On("DeleteItem", mock.Anything, itemID, false).
+			Return(fmt.Errorf("%w: item is active with 12 units in stock", usecase.ErrItemInUse)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("DELETE", "/items/"+itemID, nil)
+
+		handler.DeleteItem(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "force=true")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("forced deletion", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+		mockUseCase.On("DeleteItem", mock.Anything, itemID, true).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("DELETE", "/items/"+itemID+"?force=true", nil)
+
+		handler.DeleteItem(c)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
 		mockUseCase.AssertExpectations(t)
 	})
+
+	t.Run("malformed force flag", func(t *testing.T) {
+		itemID := "550e8400-e29b-41d4-a716-446655440000"
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "id", Value: itemID}}
+		c.Request = httptest.NewRequest("DELETE", "/items/"+itemID+"?force=please", nil)
+
+		handler.DeleteItem(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 func TestItemHandler_SearchItems(t *testing.T) {
@@ -380,11 +2976,304 @@ func TestItemHandler_SearchItems(t *testing.T) {
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
-		c.Request = httptest.NewRequest("GET", "/items/search", nil)
+		c.Request = httptest.NewRequest("GET", "/items/search?query=phone", nil)
+
+		handler.SearchItems(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("price range is passed through", func(t *testing.T) {
+		mockUseCase.On("SearchItems", mock.Anything, mock.MatchedBy(func(req *dto.SearchRequest) bool {
+			return req.MinPrice != nil && *req.MinPrice == 10 &&
+				req.MaxPrice != nil && *req.MaxPrice == 49.99 &&
+				req.Category == "electronics"
+		})).Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?category=electronics&min_price=10&max_price=49.99", nil)
+
+		handler.SearchItems(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("invalid price range", func(t *testing.T) {
+		mockUseCase.On("SearchItems", mock.Anything, mock.AnythingOfType("*dto.SearchRequest")).
+			Return(nil, fmt.Errorf("%w: min_price 50.00 is greater than max_price 10.00", usecase.ErrInvalidPriceRange)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?min_price=50&max_price=10", nil)
+
+		handler.SearchItems(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("attribute filters are passed through", func(t *testing.T) {
+		mockUseCase.On("SearchItems", mock.Anything, mock.MatchedBy(func(req *dto.SearchRequest) bool {
+			return len(req.Attributes) == 2 && req.Attributes["color"] == "red" && req.Attributes["size"] == "M"
+		})).Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?attributes[color]=red&attributes[size]=M", nil)
+
+		handler.SearchItems(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("attribute ranges are passed through", func(t *testing.T) {
+		mockUseCase.On("SearchItems", mock.Anything, mock.MatchedBy(func(req *dto.SearchRequest) bool {
+			return req.AttributeMin["weight"] == 1.5 && req.AttributeMax["weight"] == 3 && len(req.AttributeMax) == 1
+		})).Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?attribute_min[weight]=1.5&attribute_max[weight]=3", nil)
+
+		handler.SearchItems(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("inverted attribute range is a bad request", func(t *testing.T) {
+		mockUseCase.On("SearchItems", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: attribute weight min 3 is greater than max 1", usecase.ErrInvalidFilter)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?attribute_min[weight]=3&attribute_max[weight]=1", nil)
+
+		handler.SearchItems(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("sort is passed through", func(t *testing.T) {
+		mockUseCase.On("SearchItems", mock.Anything, mock.MatchedBy(func(req *dto.SearchRequest) bool {
+			return req.SortBy == "price" && req.SortOrder == "asc"
+		})).Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?query=phone&sort_by=price&sort_order=ASC", nil)
 
 		handler.SearchItems(c)
 
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockUseCase.AssertExpectations(t)
 	})
+
+	for _, query := range []string{"min_price=abc", "max_price=NaN", "min_price=-5", "sort_by=drop_table", "sort_order=sideways", "attribute_min[weight]=heavy", "attribute_max[weight]=Inf",
+		"query=phone&page=0", "query=phone&page=two", "query=phone&page_size=101", "category=---", "query=%20%20"} {
+		t.Run("rejects "+query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/items/search?"+query, nil)
+
+			handler.SearchItems(c)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestItemHandler_SearchItemsValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedField string
+	}{
+		{name: "over-long query", query: "query=" + strings.Repeat("a", 201), expectedField: "query"},
+		{name: "unknown status", query: "status=sold", expectedField: "status"},
+		{name: "no query or filter", query: "page=2", expectedField: "query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The use case is never called, so the search never reaches the database
+			mockUseCase := new(MockItemUseCase)
+			handler := NewItemHandler(mockUseCase)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/items/search?"+tt.query, nil)
+
+			handler.SearchItems(c)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var response middleware.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, middleware.CodeValidationFailed, response.Code)
+			require.Len(t, response.Errors, 1)
+			assert.Equal(t, tt.expectedField, response.Errors[0].Field)
+			mockUseCase.AssertNotCalled(t, "SearchItems", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("status is matched case-insensitively", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		mockUseCase.On("SearchItems", mock.Anything, mock.MatchedBy(func(req *dto.SearchRequest) bool {
+			return req.Status == "archived"
+		})).Return(&dto.ItemListResponse{Items: []dto.ItemResponse{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/items/search?status=Archived", nil)
+
+		NewItemHandler(mockUseCase).SearchItems(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func newBatchRequest(t *testing.T, ctx context.Context, ids ...string) *http.Request {
+	t.Helper()
+
+	body, err := json.Marshal(dto.BatchProcessRequest{ItemIDs: ids})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/items/batch/process", bytes.NewBuffer(body)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// blockUntilDone makes a mocked call wait for its context like a real query would
+func blockUntilDone(started *int32) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		atomic.AddInt32(started, 1)
+		<-args.Get(0).(context.Context).Done()
+	}
+}
+
+func TestItemHandler_ProcessItemsBatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("processes every item", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		handler := NewItemHandler(mockUseCase)
+
+		mockUseCase.On("GetItemByID", mock.Anything, "item-1").Return(&dto.ItemResponse{ID: "item-1"}, nil).Once()
+		mockUseCase.On("GetItemByID", mock.Anything, "item-2").Return(nil, item.ErrItemNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBatchRequest(t, context.Background(), "item-1", "item-2")
+
+		handler.ProcessItemsBatch(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.BatchProcessResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 2, response.Total)
+		assert.Equal(t, 1, response.Processed)
+		assert.Equal(t, 1, response.Failed)
+		assert.Equal(t, dto.BatchItemProcessed, response.Results[0].Status)
+		assert.Equal(t, dto.BatchItemFailed, response.Results[1].Status)
+		assert.NotEmpty(t, response.Results[1].Error)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		handler := NewItemHandler(new(MockItemUseCase))
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBatchRequest(t, context.Background())
+
+		handler.ProcessItemsBatch(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("client cancels mid-flight", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		handler := NewItemHandler(mockUseCase).WithBatchOptions(BatchOptions{Concurrency: 2})
+
+		var started int32
+		mockUseCase.On("GetItemByID", mock.Anything, "fast").Return(&dto.ItemResponse{ID: "fast"}, nil).Once()
+		mockUseCase.On("GetItemByID", mock.Anything, mock.Anything).
+			Run(blockUntilDone(&started)).Return(nil, context.Canceled)
+
+		baseline := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBatchRequest(t, ctx, "fast", "slow-1", "slow-2", "slow-3", "slow-4")
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			handler.ProcessItemsBatch(c)
+		}()
+
+		// Both workers are blocked only once "fast" has completed
+		require.Eventually(t, func() bool { return atomic.LoadInt32(&started) == 2 }, time.Second, 5*time.Millisecond)
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("handler did not return after cancellation")
+		}
+
+		var response dto.BatchProcessResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 5, response.Total)
+		assert.Equal(t, 1, response.Processed)
+		assert.Equal(t, 4, response.Cancelled)
+		assert.Equal(t, dto.BatchItemProcessed, response.Results[0].Status)
+		for _, result := range response.Results[1:] {
+			assert.Equal(t, dto.BatchItemCancelled, result.Status)
+			assert.Empty(t, result.Error)
+		}
+
+		// Polled inline: assert.Eventually would count its own goroutine
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > baseline && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		assert.LessOrEqual(t, runtime.NumGoroutine(), baseline, "batch workers still running")
+	})
+
+	t.Run("deadline stops the batch", func(t *testing.T) {
+		mockUseCase := new(MockItemUseCase)
+		handler := NewItemHandler(mockUseCase).WithBatchOptions(BatchOptions{Timeout: 20 * time.Millisecond})
+
+		var started int32
+		mockUseCase.On("GetItemByID", mock.Anything, mock.Anything).
+			Run(blockUntilDone(&started)).Return(nil, context.DeadlineExceeded)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBatchRequest(t, context.Background(), "item-1", "item-2")
+
+		start := time.Now()
+		handler.ProcessItemsBatch(c)
+
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.BatchProcessResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 2, response.Cancelled)
+	})
 }
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+	"item-pdp-service/internal/infrastructure/config"
+	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/events"
+	"item-pdp-service/internal/infrastructure/persistence"
+	"item-pdp-service/internal/infrastructure/tracing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// Pass-through collaborators so the real use case can run against sqlmock
+type stubInventoryService struct{}
+
+func (stubInventoryService) ReserveInventory(ctx context.Context, itemID string, quantity int) error {
+	return nil
+}
+
+func (stubInventoryService) ReleaseInventory(ctx context.Context, itemID string, quantity int) error {
+	return nil
+}
+
+type stubCategoryService struct{}
+
+func (stubCategoryService) ValidateCategory(ctx context.Context, category string) error { return nil }
+
+func (stubCategoryService) GetCategoryDiscounts(ctx context.Context, category string) (float64, error) {
+	return 0, nil
+}
+
+type stubPricingService struct{}
+
+func (stubPricingService) CalculatePrice(ctx context.Context, basePrice float64, category string) (float64, error) {
+	return basePrice, nil
+}
+
+func (stubPricingService) ApplyDiscounts(ctx context.Context, price float64, itemID string) (float64, error) {
+	return price, nil
+}
+
+func TestItemHandler_CreateItemTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider(&config.Config{
+		App:     config.AppConfig{Name: "item-pdp-service"},
+		Tracing: config.TracingConfig{SampleRatio: 1},
+	}, sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT EXISTS").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO items").WillReturnResult(sqlmock.NewResult(1, 1))
+	// ItemCreated and the draft-to-active ItemStatusChanged
+	mock.ExpectExec("INSERT INTO outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := persistence.NewTracingItemRepository(persistence.NewPostgresItemRepository(&database.DB{DB: db}))
+	itemUseCase := usecase.NewTracingItemUseCase(usecase.NewItemUseCase(
+		repo, stubInventoryService{}, stubCategoryService{}, stubPricingService{}, events.NewDispatcher()))
+	handler := NewItemHandler(itemUseCase)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.TracingMiddleware())
+	router.POST("/api/v1/items", handler.CreateItem)
+
+	body, _ := json.Marshal(dto.CreateItemRequest{
+		SKU:      "TEST-001",
+		Name:     "Test Item",
+		Price:    99.99,
+		Currency: "USD",
+		Category: "toys",
+	})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/items", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	var created dto.ItemResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	require.Len(t, spans, 4)
+
+	httpSpan, ok := spans["POST /api/v1/items"]
+	require.True(t, ok)
+	assert.False(t, httpSpan.Parent.IsValid())
+
+	useCaseSpan, ok := spans["ItemUseCase.CreateItem"]
+	require.True(t, ok)
+	assert.Equal(t, httpSpan.SpanContext.SpanID(), useCaseSpan.Parent.SpanID())
+	assert.Contains(t, useCaseSpan.Attributes, tracing.AttrItemID.String(created.ID))
+	assert.Contains(t, useCaseSpan.Attributes, tracing.AttrItemSKU.String("TEST-001"))
+
+	for _, name := range []string{"ItemRepository.ExistsBySKU", "ItemRepository.Save"} {
+		repoSpan, ok := spans[name]
+		require.True(t, ok, name)
+		assert.Equal(t, useCaseSpan.SpanContext.SpanID(), repoSpan.Parent.SpanID(), name)
+		assert.Equal(t, httpSpan.SpanContext.TraceID(), repoSpan.SpanContext.TraceID(), name)
+		assert.Contains(t, repoSpan.Attributes, tracing.AttrDBSystem.String("postgresql"), name)
+	}
+	assert.Contains(t, spans["ItemRepository.Save"].Attributes, tracing.AttrItemID.String(created.ID))
+	assert.Contains(t, spans["ItemRepository.Save"].Attributes, tracing.AttrDBQuery.String("Save"))
+}
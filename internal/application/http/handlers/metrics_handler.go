@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsHandler exposes runtime metrics and the database pool for tuning
+type MetricsHandler struct {
+	db      *database.DB
+	metrics http.Handler
+}
+
+// NewMetricsHandler creates a metrics handler whose registry reports the Go runtime,
+// the process and the database connection pool
+func NewMetricsHandler(db *database.DB) *MetricsHandler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db.DB, "postgres"),
+	)
+
+	return &MetricsHandler{
+		db:      db,
+		metrics: promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+	}
+}
+
+// Metrics serves the registry in the Prometheus text format
+// Pool figures are the go_sql_* series, labelled db_name="postgres"
+// @Summary Prometheus metrics
+// @Description Expose request, cache and connection pool metrics in the Prometheus text format
+// @Tags health
+// @Produce plain
+// @Success 200 {string} string
+// @Router /metrics [get]
+func (h *MetricsHandler) Metrics(c *gin.Context) {
+	h.metrics.ServeHTTP(c.Writer, c.Request)
+}
+
+// DBPool reports the connection pool so pool sizes can be tuned against real load
+// @Summary Database connection pool
+// @Description Report open, in-use and idle connections and how long callers waited for one
+// @Tags health
+// @Produce json
+// @Success 200 {object} dto.DBPoolStatsResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /debug/db-pool [get]
+func (h *MetricsHandler) DBPool(c *gin.Context) {
+	stats := h.db.Stats()
+
+	c.JSON(http.StatusOK, dto.DBPoolStatsResponse{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDurationMs:     stats.WaitDuration.Milliseconds(),
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	})
+}
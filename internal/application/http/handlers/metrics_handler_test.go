@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(10)
+
+	handler := NewMetricsHandler(&database.DB{DB: db})
+	router := gin.New()
+	router.GET("/metrics", handler.Metrics)
+	router.GET("/debug/db-pool", handler.DBPool)
+
+	// Run a query so the pool holds a connection
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"one"}).AddRow(1))
+	var one int
+	require.NoError(t, db.QueryRow("SELECT 1").Scan(&one))
+
+	t.Run("db pool reports open connections", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/db-pool", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp dto.DBPoolStatsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, 10, resp.MaxOpenConnections)
+		assert.Equal(t, 1, resp.OpenConnections)
+		assert.Equal(t, 0, resp.InUse)
+		assert.Equal(t, 1, resp.Idle)
+	})
+
+	t.Run("metrics include pool stats", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `go_sql_open_connections{db_name="postgres"} 1`)
+		assert.Contains(t, w.Body.String(), `go_sql_in_use_connections{db_name="postgres"} 0`)
+		assert.Contains(t, w.Body.String(), `go_sql_wait_count_total{db_name="postgres"} 0`)
+	})
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"item-pdp-service/internal/application/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TotalCountHeader carries the number of matching items across every page
+const TotalCountHeader = "X-Total-Count"
+
+// setPaginationHeaders adds X-Total-Count and an RFC 8288 Link header for list
+// Links keep the request's path and query and only change page, so they stay relative to wherever the client sent it
+// prev is left out on the first page and next on the last; last is page 1 when nothing matched
+func setPaginationHeaders(c *gin.Context, list *dto.ItemListResponse) {
+	c.Header(TotalCountHeader, strconv.Itoa(list.Total))
+
+	lastPage := list.TotalPages
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	query := c.Request.URL.Query()
+	query.Set("page_size", strconv.Itoa(list.PageSize))
+	link := func(page int, rel string) string {
+		query.Set("page", strconv.Itoa(page))
+		return fmt.Sprintf(`<%s?%s>; rel="%s"`, c.Request.URL.Path, query.Encode(), rel)
+	}
+
+	links := []string{link(1, "first")}
+	if list.Page > 1 {
+		// A page past the end steps back to the last real one
+		prev := list.Page - 1
+		if prev > lastPage {
+			prev = lastPage
+		}
+		links = append(links, link(prev, "prev"))
+	}
+	if list.Page < lastPage {
+		links = append(links, link(list.Page+1, "next"))
+	}
+	links = append(links, link(lastPage, "last"))
+
+	c.Header("Link", strings.Join(links, ", "))
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// responseFormats are the media types item responses can be written in; JSON is first so it is the default
+var responseFormats = []string{binding.MIMEJSON, binding.MIMEXML, binding.MIMEXML2}
+
+// respond writes body as XML when the Accept header asks for application/xml or text/xml, and as JSON otherwise
+func respond(c *gin.Context, status int, body interface{}) {
+	c.Writer.Header().Add("Vary", "Accept")
+
+	switch c.NegotiateFormat(responseFormats...) {
+	case binding.MIMEXML, binding.MIMEXML2:
+		c.XML(status, body)
+	default:
+		c.JSON(status, body)
+	}
+}
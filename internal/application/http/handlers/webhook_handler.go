@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/http/middleware"
+	"item-pdp-service/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// WebhookHandler handles HTTP requests for webhook subscriptions
+type WebhookHandler struct {
+	webhookUseCase usecase.WebhookUseCase
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(webhookUseCase usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// RegisterWebhook registers a callback URL for item events
+// @Summary Register webhook
+// @Description Register an http(s) URL to receive a signed POST for every item event of the given types. Leave out secret to have one generated; it is only returned here
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param request body dto.RegisterWebhookRequest true "Webhook"
+// @Success 201 {object} dto.WebhookResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/webhooks [post]
+func (h *WebhookHandler) RegisterWebhook(c *gin.Context) {
+	var req dto.RegisterWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind JSON")
+		middleware.WriteError(c, middleware.BadRequest("Invalid request body"))
+		return
+	}
+
+	// Validate request
+	if !middleware.ValidateAndRespond(c, req) {
+		return
+	}
+
+	webhook, err := h.webhookUseCase.RegisterWebhook(c.Request.Context(), &req)
+	if err != nil {
+		log.Error().Err(err).Str("url", req.URL).Msg("Failed to register webhook")
+		respondError(c, err, "Failed to register webhook")
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
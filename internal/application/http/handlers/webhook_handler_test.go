@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhookUseCase is a mock implementation of usecase.WebhookUseCase
+type MockWebhookUseCase struct {
+	mock.Mock
+}
+
+func (m *MockWebhookUseCase) RegisterWebhook(ctx context.Context, req *dto.RegisterWebhookRequest) (*dto.WebhookResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.WebhookResponse), args.Error(1)
+}
+
+func TestWebhookHandler_RegisterWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockUseCase *MockWebhookUseCase) *gin.Engine {
+		router := gin.New()
+		router.POST("/webhooks", NewWebhookHandler(mockUseCase).RegisterWebhook)
+		return router
+	}
+	post := func(router *gin.Engine, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/webhooks", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("registers the webhook", func(t *testing.T) {
+		mockUseCase := new(MockWebhookUseCase)
+		mockUseCase.On("RegisterWebhook", mock.Anything, &dto.RegisterWebhookRequest{
+			URL:        "https://example.com/hook",
+			EventTypes: []string{"ItemCreated"},
+		}).Return(&dto.WebhookResponse{ID: "wh-1", URL: "https://example.com/hook", EventTypes: []string{"ItemCreated"}, Secret: "generated"}, nil).Once()
+
+		w := post(newRouter(mockUseCase), `{"url":"https://example.com/hook","event_types":["ItemCreated"]}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"secret":"generated"`)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("unknown event type", func(t *testing.T) {
+		mockUseCase := new(MockWebhookUseCase)
+
+		w := post(newRouter(mockUseCase), `{"url":"https://example.com/hook","event_types":["ItemSold"]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "RegisterWebhook", mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing event types", func(t *testing.T) {
+		mockUseCase := new(MockWebhookUseCase)
+
+		w := post(newRouter(mockUseCase), `{"url":"https://example.com/hook","event_types":[]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "RegisterWebhook", mock.Anything, mock.Anything)
+	})
+
+	t.Run("callback the use case rejects", func(t *testing.T) {
+		mockUseCase := new(MockWebhookUseCase)
+		mockUseCase.On("RegisterWebhook", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: url must be an absolute http or https URL", usecase.ErrInvalidWebhook)).Once()
+
+		w := post(newRouter(mockUseCase), `{"url":"ftp://example.com/hook","event_types":["ItemCreated"]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "http or https")
+	})
+}
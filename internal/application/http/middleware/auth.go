@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"item-pdp-service/internal/infrastructure/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// CallerIDKey is the gin context key holding the authenticated caller identity
+const CallerIDKey = "caller_id"
+
+// TokenVerifier validates a bearer token and returns its claims
+type TokenVerifier interface {
+	Verify(token string) (*auth.Claims, error)
+}
+
+// AuthRequired rejects requests without a valid bearer token
+func AuthRequired(verifier TokenVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			AbortWithError(c, NewAPIError(http.StatusUnauthorized, CodeUnauthorized, "Missing or malformed bearer token"))
+			return
+		}
+
+		claims, err := verifier.Verify(strings.TrimSpace(token))
+		if err != nil {
+			log.Warn().Err(err).Str("path", c.Request.URL.Path).Msg("Rejected invalid token")
+			AbortWithError(c, NewAPIError(http.StatusUnauthorized, CodeUnauthorized, "Invalid or expired token"))
+			return
+		}
+
+		c.Set(CallerIDKey, claims.Subject)
+		c.Next()
+	}
+}
+
+// CallerID returns the authenticated caller identity, if any
+func CallerID(c *gin.Context) string {
+	return c.GetString(CallerIDKey)
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxBodySize rejects request bodies larger than n bytes with 413
+// Bodies that announce their length are refused up front; others are cut off at n bytes,
+// which makes reading them fail. A non-positive n disables the limit
+func MaxBodySize(n int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if n <= 0 || c.Request.Body == nil {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > n {
+			AbortWithError(c, NewAPIError(http.StatusRequestEntityTooLarge, CodeRequestTooLarge,
+				fmt.Sprintf("Request body must be at most %d bytes", n)))
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, n)
+		c.Next()
+	}
+}
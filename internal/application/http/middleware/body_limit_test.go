@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBodyLimitRouter(n int64) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(MaxBodySize(n))
+	router.POST("/items", func(c *gin.Context) {
+		var body map[string]interface{}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid request body"})
+			return
+		}
+		c.Status(http.StatusCreated)
+	})
+	return router
+}
+
+func TestMaxBodySize(t *testing.T) {
+	router := newBodyLimitRouter(32)
+
+	t.Run("body within the limit reaches the handler", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/items", strings.NewReader(`{"sku":"TEST-001"}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("over-large JSON body is rejected with 413", func(t *testing.T) {
+		body := `{"name":"` + strings.Repeat("x", 64) + `"}`
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/items", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.JSONEq(t, `{"error":"Request body must be at most 32 bytes","code":"request_too_large"}`, w.Body.String())
+	})
+
+	t.Run("body without a length is cut off at the limit", func(t *testing.T) {
+		body := `{"name":"` + strings.Repeat("x", 64) + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/items", io.NopCloser(bytes.NewBufferString(body)))
+		req.ContentLength = -1
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"net/http"
+	"strconv"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -23,9 +26,9 @@ func DefaultCORSConfig() CORSConfig {
 		},
 		AllowedHeaders: []string{
 			"Origin", "Content-Length", "Content-Type", "Authorization",
-			"X-Requested-With", "Accept", "Cache-Control",
+			"X-Requested-With", "Accept", "Cache-Control", "If-None-Match", RequestIDHeader,
 		},
-		ExposedHeaders:   []string{},
+		ExposedHeaders:   []string{"ETag", "Link", "X-Total-Count", RequestIDHeader},
 		AllowCredentials: false,
 		MaxAge:           12 * 60 * 60, // 12 hours
 	}
@@ -91,12 +94,12 @@ func CORSMiddleware(config CORSConfig) gin.HandlerFunc {
 
 		// Set Access-Control-Max-Age
 		if config.MaxAge > 0 {
-			c.Header("Access-Control-Max-Age", string(rune(config.MaxAge)))
+			c.Header("Access-Control-Max-Age", strconv.Itoa(config.MaxAge))
 		}
 
-		// Handle preflight requests
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
+		// Handle preflight requests; a plain OPTIONS request falls through to the router
+		if c.Request.Method == http.MethodOptions && c.Request.Header.Get("Access-Control-Request-Method") != "" {
+			c.AbortWithStatus(http.StatusNoContent)
 			return
 		}
 
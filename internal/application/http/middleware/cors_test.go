@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCORSRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CORSMiddleware(DefaultCORSConfig()))
+	router.GET("/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.OPTIONS("/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	router := newCORSRouter()
+
+	t.Run("max age is sent in seconds", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "43200", w.Header().Get("Access-Control-Max-Age"))
+		assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("preflight short-circuits", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/items", nil)
+		req.Header.Set("Origin", "https://example.com")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "43200", w.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("plain OPTIONS reaches the handler", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodOptions, "/items", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Error codes carried in ErrorResponse.Code
+// Messages are for people and may change; clients should branch on the code
+const (
+	CodeInvalidRequest           = "invalid_request"
+	CodeValidationFailed         = "validation_failed"
+	CodeUnauthorized             = "unauthorized"
+	CodeItemNotFound             = "item_not_found"
+	CodeCategoryNotFound         = "category_not_found"
+	CodeImageNotFound            = "image_not_found"
+	CodeFileNotFound             = "file_not_found"
+	CodeDuplicateSKU             = "duplicate_sku"
+	CodeItemInUse                = "item_in_use"
+	CodeItemArchived             = "item_archived"
+	CodeInventoryConflict        = "inventory_conflict"
+	CodeOutOfStock               = "out_of_stock"
+	CodeInvalidStatusTransition  = "invalid_status_transition"
+	CodeIdempotencyKeyInProgress = "idempotency_key_in_progress"
+	CodeIdempotencyKeyReused     = "idempotency_key_reused"
+	CodeRequestTooLarge          = "request_too_large"
+	CodeRateLimited              = "rate_limited"
+	CodeTimeout                  = "timeout"
+	CodeInternal                 = "internal_error"
+)
+
+// APIError is a failure reported to the client with its HTTP status and error code
+// Errors lists the fields at fault when the request failed validation
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+	Errors  []ValidationError
+}
+
+// NewAPIError creates an APIError
+func NewAPIError(status int, code, message string) *APIError {
+	return &APIError{Status: status, Code: code, Message: message}
+}
+
+// BadRequest creates a 400 APIError for a malformed or invalid request
+func BadRequest(message string) *APIError {
+	return NewAPIError(http.StatusBadRequest, CodeInvalidRequest, message)
+}
+
+// ValidationFailed creates a 400 APIError listing the fields that failed validation
+func ValidationFailed(errors ...ValidationError) *APIError {
+	return &APIError{Status: http.StatusBadRequest, Code: CodeValidationFailed, Message: "Validation failed", Errors: errors}
+}
+
+// InternalError creates a 500 APIError; message should not reveal internals
+func InternalError(message string) *APIError {
+	return NewAPIError(http.StatusInternalServerError, CodeInternal, message)
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// Response returns the body written for the error
+func (e *APIError) Response() ErrorResponse {
+	return ErrorResponse{Error: e.Message, Code: e.Code, Errors: e.Errors}
+}
+
+// WriteError writes err as the response
+func WriteError(c *gin.Context, err *APIError) {
+	c.JSON(err.Status, err.Response())
+}
+
+// AbortWithError writes err as the response and stops the remaining handlers
+func AbortWithError(c *gin.Context, err *APIError) {
+	c.AbortWithStatusJSON(err.Status, err.Response())
+}
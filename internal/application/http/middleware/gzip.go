@@ -0,0 +1,228 @@
+package middleware
+
+import (
+	"bufio"
+	"compress/gzip"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GzipConfig holds response compression settings
+type GzipConfig struct {
+	// MinSize is the smallest body in bytes worth compressing
+	MinSize int
+	// Level is a compress/gzip level; values outside -1 to 9 use gzip.DefaultCompression
+	Level int
+	// ExcludedContentTypes are media type prefixes that are already compressed or must not be buffered
+	ExcludedContentTypes []string
+}
+
+// DefaultGzipConfig returns default compression configuration
+func DefaultGzipConfig() GzipConfig {
+	return GzipConfig{
+		MinSize: 1024,
+		Level:   gzip.DefaultCompression,
+		ExcludedContentTypes: []string{
+			"image/", "video/", "audio/", "font/woff",
+			"application/gzip", "application/x-gzip", "application/zip", "application/pdf",
+			"text/event-stream",
+		},
+	}
+}
+
+// Gzip compresses responses for clients that accept gzip once the body reaches config.MinSize
+// Smaller bodies, excluded content types and responses that already carry a Content-Encoding
+// are sent unchanged. Every response gets Vary: Accept-Encoding so caches keep both forms apart
+func Gzip(config GzipConfig) gin.HandlerFunc {
+	if config.Level < gzip.HuffmanOnly || config.Level > gzip.BestCompression {
+		config.Level = gzip.DefaultCompression
+	}
+	writers := sync.Pool{New: func() interface{} {
+		w, _ := gzip.NewWriterLevel(nil, config.Level)
+		return w
+	}}
+
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		if c.Request.Method == http.MethodHead || !acceptsGzip(c.Request.Header.Get("Accept-Encoding")) {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &gzipWriter{ResponseWriter: original, config: &config, pool: &writers, status: http.StatusOK}
+		c.Writer = writer
+		defer func() {
+			writer.finish()
+			c.Writer = original
+		}()
+
+		c.Next()
+	}
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		q := strings.ReplaceAll(strings.ToLower(params), " ", "")
+		return q != "q=0" && q != "q=0.0" && q != "q=0.00" && q != "q=0.000"
+	}
+	return false
+}
+
+// gzipWriter holds the body back until it is known whether compression pays off
+// Once MinSize bytes have been written, or the handler flushes, the choice is made and kept
+type gzipWriter struct {
+	gin.ResponseWriter
+	config *GzipConfig
+	pool   *sync.Pool
+
+	status  int
+	buf     []byte
+	decided bool
+	gz      *gzip.Writer
+}
+
+func (w *gzipWriter) WriteHeader(code int) {
+	if !w.decided {
+		w.status = code
+	}
+}
+
+func (w *gzipWriter) WriteHeaderNow() {
+	w.decide()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *gzipWriter) Status() int {
+	if !w.decided {
+		return w.status
+	}
+	return w.ResponseWriter.Status()
+}
+
+// Written reports true once the handler has written anything, even while it is still buffered
+func (w *gzipWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+func (w *gzipWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		w.buf = append(w.buf, b...)
+		if len(w.buf) < w.config.MinSize {
+			return len(b), nil
+		}
+		if err := w.flushBuffer(); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+
+	if w.gz != nil {
+		return w.gz.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *gzipWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends what has been written so far; a stream flushed before MinSize stays uncompressed
+func (w *gzipWriter) Flush() {
+	if !w.decided {
+		_ = w.flushBuffer()
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *gzipWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.decide()
+	return w.ResponseWriter.Hijack()
+}
+
+// finish writes out a body that never reached MinSize and completes the gzip stream
+func (w *gzipWriter) finish() {
+	if !w.decided {
+		if len(w.buf) == 0 {
+			// Nothing written: leave the status for gin to send
+			w.decided = true
+			w.ResponseWriter.WriteHeader(w.status)
+			return
+		}
+		_ = w.flushBuffer()
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		w.gz.Reset(nil)
+		w.pool.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+// flushBuffer makes the choice and writes the held-back body through it
+func (w *gzipWriter) flushBuffer() error {
+	w.decide()
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// decide sends the headers, compressing when the buffered body is large enough and eligible
+func (w *gzipWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+
+	if len(w.buf) >= w.config.MinSize && w.compressible() {
+		header := w.ResponseWriter.Header()
+		if header.Get("Content-Type") == "" {
+			// Sniff before compressing, or net/http would sniff the gzip bytes instead
+			header.Set("Content-Type", http.DetectContentType(w.buf))
+		}
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+
+		w.gz = w.pool.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *gzipWriter) compressible() bool {
+	if w.status < http.StatusOK || w.status == http.StatusNoContent ||
+		w.status == http.StatusPartialContent || w.status == http.StatusNotModified {
+		return false
+	}
+
+	header := w.ResponseWriter.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	for _, excluded := range w.config.ExcludedContentTypes {
+		if strings.HasPrefix(contentType, excluded) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGzip(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	items := make([]gin.H, 200)
+	for i := range items {
+		items[i] = gin.H{"sku": "ELEC-000001", "name": "Wireless Headphones", "price": 199.99}
+	}
+
+	router := gin.New()
+	router.Use(Gzip(DefaultGzipConfig()))
+	router.GET("/items", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	})
+	router.GET("/tiny", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+	router.GET("/image", func(c *gin.Context) {
+		c.Data(http.StatusOK, "image/png", make([]byte, 4096))
+	})
+	router.GET("/stream", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+		_, _ = c.Writer.WriteString(strings.Repeat("data: x\n\n", 500))
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": strings.Repeat("missing ", 200)})
+	})
+
+	get := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("large JSON list is gzip encoded", func(t *testing.T) {
+		w := get("/items", "gzip, deflate, br")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+		reader, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+
+		var decoded struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal(body, &decoded))
+		assert.Len(t, decoded.Items, 200)
+	})
+
+	t.Run("tiny response is left uncompressed", func(t *testing.T) {
+		w := get("/tiny", "gzip")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+		assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+	})
+
+	t.Run("client without gzip gets plain body", func(t *testing.T) {
+		for _, acceptEncoding := range []string{"", "br", "gzip;q=0"} {
+			w := get("/items", acceptEncoding)
+
+			assert.Empty(t, w.Header().Get("Content-Encoding"), acceptEncoding)
+			assert.True(t, json.Valid(w.Body.Bytes()), acceptEncoding)
+		}
+	})
+
+	t.Run("already compressed content type is skipped", func(t *testing.T) {
+		w := get("/image", "gzip")
+
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Equal(t, 4096, w.Body.Len())
+	})
+
+	t.Run("event stream is skipped", func(t *testing.T) {
+		w := get("/stream", "gzip")
+
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.True(t, strings.HasPrefix(w.Body.String(), "data: x\n\n"))
+	})
+
+	t.Run("status is kept", func(t *testing.T) {
+		w := get("/missing", "gzip")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	})
+}
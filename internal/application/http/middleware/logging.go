@@ -11,6 +11,7 @@ import (
 // LoggingMiddleware creates a logging middleware
 func LoggingMiddleware() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		requestID, _ := param.Keys[RequestIDKey].(string)
 		log.Info().
 			Str("method", param.Method).
 			Str("path", param.Path).
@@ -19,6 +20,7 @@ func LoggingMiddleware() gin.HandlerFunc {
 			Str("client_ip", param.ClientIP).
 			Str("user_agent", param.Request.UserAgent()).
 			Int("body_size", param.BodySize).
+			Str(RequestIDKey, requestID).
 			Msg("HTTP Request")
 		return ""
 	})
@@ -68,6 +70,7 @@ func StructuredLoggingMiddleware(logger zerolog.Logger) gin.HandlerFunc {
 			Str("client_ip", clientIP).
 			Str("user_agent", userAgent).
 			Int("body_size", bodySize).
+			Str(RequestIDKey, c.GetString(RequestIDKey)).
 			Msg("HTTP Request")
 	}
 } 
\ No newline at end of file
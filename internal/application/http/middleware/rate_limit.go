@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// rateLimitCleanupInterval is how often idle client buckets are swept
+const rateLimitCleanupInterval = time.Minute
+
+// tokenBucket holds one client's remaining tokens as of last
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps a token bucket per client IP
+type rateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func newRateLimiter(rps, burst int, now func() time.Time) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:      float64(rps),
+		burst:     float64(burst),
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: now(),
+		now:       now,
+	}
+}
+
+// allow takes a token for key, or reports how long until one is available
+func (l *rateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate)
+	bucket.last = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+// sweep drops buckets that have been idle long enough to refill completely,
+// since a fresh bucket for that client would be identical
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitCleanupInterval {
+		return
+	}
+	l.lastSweep = now
+
+	full := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (l *rateLimiter) middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, wait := l.allow(c.ClientIP())
+		if !allowed {
+			seconds := int(math.Ceil(wait.Seconds()))
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			AbortWithError(c, NewAPIError(http.StatusTooManyRequests, CodeRateLimited, "Too many requests"))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RateLimit allows each client IP rps requests per second with bursts of up to burst requests
+// Requests over the limit get 429 with a Retry-After header; a non-positive rps disables limiting
+func RateLimit(rps, burst int) gin.HandlerFunc {
+	if rps <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	return newRateLimiter(rps, burst, time.Now).middleware()
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is advanced by hand so refills do not depend on wall time
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newRateLimitRouter(limiter *rateLimiter) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(limiter.middleware())
+	router.GET("/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func requestFrom(router *gin.Engine, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.RemoteAddr = ip + ":12345"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Run("request over the burst is rejected", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		router := newRateLimitRouter(newRateLimiter(1, 3, clock.Now))
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, requestFrom(router, "10.0.0.1").Code)
+		}
+
+		w := requestFrom(router, "10.0.0.1")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "1", w.Header().Get("Retry-After"))
+		assert.JSONEq(t, `{"error":"Too many requests","code":"rate_limited"}`, w.Body.String())
+	})
+
+	t.Run("request after refill succeeds", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		router := newRateLimitRouter(newRateLimiter(2, 1, clock.Now))
+
+		assert.Equal(t, http.StatusOK, requestFrom(router, "10.0.0.1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, requestFrom(router, "10.0.0.1").Code)
+
+		clock.now = clock.now.Add(500 * time.Millisecond)
+		assert.Equal(t, http.StatusOK, requestFrom(router, "10.0.0.1").Code)
+	})
+
+	t.Run("clients are limited separately", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		router := newRateLimitRouter(newRateLimiter(1, 1, clock.Now))
+
+		assert.Equal(t, http.StatusOK, requestFrom(router, "10.0.0.1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, requestFrom(router, "10.0.0.1").Code)
+		assert.Equal(t, http.StatusOK, requestFrom(router, "10.0.0.2").Code)
+	})
+
+	t.Run("idle buckets are swept", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		limiter := newRateLimiter(1, 5, clock.Now)
+
+		limiter.allow("10.0.0.1")
+		clock.now = clock.now.Add(rateLimitCleanupInterval)
+		limiter.allow("10.0.0.2")
+
+		assert.NotContains(t, limiter.buckets, "10.0.0.1")
+		assert.Contains(t, limiter.buckets, "10.0.0.2")
+	})
+
+	t.Run("non-positive rate disables limiting", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(RateLimit(0, 0))
+		router.GET("/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, http.StatusOK, requestFrom(router, "10.0.0.1").Code)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// RequestIDHeader carries the request ID in both directions
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDKey is the gin context key and log field holding the request ID
+	RequestIDKey = "request_id"
+	// maxRequestIDLength bounds client supplied IDs before they reach the logs
+	maxRequestIDLength = 128
+)
+
+type requestIDContextKey struct{}
+
+// RequestID tags each request with the caller's X-Request-ID, or a new UUID when it is
+// missing or unusable. The ID is echoed in the response, and the request context carries
+// it along with a logger that adds it to every entry written through log.Ctx
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		c.Set(RequestIDKey, id)
+		c.Header(RequestIDHeader, id)
+
+		logger := log.Logger.With().Str(RequestIDKey, id).Logger()
+		ctx := context.WithValue(c.Request.Context(), requestIDContextKey{}, id)
+		c.Request = c.Request.WithContext(logger.WithContext(ctx))
+
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, if any
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// validRequestID accepts short IDs made of characters that are safe to log and echo
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var seen, fromContext string
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/items", func(c *gin.Context) {
+		seen = c.GetString(RequestIDKey)
+		fromContext = RequestIDFromContext(c.Request.Context())
+		log.Ctx(c.Request.Context()).Info().Msg("handled")
+		c.Status(http.StatusOK)
+	})
+
+	t.Run("provided ID is echoed back", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		req.Header.Set(RequestIDHeader, "req-123")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, "req-123", w.Header().Get(RequestIDHeader))
+		assert.Equal(t, "req-123", seen)
+		assert.Equal(t, "req-123", fromContext)
+	})
+
+	t.Run("missing ID is generated as a UUID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+		id := w.Header().Get(RequestIDHeader)
+		_, err := uuid.Parse(id)
+		require.NoError(t, err)
+		assert.Equal(t, id, seen)
+	})
+
+	t.Run("unsafe ID is replaced", func(t *testing.T) {
+		for _, id := range []string{"has space", "line\nbreak", strings.Repeat("a", maxRequestIDLength+1)} {
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			req.Header.Set(RequestIDHeader, id)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			_, err := uuid.Parse(w.Header().Get(RequestIDHeader))
+			assert.NoError(t, err, id)
+		}
+	})
+
+	t.Run("context logger adds the ID to entries", func(t *testing.T) {
+		var buf bytes.Buffer
+		original := log.Logger
+		log.Logger = zerolog.New(&buf)
+		t.Cleanup(func() { log.Logger = original })
+
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+		req.Header.Set(RequestIDHeader, "req-456")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Contains(t, buf.String(), `"request_id":"req-456"`)
+		assert.Contains(t, buf.String(), `"message":"handled"`)
+	})
+}
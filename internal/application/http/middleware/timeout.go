@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// timeoutWriter drops a handler's response once its deadline has passed,
+// so the handler's own error for the cancelled context does not reach the client
+type timeoutWriter struct {
+	gin.ResponseWriter
+	ctx      context.Context
+	timedOut bool
+}
+
+// expired reports whether the response should be dropped; a response already
+// being written before the deadline is allowed to finish
+func (w *timeoutWriter) expired() bool {
+	if !w.timedOut && !w.ResponseWriter.Written() && w.ctx.Err() != nil {
+		w.timedOut = true
+	}
+	return w.timedOut
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	if w.expired() {
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *timeoutWriter) WriteHeaderNow() {
+	if w.expired() {
+		return
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *timeoutWriter) Write(b []byte) (int, error) {
+	if w.expired() {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	if w.expired() {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Timeout gives each request a context deadline of d and answers 503 when the
+// handler has not started responding by then. Handlers stop early by honouring
+// their request context; a non-positive d disables the deadline
+// Routes in longLived, matched by their registered path, are streams and get no deadline
+func Timeout(d time.Duration, longLived ...string) gin.HandlerFunc {
+	exempt := make(map[string]bool, len(longLived))
+	for _, path := range longLived {
+		exempt[path] = true
+	}
+
+	return func(c *gin.Context) {
+		if d <= 0 || exempt[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		original := c.Writer
+		writer := &timeoutWriter{ResponseWriter: original, ctx: ctx}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = original
+		if writer.expired() && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			AbortWithError(c, NewAPIError(http.StatusServiceUnavailable, CodeTimeout, "Request timed out"))
+		}
+	}
+}
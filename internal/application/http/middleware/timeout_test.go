@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Timeout(20*time.Millisecond, "/stream"))
+	router.GET("/fast", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+	router.GET("/slow", func(c *gin.Context) {
+		// Like a repository call, give up when the request context ends
+		<-c.Request.Context().Done()
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Failed to get item"})
+	})
+	router.GET("/stubborn", func(c *gin.Context) {
+		time.Sleep(40 * time.Millisecond)
+		c.JSON(http.StatusOK, gin.H{"status": "late"})
+	})
+	router.GET("/stream", func(c *gin.Context) {
+		_, hasDeadline := c.Request.Context().Deadline()
+		c.JSON(http.StatusOK, gin.H{"deadline": hasDeadline})
+	})
+
+	t.Run("handler within the deadline responds normally", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/fast", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+	})
+
+	t.Run("handler exceeding the timeout returns 503", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/slow", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.JSONEq(t, `{"error":"Request timed out","code":"timeout"}`, w.Body.String())
+	})
+
+	t.Run("late response from a handler ignoring the context is dropped", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stubborn", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.JSONEq(t, `{"error":"Request timed out","code":"timeout"}`, w.Body.String())
+	})
+
+	t.Run("long-lived route has no deadline", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stream", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"deadline":false}`, w.Body.String())
+	})
+}
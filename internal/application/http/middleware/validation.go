@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -18,6 +19,7 @@ type ValidationError struct {
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error  string            `json:"error"`
+	Code   string            `json:"code,omitempty"`
 	Errors []ValidationError `json:"errors,omitempty"`
 }
 
@@ -46,6 +48,7 @@ func ValidateJSON(obj interface{}) gin.HandlerFunc {
 
 			c.JSON(http.StatusBadRequest, ErrorResponse{
 				Error:  "Validation failed",
+				Code:   CodeValidationFailed,
 				Errors: validationErrors,
 			})
 			c.Abort()
@@ -78,15 +81,17 @@ func ValidateStruct(s interface{}) []ValidationError {
 // ValidateAndRespond validates a struct and responds with errors if any
 func ValidateAndRespond(c *gin.Context, s interface{}) bool {
 	if errors := ValidateStruct(s); len(errors) > 0 {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:  "Validation failed",
-			Errors: errors,
-		})
+		RespondValidationErrors(c, errors)
 		return false
 	}
 	return true
 }
 
+// RespondValidationErrors writes a 400 listing the fields that failed validation
+func RespondValidationErrors(c *gin.Context, errors []ValidationError) {
+	WriteError(c, ValidationFailed(errors...))
+}
+
 // getFieldName converts field name to snake_case
 func getFieldName(field string) string {
 	var result strings.Builder
@@ -107,8 +112,14 @@ func getValidationMessage(fe validator.FieldError) string {
 	case "email":
 		return "Must be a valid email address"
 	case "min":
+		if isNumber(fe.Kind()) {
+			return "Must be at least " + fe.Param()
+		}
 		return "Must be at least " + fe.Param() + " characters long"
 	case "max":
+		if isNumber(fe.Kind()) {
+			return "Must be at most " + fe.Param()
+		}
 		return "Must be at most " + fe.Param() + " characters long"
 	case "len":
 		return "Must be exactly " + fe.Param() + " characters long"
@@ -127,4 +138,15 @@ func getValidationMessage(fe validator.FieldError) string {
 	default:
 		return "Invalid value"
 	}
-} 
\ No newline at end of file
+}
+
+// isNumber reports whether kind is a numeric kind, whose min and max bound the value rather than its length
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"time"
+
 	"item-pdp-service/internal/application/http/handlers"
 	"item-pdp-service/internal/application/http/middleware"
 
@@ -11,60 +13,174 @@ import (
 func SetupRoutes(
 	router *gin.Engine,
 	itemHandler *handlers.ItemHandler,
+	adminHandler *handlers.AdminHandler,
+	webhookHandler *handlers.WebhookHandler,
+	authHandler *handlers.AuthHandler,
+	healthHandler *handlers.HealthHandler,
+	metricsHandler *handlers.MetricsHandler,
+	docsHandler *handlers.DocsHandler,
+	tokenVerifier middleware.TokenVerifier,
 ) {
-	// Health check endpoint
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"status":  "healthy",
-			"service": "item-pdp-service",
-		})
-	})
+	// Health check endpoints; /health stays as an alias of the liveness probe
+	router.GET("/health", healthHandler.Live)
+	router.GET("/health/live", healthHandler.Live)
+	router.GET("/health/ready", healthHandler.Ready)
+
+	authRequired := middleware.AuthRequired(tokenVerifier)
+
+	// Metrics for scraping, and the connection pool for tuning, which needs a token like /admin
+	router.GET("/metrics", metricsHandler.Metrics)
+	router.GET("/debug/db-pool", authRequired, metricsHandler.DBPool)
+
+	// OpenAPI document generated from the handler annotations, and Swagger UI to browse it
+	router.GET("/swagger.json", docsHandler.Spec)
+	router.GET("/swagger", docsHandler.UI)
 
 	// API v1 routes
 	v1 := router.Group("/api/v1")
 	{
-		setupItemRoutes(v1, itemHandler)
+		setupAuthRoutes(v1, authHandler)
+		setupItemRoutes(v1, itemHandler, authRequired)
+		setupAdminRoutes(v1, adminHandler, authRequired)
+		setupWebhookRoutes(v1, webhookHandler, authRequired)
+	}
+}
+
+// setupAuthRoutes configures authentication routes
+func setupAuthRoutes(rg *gin.RouterGroup, authHandler *handlers.AuthHandler) {
+	authGroup := rg.Group("/auth")
+	{
+		authGroup.POST("/token", authHandler.GenerateToken)
 	}
 }
 
 // setupItemRoutes configures item-related routes
-func setupItemRoutes(rg *gin.RouterGroup, itemHandler *handlers.ItemHandler) {
+// Reads are public; every mutation requires a bearer token
+func setupItemRoutes(rg *gin.RouterGroup, itemHandler *handlers.ItemHandler, authRequired gin.HandlerFunc) {
 	items := rg.Group("/items")
 	{
-		// Basic CRUD operations
-		items.POST("", itemHandler.CreateItem)
+		// Basic reads
 		items.GET("/:id", itemHandler.GetItem)
-		items.PUT("/:id", itemHandler.UpdateItem)
-		items.DELETE("/:id", itemHandler.DeleteItem)
+		items.GET("/:id/price-history", itemHandler.GetPriceHistory)
+		items.GET("/:id/availability", itemHandler.CheckAvailability)
+		items.GET("/:id/related", itemHandler.GetRelatedItems)
+		items.GET("/:id/stats", itemHandler.GetItemWithStats)
+		items.GET("/:id/events", itemHandler.StreamItemEvents)
 
 		// SKU-based operations
 		items.GET("/sku/:sku", itemHandler.GetItemBySKU)
+		items.GET("/barcode/:code", itemHandler.GetItemByBarcode)
+
+		// Search and filtering
+		items.GET("", itemHandler.ListItems)
+		items.GET("/summary", itemHandler.ListItemSummaries)
+		items.GET("/search", itemHandler.SearchItems)
+		items.GET("/suggest", itemHandler.SuggestItems)
+		items.GET("/category/:category", itemHandler.GetItemsByCategory)
+		items.GET("/brand/:brand", itemHandler.GetItemsByBrand)
+		items.GET("/available", itemHandler.GetAvailableItems)
+		items.GET("/popular", itemHandler.GetPopularItems)
+		items.GET("/low-stock", itemHandler.GetLowStockItems)
+
+		// Reporting
+		items.GET("/export", itemHandler.ExportItems)
+		items.GET("/stats", itemHandler.GetItemStats)
+	}
+
+	protected := items.Group("", authRequired)
+	{
+		// Basic CRUD operations
+		protected.POST("", itemHandler.CreateItem)
+		protected.POST("/bulk", itemHandler.CreateItemsBulk)
+		protected.POST("/bulk-delete", itemHandler.DeleteItemsBulk)
+		protected.POST("/import", itemHandler.ImportItems)
+		protected.PUT("/:id", itemHandler.UpdateItem)
+		protected.PATCH("/:id", itemHandler.PatchItem)
+		protected.DELETE("/:id", itemHandler.DeleteItem)
+		protected.POST("/:id/clone", itemHandler.CloneItem)
 
 		// Inventory management
-		items.PATCH("/:id/inventory", itemHandler.UpdateInventory)
+		protected.PATCH("/inventory/bulk", itemHandler.UpdateInventoryBulk)
+		protected.PATCH("/:id/inventory", itemHandler.UpdateInventory)
+		protected.POST("/:id/inventory/reserve", itemHandler.ReserveInventory)
+		protected.POST("/:id/inventory/release", itemHandler.ReleaseInventory)
 
 		// Image management
-		items.POST("/:id/images", itemHandler.AddImage)
+		protected.POST("/:id/images", itemHandler.AddImage)
+		protected.POST("/:id/images/upload", itemHandler.UploadImage)
+		protected.DELETE("/:id/images", itemHandler.RemoveImage)
+		protected.DELETE("/:id/images/:index", itemHandler.RemoveImageAt)
+		protected.PUT("/:id/images", itemHandler.ReplaceImages)
+		protected.PUT("/:id/images/order", itemHandler.ReorderImages)
+
+		// Attribute management
+		protected.DELETE("/:id/attributes/:key", itemHandler.RemoveAttribute)
 
 		// Status management
-		items.PATCH("/:id/activate", itemHandler.ActivateItem)
-		items.PATCH("/:id/deactivate", itemHandler.DeactivateItem)
+		protected.PATCH("/:id/activate", itemHandler.ActivateItem)
+		protected.PATCH("/:id/deactivate", itemHandler.DeactivateItem)
+		protected.POST("/:id/unarchive", itemHandler.UnarchiveItem)
 
-		// Search and filtering
-		items.GET("/search", itemHandler.SearchItems)
-		items.GET("/category/:category", itemHandler.GetItemsByCategory)
-		items.GET("/available", itemHandler.GetAvailableItems)
+		// Batch processing
+		protected.POST("/batch/process", itemHandler.ProcessItemsBatch)
+	}
+}
+
+// setupAdminRoutes configures administrative routes
+func setupAdminRoutes(rg *gin.RouterGroup, adminHandler *handlers.AdminHandler, authRequired gin.HandlerFunc) {
+	admin := rg.Group("/admin", authRequired)
+	{
+		admin.POST("/maintenance", adminHandler.RunMaintenance)
+	}
+}
+
+// setupWebhookRoutes configures webhook subscription routes
+func setupWebhookRoutes(rg *gin.RouterGroup, webhookHandler *handlers.WebhookHandler, authRequired gin.HandlerFunc) {
+	webhooks := rg.Group("/webhooks", authRequired)
+	{
+		webhooks.POST("", webhookHandler.RegisterWebhook)
 	}
 }
 
+// itemEventsPath is the event stream route; it stays open until the client leaves, so no request deadline applies
+const itemEventsPath = "/api/v1/items/:id/events"
+
+// MiddlewareOptions holds the configured request limits; zero values disable a limit
+type MiddlewareOptions struct {
+	RateLimitRPS   int
+	RateLimitBurst int
+	MaxBodySize    int64
+	RequestTimeout time.Duration
+	// Gzip compresses responses for clients that accept it; nil disables compression
+	Gzip *middleware.GzipConfig
+}
+
 // SetupMiddlewares configures all middlewares
-func SetupMiddlewares(router *gin.Engine) {
+func SetupMiddlewares(router *gin.Engine, opts MiddlewareOptions) {
 	// Recovery middleware
 	router.Use(gin.Recovery())
 
+	// Request ID middleware, so every later log entry can carry it
+	router.Use(middleware.RequestID())
+
 	// CORS middleware
 	router.Use(middleware.CORSMiddleware(middleware.DefaultCORSConfig()))
 
+	// Tracing middleware
+	router.Use(middleware.TracingMiddleware())
+
 	// Logging middleware
 	router.Use(middleware.LoggingMiddleware())
+
+	// Throttle each client IP before any route is reached
+	router.Use(middleware.RateLimit(opts.RateLimitRPS, opts.RateLimitBurst))
+
+	// Response compression
+	if opts.Gzip != nil {
+		router.Use(middleware.Gzip(*opts.Gzip))
+	}
+
+	// Request size and duration limits
+	router.Use(middleware.MaxBodySize(opts.MaxBodySize))
+	router.Use(middleware.Timeout(opts.RequestTimeout, itemEventsPath))
 } 
\ No newline at end of file
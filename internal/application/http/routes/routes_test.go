@@ -0,0 +1,89 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"item-pdp-service/docs"
+	"item-pdp-service/internal/application/http/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// undocumentedRoutes serve the spec itself
+var undocumentedRoutes = map[string]bool{
+	"GET /swagger.json": true,
+	"GET /swagger":      true,
+}
+
+var pathParam = regexp.MustCompile(`:(\w+)`)
+
+// TestSetupRoutes_MatchesSwagger fails when a route is added without annotations or
+// docs/swagger.json was not regenerated; run `make swagger` to fix it
+func TestSetupRoutes_MatchesSwagger(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	SetupRoutes(router,
+		handlers.NewItemHandler(nil),
+		handlers.NewAdminHandler(nil),
+		handlers.NewWebhookHandler(nil),
+		handlers.NewAuthHandler(nil),
+		handlers.NewHealthHandler(nil),
+		&handlers.MetricsHandler{},
+		handlers.NewDocsHandler(docs.SwaggerJSON),
+		nil,
+	)
+
+	var routed []string
+	for _, route := range router.Routes() {
+		key := route.Method + " " + pathParam.ReplaceAllString(route.Path, "{$1}")
+		if !undocumentedRoutes[key] {
+			routed = append(routed, key)
+		}
+	}
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(docs.SwaggerJSON, &spec))
+
+	var documented []string
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	sort.Strings(routed)
+	sort.Strings(documented)
+	assert.Equal(t, routed, documented)
+}
+
+func TestSetupRoutes_DBPoolRequiresToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	SetupRoutes(router,
+		handlers.NewItemHandler(nil),
+		handlers.NewAdminHandler(nil),
+		handlers.NewWebhookHandler(nil),
+		handlers.NewAuthHandler(nil),
+		handlers.NewHealthHandler(nil),
+		&handlers.MetricsHandler{},
+		handlers.NewDocsHandler(docs.SwaggerJSON),
+		nil,
+	)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/db-pool", nil))
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
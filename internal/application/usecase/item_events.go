@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/domain/item"
+)
+
+// StreamedEventTypes are the events delivered to clients watching an item
+var StreamedEventTypes = []string{"ItemPriceChanged", "ItemInventoryUpdated", "ItemStatusChanged"}
+
+// EventSubscriber delivers the events raised by one aggregate while subscribed
+type EventSubscriber interface {
+	// Subscribe listens for events of the given types; the returned function stops listening
+	Subscribe(aggregateID string, types ...string) (<-chan item.DomainEvent, func())
+}
+
+// WithEventSubscriber lets clients watch items through SubscribeItemEvents
+func WithEventSubscriber(subscriber EventSubscriber) Option {
+	return func(uc *itemUseCase) {
+		uc.subscriber = subscriber
+	}
+}
+
+// SubscribeItemEvents streams the item's price, inventory and status changes as they happen
+// The channel is closed once ctx is done, which also ends the subscription
+func (uc *itemUseCase) SubscribeItemEvents(ctx context.Context, id string) (<-chan dto.ItemEventResponse, error) {
+	if uc.subscriber == nil {
+		return nil, errors.New("item events are not available")
+	}
+
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	exists, err := uc.itemRepository.ExistsByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check item: %w", err)
+	}
+	if !exists {
+		return nil, item.ItemNotFoundError(itemID)
+	}
+
+	events, unsubscribe := uc.subscriber.Subscribe(itemID.String(), StreamedEventTypes...)
+	out := make(chan dto.ItemEventResponse)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				select {
+				case out <- mapEventToResponse(event):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func mapEventToResponse(event item.DomainEvent) dto.ItemEventResponse {
+	return dto.ItemEventResponse{
+		ID:         event.EventID(),
+		Type:       event.EventType(),
+		ItemID:     event.AggregateID(),
+		OccurredAt: event.OccurredAt(),
+		Data:       event.EventData(),
+	}
+}
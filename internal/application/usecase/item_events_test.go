@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemUseCase_SubscribeItemEvents(t *testing.T) {
+	newUseCase := func(mockRepo *MockItemRepository, broadcaster *events.Broadcaster) (ItemUseCase, events.Publisher) {
+		publisher := events.Chain{events.NewDispatcher(), broadcaster}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, publisher,
+			WithEventSubscriber(broadcaster))
+		return useCase, publisher
+	}
+
+	receive := func(t *testing.T, stream <-chan dto.ItemEventResponse) dto.ItemEventResponse {
+		t.Helper()
+		select {
+		case event, ok := <-stream:
+			require.True(t, ok, "stream closed early")
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("no event delivered")
+			return dto.ItemEventResponse{}
+		}
+	}
+
+	t.Run("delivers a price change published for the item", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		broadcaster := events.NewBroadcaster()
+		useCase, publisher := newUseCase(mockRepo, broadcaster)
+
+		itm := createTestItem(t)
+		other := createTestItem(t)
+		mockRepo.On("ExistsByID", mock.Anything, itm.ID()).Return(true, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream, err := useCase.SubscribeItemEvents(ctx, itm.ID().String())
+		require.NoError(t, err)
+
+		newPrice, err := item.NewPrice(79.99, "USD")
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish(context.Background(), []item.DomainEvent{
+			item.NewItemCreatedEvent(itm),
+			item.NewItemPriceChangedEvent(other.ID(), other.Price(), newPrice),
+			item.NewItemPriceChangedEvent(itm.ID(), itm.Price(), newPrice),
+		}))
+
+		event := receive(t, stream)
+		assert.Equal(t, "ItemPriceChanged", event.Type)
+		assert.Equal(t, itm.ID().String(), event.ItemID)
+		assert.Equal(t, 79.99, event.Data.(map[string]interface{})["newPrice"])
+	})
+
+	t.Run("disconnect unsubscribes and closes the stream", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		broadcaster := events.NewBroadcaster()
+		useCase, _ := newUseCase(mockRepo, broadcaster)
+
+		itm := createTestItem(t)
+		mockRepo.On("ExistsByID", mock.Anything, itm.ID()).Return(true, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := useCase.SubscribeItemEvents(ctx, itm.ID().String())
+		require.NoError(t, err)
+		assert.Equal(t, 1, broadcaster.Subscribers(itm.ID().String()))
+
+		cancel()
+
+		select {
+		case _, ok := <-stream:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("stream not closed after disconnect")
+		}
+		assert.Equal(t, 0, broadcaster.Subscribers(itm.ID().String()))
+	})
+
+	t.Run("unknown item is not subscribed", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		broadcaster := events.NewBroadcaster()
+		useCase, _ := newUseCase(mockRepo, broadcaster)
+
+		itm := createTestItem(t)
+		mockRepo.On("ExistsByID", mock.Anything, itm.ID()).Return(false, nil)
+
+		_, err := useCase.SubscribeItemEvents(context.Background(), itm.ID().String())
+
+		assert.ErrorIs(t, err, item.ErrItemNotFound)
+		assert.Equal(t, 0, broadcaster.Subscribers(itm.ID().String()))
+	})
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultIdempotencyTTL is how long a key is remembered when no window is configured
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+var (
+	// ErrIdempotencyKeyReused is returned when a key is replayed with a different request body
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused")
+	// ErrIdempotencyKeyInProgress is returned when the first request for a key has not finished yet
+	ErrIdempotencyKeyInProgress = errors.New("idempotency key in progress")
+)
+
+// IdempotencyRecord is what was stored for a key by an earlier request
+// Response is empty until that request has completed
+type IdempotencyRecord struct {
+	RequestHash string
+	ItemID      string
+	Response    []byte
+}
+
+// IdempotencyStore remembers idempotency keys and the responses they produced
+type IdempotencyStore interface {
+	// Reserve claims key for ttl. It returns nil when the key was free or had expired,
+	// otherwise the record left by the request that holds it
+	Reserve(ctx context.Context, key, requestHash string, ttl time.Duration) (*IdempotencyRecord, error)
+	// Complete stores the response for a reserved key
+	Complete(ctx context.Context, key, itemID string, response []byte) error
+	// Release frees a reserved key so the request can be retried
+	Release(ctx context.Context, key string) error
+}
+
+// WithIdempotency enables Idempotency-Key support on CreateItem; a non-positive ttl keeps the default
+func WithIdempotency(store IdempotencyStore, ttl time.Duration) Option {
+	return func(uc *itemUseCase) {
+		if ttl <= 0 {
+			ttl = DefaultIdempotencyTTL
+		}
+		uc.idempotency = store
+		uc.idempotencyTTL = ttl
+	}
+}
+
+// createItemOnce runs CreateItem at most once per idempotency key
+// A repeated key with the same body returns the stored response instead of creating another item
+func (uc *itemUseCase) createItemOnce(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error) {
+	hash, err := hashCreateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.idempotency.Reserve(ctx, req.IdempotencyKey, hash, uc.idempotencyTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if existing != nil {
+		return replayCreateItem(ctx, existing, hash)
+	}
+
+	resp, err := uc.createItem(ctx, req)
+	if err != nil {
+		if releaseErr := uc.idempotency.Release(ctx, req.IdempotencyKey); releaseErr != nil {
+			log.Ctx(ctx).Error().Err(releaseErr).Msg("Failed to release idempotency key")
+		}
+		return nil, err
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode idempotent response: %w", err)
+	}
+	// The item exists at this point, so a failure here is logged rather than returned;
+	// the key stays reserved and a retry reports it as in progress until it expires
+	if err := uc.idempotency.Complete(ctx, req.IdempotencyKey, resp.ID, body); err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("item_id", resp.ID).Msg("Failed to store idempotent response")
+	}
+
+	return resp, nil
+}
+
+// replayCreateItem decodes the response stored for a key
+func replayCreateItem(ctx context.Context, record *IdempotencyRecord, hash string) (*dto.ItemResponse, error) {
+	if record.RequestHash != hash {
+		return nil, fmt.Errorf("%w: key was first used with a different request", ErrIdempotencyKeyReused)
+	}
+	if len(record.Response) == 0 {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+
+	var resp dto.ItemResponse
+	if err := json.Unmarshal(record.Response, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotent response: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("item_id", record.ItemID).
+		Msg("Replayed idempotent item creation")
+
+	return &resp, nil
+}
+
+// hashCreateRequest fingerprints the request body so a key cannot be replayed with other data
+func hashCreateRequest(req *dto.CreateItemRequest) (string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash request: %w", err)
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryIdempotencyStore keeps keys in a map and never expires them
+type memoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]*IdempotencyRecord
+	ttl     time.Duration
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{records: make(map[string]*IdempotencyRecord)}
+}
+
+func (s *memoryIdempotencyStore) Reserve(ctx context.Context, key, requestHash string, ttl time.Duration) (*IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ttl = ttl
+	if record, ok := s.records[key]; ok {
+		copied := *record
+		return &copied, nil
+	}
+	s.records[key] = &IdempotencyRecord{RequestHash: requestHash}
+	return nil, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(ctx context.Context, key, itemID string, response []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[key].ItemID = itemID
+	s.records[key].Response = response
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func TestItemUseCase_CreateItemIdempotency(t *testing.T) {
+	newIdempotentUseCase := func(store IdempotencyStore) (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "electronics").Return(99.99, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		mockInventory := &MockInventoryService{}
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 10).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, mock.AnythingOfType("string"), 10).Return(nil).Maybe()
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher(),
+			WithIdempotency(store, time.Hour))
+		return useCase, mockRepo
+	}
+	newRequest := func(key string) *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{
+			SKU:            "TEST-001",
+			Name:           "Test Item",
+			Price:          99.99,
+			Category:       "electronics",
+			Inventory:      10,
+			IdempotencyKey: key,
+		}
+	}
+
+	t.Run("same key creates one item and replays the response", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		useCase, mockRepo := newIdempotentUseCase(store)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		first, err := useCase.CreateItem(context.Background(), newRequest("key-1"))
+		require.NoError(t, err)
+		second, err := useCase.CreateItem(context.Background(), newRequest("key-1"))
+		require.NoError(t, err)
+
+		firstJSON, _ := json.Marshal(first)
+		secondJSON, _ := json.Marshal(second)
+		assert.JSONEq(t, string(firstJSON), string(secondJSON))
+		assert.Equal(t, first.ID, store.records["key-1"].ItemID)
+		assert.Equal(t, time.Hour, store.ttl)
+		mockRepo.AssertNumberOfCalls(t, "Save", 1)
+	})
+
+	t.Run("different keys create two items", func(t *testing.T) {
+		useCase, mockRepo := newIdempotentUseCase(newMemoryIdempotencyStore())
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		first, err := useCase.CreateItem(context.Background(), newRequest("key-1"))
+		require.NoError(t, err)
+		second, err := useCase.CreateItem(context.Background(), newRequest("key-2"))
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.ID, second.ID)
+		mockRepo.AssertNumberOfCalls(t, "Save", 2)
+	})
+
+	t.Run("no key skips the store", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		useCase, mockRepo := newIdempotentUseCase(store)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		_, err := useCase.CreateItem(context.Background(), newRequest(""))
+		require.NoError(t, err)
+		_, err = useCase.CreateItem(context.Background(), newRequest(""))
+		require.NoError(t, err)
+
+		assert.Empty(t, store.records)
+		mockRepo.AssertNumberOfCalls(t, "Save", 2)
+	})
+
+	t.Run("key reused with a different body is rejected", func(t *testing.T) {
+		useCase, mockRepo := newIdempotentUseCase(newMemoryIdempotencyStore())
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		_, err := useCase.CreateItem(context.Background(), newRequest("key-1"))
+		require.NoError(t, err)
+
+		changed := newRequest("key-1")
+		changed.Name = "Other Item"
+		_, err = useCase.CreateItem(context.Background(), changed)
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+		mockRepo.AssertNumberOfCalls(t, "Save", 1)
+	})
+
+	t.Run("key held by an unfinished request", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		useCase, mockRepo := newIdempotentUseCase(store)
+		hash, err := hashCreateRequest(newRequest("key-1"))
+		require.NoError(t, err)
+		store.records["key-1"] = &IdempotencyRecord{RequestHash: hash}
+
+		_, err = useCase.CreateItem(context.Background(), newRequest("key-1"))
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInProgress)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed creation releases the key", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		useCase, mockRepo := newIdempotentUseCase(store)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(errors.New("database down")).Once()
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil).Once()
+
+		_, err := useCase.CreateItem(context.Background(), newRequest("key-1"))
+		require.Error(t, err)
+		assert.NotContains(t, store.records, "key-1")
+
+		_, err = useCase.CreateItem(context.Background(), newRequest("key-1"))
+		assert.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "Save", 2)
+	})
+}
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// MaxImportRows bounds one CSV import; strict imports hold every row in memory until commit
+	MaxImportRows = 10000
+	// importBatchSize is how many rows a lenient import writes per SaveAll call
+	importBatchSize = 100
+)
+
+// CSVColumns are the columns of the item CSV format shared by import and export
+// Imports must provide all of them, in any order
+var CSVColumns = []string{"sku", "name", "description", "price", "currency", "category", "inventory"}
+
+// importValidator applies the CreateItemRequest rules and reports fields by their CSV column name
+var importValidator = func() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	})
+	return v
+}()
+
+// ImportItemsCSV creates items from CSV rows, reporting the outcome per row
+// Each row goes through the same checks as CreateItem. Lenient imports skip bad rows and
+// write the rest in batches; strict imports write nothing unless every row is valid
+func (uc *itemUseCase) ImportItemsCSV(ctx context.Context, r io.Reader, strict bool) (*dto.ImportResult, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: unreadable header: %v", ErrInvalidImport, err)
+	}
+	columns, err := importColumnIndex(header)
+	if err != nil {
+		return nil, err
+	}
+	reader.FieldsPerRecord = len(header)
+
+	result := &dto.ImportResult{
+		Rows:   make([]dto.ImportRowResult, 0),
+		Strict: strict,
+	}
+
+	var (
+		pending   []*item.Item
+		positions []int
+		warnings  [][]string
+		seenSKUs  = make(map[string]int)
+	)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if err != nil && !errors.As(err, &parseErr) {
+			return nil, fmt.Errorf("failed to read import: %w", err)
+		}
+		if result.Total == MaxImportRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidImport, MaxImportRows)
+		}
+
+		idx := len(result.Rows)
+		result.Total++
+		if parseErr != nil {
+			result.Rows = append(result.Rows, dto.ImportRowResult{Row: parseErr.StartLine})
+			failImportRow(result, idx, fmt.Errorf("malformed row: %w", parseErr.Err))
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		result.Rows = append(result.Rows, dto.ImportRowResult{Row: line})
+
+		req, err := importRowToRequest(record, columns)
+		result.Rows[idx].SKU = req.SKU
+		if err != nil {
+			failImportRow(result, idx, err)
+			continue
+		}
+
+		sku := uc.skus.Normalize(req.SKU)
+		if first, dup := seenSKUs[sku]; dup {
+			failImportRow(result, idx, fmt.Errorf("duplicate SKU %s in file (first at row %d)", sku, first))
+			continue
+		}
+		seenSKUs[sku] = line
+
+		domainItem, err := uc.buildItem(ctx, req, true)
+		if err != nil {
+			failImportRow(result, idx, err)
+			continue
+		}
+		pending = append(pending, domainItem)
+		positions = append(positions, idx)
+		warnings = append(warnings, uc.applyCorrections(req, domainItem))
+
+		if !strict && len(pending) == importBatchSize {
+			if err := uc.saveImportBatch(ctx, result, pending, positions, warnings, false); err != nil {
+				return nil, err
+			}
+			pending, positions, warnings = pending[:0], positions[:0], warnings[:0]
+		}
+	}
+
+	if result.Total == 0 {
+		return nil, fmt.Errorf("%w: file has no rows", ErrInvalidImport)
+	}
+
+	if strict && result.Failed > 0 {
+		markImportRolledBack(result)
+	} else if len(pending) > 0 {
+		if err := uc.saveImportBatch(ctx, result, pending, positions, warnings, strict); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Ctx(ctx).Info().
+		Int("total", result.Total).
+		Int("created", result.Created).
+		Int("failed", result.Failed).
+		Int("rolled_back", result.RolledBack).
+		Bool("strict", strict).
+		Msg("CSV item import finished")
+
+	return result, nil
+}
+
+// saveImportBatch writes one batch and records each row's outcome
+// In strict mode the batch is every valid row, so a save failure rolls back the whole import
+func (uc *itemUseCase) saveImportBatch(ctx context.Context, result *dto.ImportResult, pending []*item.Item, positions []int, warnings [][]string, strict bool) error {
+	itemErrs, err := uc.itemRepository.SaveAll(ctx, pending, strict)
+	for j, idx := range positions {
+		if j < len(itemErrs) && itemErrs[j] != nil {
+			failImportRow(result, idx, fmt.Errorf("failed to save item: %w", itemErrs[j]))
+		}
+	}
+
+	if err != nil {
+		if !strict || result.Failed == 0 {
+			return fmt.Errorf("failed to save items: %w", err)
+		}
+		markImportRolledBack(result)
+		return nil
+	}
+
+	for j, idx := range positions {
+		if result.Rows[idx].Status == dto.BulkItemFailed {
+			continue
+		}
+		uc.dispatchEvents(ctx, pending[j])
+		result.Rows[idx].Status = dto.BulkItemCreated
+		result.Rows[idx].Item = uc.mapItemToResponse(pending[j])
+		result.Rows[idx].Item.Warnings = warnings[j]
+		result.Created++
+	}
+	return nil
+}
+
+// importColumnIndex maps each required column to its position in the header
+func importColumnIndex(header []string) (map[string]int, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheet exports often start with a UTF-8 byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, dup := index[name]; dup {
+			return nil, fmt.Errorf("%w: duplicate column %s", ErrInvalidImport, name)
+		}
+		index[name] = i
+	}
+
+	var missing []string
+	for _, name := range CSVColumns {
+		if _, ok := index[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing columns %s", ErrInvalidImport, strings.Join(missing, ", "))
+	}
+	return index, nil
+}
+
+// importRowToRequest parses and validates one CSV record as a create request
+// The request is returned even on error so the caller can report the row's SKU
+func importRowToRequest(record []string, columns map[string]int) (*dto.CreateItemRequest, error) {
+	field := func(name string) string {
+		return strings.TrimSpace(record[columns[name]])
+	}
+
+	req := &dto.CreateItemRequest{
+		SKU:         field("sku"),
+		Name:        field("name"),
+		Description: field("description"),
+		Currency:    strings.ToUpper(field("currency")),
+		Category:    field("category"),
+	}
+
+	price, err := strconv.ParseFloat(field("price"), 64)
+	if err != nil || math.IsNaN(price) || math.IsInf(price, 0) {
+		return req, fmt.Errorf("invalid price %q", field("price"))
+	}
+	req.Price = price
+
+	if raw := field("inventory"); raw != "" {
+		inventory, err := strconv.Atoi(raw)
+		if err != nil {
+			return req, fmt.Errorf("invalid inventory %q", raw)
+		}
+		req.Inventory = inventory
+	}
+
+	if err := importValidator.Struct(req); err != nil {
+		var fieldErrs validator.ValidationErrors
+		if !errors.As(err, &fieldErrs) {
+			return req, err
+		}
+		problems := make([]string, 0, len(fieldErrs))
+		for _, fe := range fieldErrs {
+			problem := fe.Field() + " failed " + fe.Tag()
+			if fe.Param() != "" {
+				problem += "=" + fe.Param()
+			}
+			problems = append(problems, problem)
+		}
+		return req, errors.New(strings.Join(problems, "; "))
+	}
+
+	return req, nil
+}
+
+func failImportRow(result *dto.ImportResult, idx int, err error) {
+	result.Rows[idx].Status = dto.BulkItemFailed
+	result.Rows[idx].Error = err.Error()
+	result.Failed++
+}
+
+// markImportRolledBack reports every row that did not fail on its own as rolled back
+func markImportRolledBack(result *dto.ImportResult) {
+	for idx := range result.Rows {
+		if result.Rows[idx].Status == dto.BulkItemFailed {
+			continue
+		}
+		result.Rows[idx].Status = dto.BulkItemRolledBack
+		result.Rows[idx].Item = nil
+		result.RolledBack++
+	}
+}
+
+// ExportItems passes every item matching the filters to fn, oldest first
+// Items are streamed from the repository one at a time; an error from fn stops the export
+func (uc *itemUseCase) ExportItems(ctx context.Context, req *dto.ExportRequest, fn func(*dto.ItemResponse) error) error {
+	var filter item.StreamFilter
+	if req.Category != "" {
+		category, err := item.NewCategory(req.Category)
+		if err != nil {
+			return fmt.Errorf("%w: category: %v", ErrInvalidFilter, err)
+		}
+		filter.Category = &category
+	}
+	if req.Status != "" {
+		status, err := item.StatusFromString(req.Status)
+		if err != nil {
+			return fmt.Errorf("%w: status: %v", ErrInvalidFilter, err)
+		}
+		filter.Status = &status
+	}
+
+	return uc.itemRepository.Stream(ctx, filter, func(itm *item.Item) error {
+		return fn(uc.mapItemToResponse(itm))
+	})
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// importCSV has one bad row: line 3 carries a price that is not a number
+const importCSV = `sku,name,description,price,currency,category,inventory
+CSV-001,First Item,Loaded from a sheet,10,USD,toys,5
+CSV-002,Second Item,,ten,USD,toys,5
+CSV-003,Third Item,"Quoted, with a comma",30,usd,toys,
+`
+
+func TestItemUseCase_ImportItemsCSV(t *testing.T) {
+	newImportUseCase := func() (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		for _, price := range []float64{10, 30} {
+			mockPricing.On("CalculatePrice", mock.Anything, price, "toys").Return(price, nil)
+		}
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+		return useCase, mockRepo
+	}
+
+	t.Run("lenient skips the bad row and creates the rest", func(t *testing.T) {
+		useCase, mockRepo := newImportUseCase()
+		mockRepo.On("SaveAll", mock.Anything, mock.MatchedBy(func(items []*item.Item) bool {
+			return len(items) == 2 && items[0].SKU().String() == "CSV-001" && items[1].SKU().String() == "CSV-003"
+		}), false).Return([]error{nil, nil}, nil)
+
+		result, err := useCase.ImportItemsCSV(context.Background(), strings.NewReader(importCSV), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, 0, result.RolledBack)
+		assert.False(t, result.Strict)
+
+		require.Len(t, result.Rows, 3)
+		assert.Equal(t, 2, result.Rows[0].Row)
+		assert.Equal(t, dto.BulkItemCreated, result.Rows[0].Status)
+		require.NotNil(t, result.Rows[0].Item)
+		assert.Equal(t, 5, result.Rows[0].Item.Inventory.Quantity)
+
+		assert.Equal(t, 3, result.Rows[1].Row)
+		assert.Equal(t, "CSV-002", result.Rows[1].SKU)
+		assert.Equal(t, dto.BulkItemFailed, result.Rows[1].Status)
+		assert.Contains(t, result.Rows[1].Error, `invalid price "ten"`)
+
+		assert.Equal(t, 4, result.Rows[2].Row)
+		assert.Equal(t, dto.BulkItemCreated, result.Rows[2].Status)
+		assert.Equal(t, "Quoted, with a comma", result.Rows[2].Item.Description)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("strict creates nothing when a row is bad", func(t *testing.T) {
+		useCase, mockRepo := newImportUseCase()
+
+		result, err := useCase.ImportItemsCSV(context.Background(), strings.NewReader(importCSV), true)
+
+		require.NoError(t, err)
+		assert.True(t, result.Strict)
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 0, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, 2, result.RolledBack)
+		assert.Equal(t, dto.BulkItemRolledBack, result.Rows[0].Status)
+		assert.Nil(t, result.Rows[0].Item)
+		assert.Equal(t, dto.BulkItemFailed, result.Rows[1].Status)
+		assert.Equal(t, dto.BulkItemRolledBack, result.Rows[2].Status)
+		mockRepo.AssertNotCalled(t, "SaveAll", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("strict saves every row in one transaction", func(t *testing.T) {
+		useCase, mockRepo := newImportUseCase()
+		mockRepo.On("SaveAll", mock.Anything, mock.AnythingOfType("[]*item.Item"), true).Return([]error{nil, nil}, nil)
+
+		valid := strings.Replace(importCSV, "CSV-002,Second Item,,ten,USD,toys,5\n", "", 1)
+		result, err := useCase.ImportItemsCSV(context.Background(), strings.NewReader(valid), true)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 0, result.Failed)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("malformed and duplicate rows are reported", func(t *testing.T) {
+		useCase, mockRepo := newImportUseCase()
+		mockRepo.On("SaveAll", mock.Anything, mock.AnythingOfType("[]*item.Item"), false).Return([]error{nil}, nil)
+
+		file := "sku,name,description,price,currency,category,inventory\n" +
+			"CSV-001,First Item,,10,USD,toys,5\n" +
+			"CSV-009,Short Row\n" +
+			"csv-001,Again,,10,USD,toys,5\n"
+		result, err := useCase.ImportItemsCSV(context.Background(), strings.NewReader(file), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 2, result.Failed)
+		assert.Equal(t, 3, result.Rows[1].Row)
+		assert.Contains(t, result.Rows[1].Error, "malformed row")
+		assert.Contains(t, result.Rows[2].Error, "duplicate SKU CSV-001 in file (first at row 2)")
+	})
+
+	t.Run("missing columns reject the file", func(t *testing.T) {
+		useCase, mockRepo := newImportUseCase()
+
+		_, err := useCase.ImportItemsCSV(context.Background(), strings.NewReader("sku,name,price\nCSV-001,First,10\n"), false)
+
+		assert.ErrorIs(t, err, ErrInvalidImport)
+		assert.Contains(t, err.Error(), "description, currency, category, inventory")
+		mockRepo.AssertNotCalled(t, "ExistsBySKU", mock.Anything, mock.Anything)
+	})
+
+	t.Run("header only is rejected", func(t *testing.T) {
+		useCase, _ := newImportUseCase()
+
+		_, err := useCase.ImportItemsCSV(context.Background(), strings.NewReader("sku,name,description,price,currency,category,inventory\n"), false)
+
+		assert.ErrorIs(t, err, ErrInvalidImport)
+	})
+}
+
+func TestItemUseCase_ExportItems(t *testing.T) {
+	newExportUseCase := func() (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+		return useCase, mockRepo
+	}
+
+	t.Run("filters are passed to the repository stream", func(t *testing.T) {
+		useCase, mockRepo := newExportUseCase()
+		testItem := createTestItem(t)
+		mockRepo.On("Stream", mock.Anything, mock.MatchedBy(func(filter item.StreamFilter) bool {
+			return filter.Category != nil && filter.Category.Slug() == "electronics" &&
+				filter.Status != nil && *filter.Status == item.StatusActive
+		})).Return([]*item.Item{testItem}, nil)
+
+		var exported []*dto.ItemResponse
+		err := useCase.ExportItems(context.Background(), &dto.ExportRequest{
+			Format:   "csv",
+			Category: "electronics",
+			Status:   "active",
+		}, func(resp *dto.ItemResponse) error {
+			exported = append(exported, resp)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, exported, 1)
+		assert.Equal(t, testItem.SKU().String(), exported[0].SKU)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no filters stream everything", func(t *testing.T) {
+		useCase, mockRepo := newExportUseCase()
+		mockRepo.On("Stream", mock.Anything, item.StreamFilter{}).Return(nil, nil)
+
+		err := useCase.ExportItems(context.Background(), &dto.ExportRequest{Format: "json"}, func(*dto.ItemResponse) error {
+			return nil
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown status is rejected before streaming", func(t *testing.T) {
+		useCase, mockRepo := newExportUseCase()
+
+		err := useCase.ExportItems(context.Background(), &dto.ExportRequest{Format: "csv", Status: "sold"}, func(*dto.ItemResponse) error {
+			return nil
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Stream", mock.Anything, mock.Anything)
+	})
+}
@@ -1,14 +1,24 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"item-pdp-service/internal/application/dto"
 	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/events"
+	"item-pdp-service/internal/infrastructure/storage"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 )
 
@@ -16,21 +26,155 @@ import (
 // Contains business logic that should be in domain - anti-pattern
 type ItemUseCase interface {
 	CreateItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error)
+	CreateItemsBulk(ctx context.Context, reqs []*dto.CreateItemRequest) (*dto.BulkCreateResult, error)
+	// UpsertItem creates the item req describes, or updates the item already stored under its SKU,
+	// so sync pipelines can re-send items without checking which exist. It returns the stored item
+	UpsertItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error)
+	ImportItemsCSV(ctx context.Context, r io.Reader, strict bool) (*dto.ImportResult, error)
+	ExportItems(ctx context.Context, req *dto.ExportRequest, fn func(*dto.ItemResponse) error) error
+	// SubscribeItemEvents delivers an item's changes on the returned channel until ctx is done
+	SubscribeItemEvents(ctx context.Context, id string) (<-chan dto.ItemEventResponse, error)
 	GetItemByID(ctx context.Context, id string) (*dto.ItemResponse, error)
+	GetItemInCurrency(ctx context.Context, id, currency string) (*dto.ItemResponse, error)
 	GetItemBySKU(ctx context.Context, sku string) (*dto.ItemResponse, error)
+	// GetItemByBarcode looks an item up by GTIN-13 or UPC-A code
+	GetItemByBarcode(ctx context.Context, code string) (*dto.ItemResponse, error)
+	GetItemsByIDs(ctx context.Context, ids []string) ([]dto.ItemResponse, error)
+	// GetItemWithStats returns an item with its view count and rating
+	GetItemWithStats(ctx context.Context, id string) (*dto.ItemWithStatsResponse, error)
+	GetPriceHistory(ctx context.Context, id string, page, pageSize int) (*dto.PriceHistoryResponse, error)
 	UpdateItem(ctx context.Context, id string, req *dto.UpdateItemRequest) (*dto.ItemResponse, error)
 	UpdateInventory(ctx context.Context, id string, req *dto.UpdateInventoryRequest) (*dto.ItemResponse, error)
+	// UpdateInventoryBulk sets stock by SKU in one transaction; a failed SKU does not stop the others
+	UpdateInventoryBulk(ctx context.Context, updates []dto.InventoryUpdate) (*dto.BulkInventoryResult, error)
+	ReserveInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error)
+	ReleaseInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error)
+	// CheckAvailability reports whether quantity units of an item could be reserved now; nothing is held
+	CheckAvailability(ctx context.Context, id string, quantity int) (*dto.ItemAvailabilityResponse, error)
 	AddImage(ctx context.Context, id string, req *dto.AddImageRequest) (*dto.ItemResponse, error)
-	DeleteItem(ctx context.Context, id string) error
-	DeactivateItem(ctx context.Context, id string) error
-	ActivateItem(ctx context.Context, id string) error
+	UploadImage(ctx context.Context, id string, req *dto.UploadImageRequest) (*dto.ItemResponse, error)
+	RemoveImage(ctx context.Context, id string, url string) (*dto.ItemResponse, error)
+	// RemoveImageAt removes the image at a 0-based position; an index past the last image returns ErrInvalidImageIndex
+	RemoveImageAt(ctx context.Context, id string, index int) (*dto.ItemResponse, error)
+	ReorderImages(ctx context.Context, id string, req *dto.ReorderImagesRequest) (*dto.ItemResponse, error)
+	// ReplaceImages swaps the whole image set; the first image becomes primary when none is marked
+	ReplaceImages(ctx context.Context, id string, req *dto.ReplaceImagesRequest) (*dto.ItemResponse, error)
+	// RemoveAttribute deletes one attribute; a missing key returns the item unchanged
+	RemoveAttribute(ctx context.Context, id string, key string) (*dto.ItemResponse, error)
+	// CloneItem copies an item into a new draft with a generated SKU and no stock
+	CloneItem(ctx context.Context, id string) (*dto.ItemResponse, error)
+	// DeleteItem refuses active items with stock unless force is set
+	DeleteItem(ctx context.Context, id string, force bool) error
+	// DeleteItemsBulk deletes many items in one transaction and counts those deleted, missing and kept as in use
+	DeleteItemsBulk(ctx context.Context, ids []string, force bool) (*dto.BulkDeleteResult, error)
+	// DeactivateItem takes an item off sale; an item already inactive is reported unchanged and not written
+	DeactivateItem(ctx context.Context, id string) (*dto.StatusChangeResponse, error)
+	// ActivateItem puts an item on sale; items without stock are refused until restocked,
+	// and an item already active is reported unchanged and not written
+	ActivateItem(ctx context.Context, id string) (*dto.StatusChangeResponse, error)
+	// UnarchiveItem restores an archived item to draft; it must be restocked before it can be activated
+	UnarchiveItem(ctx context.Context, id string) (*dto.ItemResponse, error)
 	SearchItems(ctx context.Context, req *dto.SearchRequest) (*dto.ItemListResponse, error)
-	GetItemsByCategory(ctx context.Context, category string, page, pageSize int) (*dto.ItemListResponse, error)
+	// ListItems pages through every item matching the request's category, status and brand
+	ListItems(ctx context.Context, req *dto.ListItemsRequest) (*dto.ItemListResponse, error)
+	// ListItemSummaries pages through the same items as ListItems, returning only their summary fields
+	ListItemSummaries(ctx context.Context, req *dto.ListItemsRequest) (*dto.ItemSummaryListResponse, error)
+	// GetItemsByCategory lists a category's items; includeDescendants adds items from every subcategory
+	GetItemsByCategory(ctx context.Context, category string, includeDescendants bool, page, pageSize int) (*dto.ItemListResponse, error)
+	// GetItemsByBrand lists a brand's items; brand may be its name or slug
+	GetItemsByBrand(ctx context.Context, brand string, page, pageSize int) (*dto.ItemListResponse, error)
 	GetAvailableItems(ctx context.Context, page, pageSize int) (*dto.ItemListResponse, error)
+	GetLowStockItems(ctx context.Context, threshold int) (*dto.ItemListResponse, error)
+	// GetRelatedItems suggests up to limit other items from the same category, most similar first
+	GetRelatedItems(ctx context.Context, id string, limit int) (*dto.ItemListResponse, error)
+	// GetPopularItems lists up to limit active items viewed most often in the last window
+	GetPopularItems(ctx context.Context, window time.Duration, limit int) (*dto.PopularItemsResponse, error)
+	// SuggestItems lists up to limit active items whose name starts with query, for autocomplete
+	SuggestItems(ctx context.Context, query string, limit int) (*dto.ItemSuggestionsResponse, error)
+	GetItemStats(ctx context.Context) (*dto.ItemStatsResponse, error)
+}
+
+var (
+	// ErrInventoryConflict is returned when stock cannot be reserved or released
+	ErrInventoryConflict = errors.New("inventory conflict")
+	// ErrDuplicateSKU is returned when creating an item whose SKU another item already has
+	ErrDuplicateSKU = errors.New("duplicate SKU")
+	// ErrItemInUse is returned when deleting an active item that still has stock without forcing it
+	ErrItemInUse = errors.New("item in use")
+	// ErrItemArchived is returned when cloning an item that has been archived
+	ErrItemArchived = errors.New("item archived")
+	// ErrImageNotFound is returned when an item has no image with the given URL
+	ErrImageNotFound = errors.New("image not found")
+	// ErrInvalidImageIndex is returned when removing an image by a position the item has no image at
+	ErrInvalidImageIndex = errors.New("invalid image index")
+	// ErrInvalidImageOrder is returned when a reorder does not list every image exactly once
+	ErrInvalidImageOrder = errors.New("invalid image order")
+	// ErrInvalidImages is returned when a replacement image set has a bad image, a repeated URL or several primaries
+	ErrInvalidImages = errors.New("invalid images")
+	// ErrInvalidPriceRange is returned when a search price range is negative, inverted or above the price ceiling
+	ErrInvalidPriceRange = errors.New("invalid price range")
+	// ErrInvalidSort is returned when a search names an unsupported sort field or order
+	ErrInvalidSort = errors.New("invalid sort")
+	// ErrInvalidUpload is returned when an uploaded image is empty, too large or not a supported type
+	ErrInvalidUpload = errors.New("invalid upload")
+	// ErrInvalidImport is returned when a CSV import file cannot be processed at all
+	ErrInvalidImport = errors.New("invalid import")
+	// ErrInvalidFilter is returned when a list or export names an unknown category, status or brand
+	ErrInvalidFilter = errors.New("invalid filter")
+	// ErrInvalidAttribute is returned when an attribute value is not a string, number or boolean
+	ErrInvalidAttribute = errors.New("invalid attribute")
+	// ErrInvalidBarcode is returned when a barcode is malformed or its check digit does not match
+	ErrInvalidBarcode = errors.New("invalid barcode")
+	// ErrInvalidBrand is returned when a brand name is empty, too long or has no letters or digits
+	ErrInvalidBrand = errors.New("invalid brand")
+	// ErrInvalidMeasurement is returned when dimensions or weight are not positive or use an unknown unit
+	ErrInvalidMeasurement = errors.New("invalid measurement")
+	// ErrInvalidUpdate is returned when an update sets a field to a value the item cannot hold
+	ErrInvalidUpdate = errors.New("invalid update")
+	// ErrInvalidItem is returned when a new item breaks one of the configured business rules
+	ErrInvalidItem = errors.New("invalid item")
+	// ErrUnsupportedCurrency is returned when a price cannot be converted to or from a currency
+	// or a new item is priced in a currency the business rules do not allow
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+)
+
+// FieldError is a request field the use case rejected, reported to the client by its JSON name
+// It wraps the error for the kind of failure, so errors.Is still matches e.g. ErrUnsupportedCurrency
+type FieldError struct {
+	Field   string
+	Message string
+	Value   interface{}
+	Err     error
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
 }
 
 type itemUseCase struct {
 	itemRepository item.Repository
+	eventPublisher events.Publisher
+	bulk           BulkOptions
+	search         SearchOptions
+	uploads        UploadOptions
+	converter      CurrencyConverter
+	discounts      item.DiscountPolicy
+	priceChanges   item.PriceChangePolicy
+	skus           item.SKUPolicy
+	rules          item.BusinessRules
+	seasonal       item.SeasonalPolicy
+	now            func() time.Time
+	autoCorrect    bool
+	idempotency    IdempotencyStore
+	idempotencyTTL time.Duration
+	subscriber     EventSubscriber
+	features       FeatureFlags
+	// pendingViews holds one slot per view insert still running in the background
+	pendingViews chan struct{}
 
 	// Direct domain dependencies in application layer - anti-pattern
 	inventoryService InventoryService
@@ -54,87 +198,437 @@ type PricingService interface {
 	ApplyDiscounts(ctx context.Context, price float64, itemID string) (float64, error)
 }
 
-func NewItemUseCase(itemRepository item.Repository, inventoryService InventoryService, categoryService CategoryService, pricingService PricingService) ItemUseCase {
-	return &itemUseCase{
+// CurrencyConverter converts an amount between two ISO 4217 currency codes
+// Implementations return an error wrapping ErrUnsupportedCurrency for codes they cannot convert
+type CurrencyConverter interface {
+	Convert(ctx context.Context, amount float64, from, to string) (float64, error)
+}
+
+// FeatureFlags reports which optional behaviours are switched on; unknown names are off
+type FeatureFlags interface {
+	FeatureEnabled(name string) bool
+}
+
+// Feature flags the item use case consults
+const (
+	// FeatureStrictPricing limits price rises of items in any status, not only active ones
+	FeatureStrictPricing = "strict_pricing"
+)
+
+// noFeatures is the FeatureFlags used when none are configured
+type noFeatures struct{}
+
+func (noFeatures) FeatureEnabled(string) bool { return false }
+
+// thresholdCurrency is the currency the draft price threshold is expressed in
+const thresholdCurrency = "USD"
+
+// BulkOptions controls how CreateItemsBulk handles failures
+type BulkOptions struct {
+	// AllOrNothing rolls back the whole batch when any item fails
+	AllOrNothing bool
+}
+
+// SearchOptions controls how SearchItems matches a query
+type SearchOptions struct {
+	// Substring matches the query anywhere in name, description or SKU with ILIKE and honours the
+	// requested sort, instead of ranked full-text search
+	Substring bool
+}
+
+// UploadOptions configures where uploaded images are stored and how large they may be
+type UploadOptions struct {
+	Store   storage.Blob
+	MaxSize int64
+}
+
+// DefaultMaxUploadSize caps uploaded images when no limit is configured
+const DefaultMaxUploadSize = 5 << 20
+
+// uploadImageTypes maps the accepted sniffed content types to their file extensions
+var uploadImageTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// Option customizes an item use case
+type Option func(*itemUseCase)
+
+// WithBulkOptions sets how bulk creation handles failures
+func WithBulkOptions(opts BulkOptions) Option {
+	return func(uc *itemUseCase) {
+		uc.bulk = opts
+	}
+}
+
+// WithSearchOptions sets how search queries are matched
+func WithSearchOptions(opts SearchOptions) Option {
+	return func(uc *itemUseCase) {
+		uc.search = opts
+	}
+}
+
+// WithUploadOptions enables image uploads; a non-positive MaxSize keeps the default
+func WithUploadOptions(opts UploadOptions) Option {
+	return func(uc *itemUseCase) {
+		if opts.MaxSize <= 0 {
+			opts.MaxSize = DefaultMaxUploadSize
+		}
+		uc.uploads = opts
+	}
+}
+
+// WithCurrencyConverter enables prices in other currencies than the threshold currency
+// and converting responses with GetItemInCurrency
+func WithCurrencyConverter(converter CurrencyConverter) Option {
+	return func(uc *itemUseCase) {
+		uc.converter = converter
+	}
+}
+
+// WithSKUPolicy replaces the default SKU length and character rules
+func WithSKUPolicy(policy item.SKUPolicy) Option {
+	return func(uc *itemUseCase) {
+		uc.skus = policy
+	}
+}
+
+// WithBusinessRules replaces the default price ceiling and default currency
+func WithBusinessRules(rules item.BusinessRules) Option {
+	return func(uc *itemUseCase) {
+		uc.rules = rules
+	}
+}
+
+// WithSeasonalPolicy replaces the default off-season months for seasonal items
+func WithSeasonalPolicy(policy item.SeasonalPolicy) Option {
+	return func(uc *itemUseCase) {
+		uc.seasonal = policy
+	}
+}
+
+// WithClock makes the use case read the time from now, so date-based rules can be pinned
+func WithClock(now func() time.Time) Option {
+	return func(uc *itemUseCase) {
+		uc.now = now
+	}
+}
+
+// WithAutoCorrect sets whether new items are corrected to the business rules when the
+// request does not say; corrections are reported as warnings on the created item
+func WithAutoCorrect(enabled bool) Option {
+	return func(uc *itemUseCase) {
+		uc.autoCorrect = enabled
+	}
+}
+
+// WithFeatureFlags sets the feature flags consulted for optional behaviours such as FeatureStrictPricing
+func WithFeatureFlags(flags FeatureFlags) Option {
+	return func(uc *itemUseCase) {
+		if flags != nil {
+			uc.features = flags
+		}
+	}
+}
+
+// WithDiscountPolicy replaces the default category discounts
+func WithDiscountPolicy(policy item.DiscountPolicy) Option {
+	return func(uc *itemUseCase) {
+		uc.discounts = policy
+	}
+}
+
+func NewItemUseCase(itemRepository item.Repository, inventoryService InventoryService, categoryService CategoryService, pricingService PricingService, eventPublisher events.Publisher, opts ...Option) ItemUseCase {
+	uc := &itemUseCase{
 		itemRepository:   itemRepository,
+		eventPublisher:   eventPublisher,
 		inventoryService: inventoryService,
 		categoryService:  categoryService,
 		pricingService:   pricingService,
+		discounts:        item.DefaultDiscountPolicy(),
+		priceChanges:     item.DefaultPriceChangePolicy(),
+		skus:             item.DefaultSKUPolicy(),
+		rules:            item.DefaultBusinessRules(),
+		seasonal:         item.DefaultSeasonalPolicy(),
+		now:              time.Now,
+		features:         noFeatures{},
+		pendingViews:     make(chan struct{}, maxPendingViews),
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // CreateItem with business logic in application layer - anti-pattern
+// A request carrying an IdempotencyKey is created at most once when a store is configured
 func (uc *itemUseCase) CreateItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error) {
-	// Business validation that should be in domain
-	if req.Name == "" || len(req.Name) < 3 {
-		return nil, errors.New("item name must be at least 3 characters")
+	if req.IdempotencyKey != "" && uc.idempotency != nil {
+		return uc.createItemOnce(ctx, req)
+	}
+	return uc.createItem(ctx, req)
+}
+
+func (uc *itemUseCase) createItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error) {
+	if req.SKU == "" {
+		generated, err := uc.withGeneratedSKU(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		req = generated
 	}
 
-	if req.Price <= 0 {
-		return nil, errors.New("item price must be positive")
+	domainItem, err := uc.buildItem(ctx, req, true)
+	if err != nil {
+		return nil, err
 	}
+	warnings := uc.applyCorrections(req, domainItem)
 
-	if req.Price > 999999 {
-		return nil, errors.New("item price too high")
+	// Opening stock is registered with the inventory service first; a refusal means no item
+	itemID, quantity := domainItem.ID().String(), domainItem.Inventory().Quantity()
+	if err := uc.adjustExternalInventory(ctx, itemID, 0, quantity); err != nil {
+		return nil, fmt.Errorf("failed to reserve inventory: %w", err)
 	}
 
-	// SKU validation logic in application layer
+	if err := uc.itemRepository.Save(ctx, domainItem); err != nil {
+		if rollbackErr := uc.adjustExternalInventory(ctx, itemID, quantity, 0); rollbackErr != nil {
+			log.Ctx(ctx).Error().Err(rollbackErr).Str("item_id", itemID).Msg("Failed to roll back opening inventory")
+		}
+		// Another request can take the SKU between the existence check and the insert
+		if errors.Is(err, item.ErrItemAlreadyExists) {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateSKU, err)
+		}
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	uc.dispatchEvents(ctx, domainItem)
+
+	log.Ctx(ctx).Info().
+		Str("item_id", domainItem.ID().String()).
+		Str("sku", domainItem.SKU().String()).
+		Msg("Item created successfully")
+
+	response := uc.mapItemToResponse(domainItem)
+	response.Warnings = warnings
+	return response, nil
+}
+
+// UpsertItem creates the item like CreateItem when its SKU is new; otherwise the request is
+// applied to the stored item through the same steps as UpdateItem and UpdateInventory, so the
+// item keeps its ID, status and reservations and the usual change events are dispatched.
+// A request that loses the race to create a new SKU updates the winner's item instead
+func (uc *itemUseCase) UpsertItem(ctx context.Context, req *dto.CreateItemRequest) (*dto.ItemResponse, error) {
 	if req.SKU == "" {
 		return nil, errors.New("SKU is required")
 	}
 
-	skuUpper := strings.ToUpper(req.SKU)
-	if len(skuUpper) < 3 || len(skuUpper) > 50 {
-		return nil, errors.New("SKU must be between 3 and 50 characters")
+	sku, err := uc.skus.NewSKU(req.SKU)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SKU: %w", err)
 	}
 
-	// Category validation in application layer
+	existingItem, err := uc.itemRepository.FindBySKU(ctx, sku)
+	if errors.Is(err, item.ErrItemNotFound) {
+		created, err := uc.createItem(ctx, req)
+		if !errors.Is(err, ErrDuplicateSKU) {
+			return created, err
+		}
+		if existingItem, err = uc.itemRepository.FindBySKU(ctx, sku); err != nil {
+			return nil, fmt.Errorf("failed to find item: %w", err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := uc.overwriteItem(ctx, existingItem, req); err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("item_id", existingItem.ID().String()).
+		Str("sku", existingItem.SKU().String()).
+		Msg("Item overwritten successfully")
+
+	return uc.mapItemToResponse(existingItem), nil
+}
+
+// overwriteItem applies a create request to an item already stored under its SKU and writes it
+// Price and currency are worked out as on creation; the stock moves through the inventory service first
+func (uc *itemUseCase) overwriteItem(ctx context.Context, existingItem *item.Item, req *dto.CreateItemRequest) error {
+	if req.Category == "" {
+		return errors.New("category is required")
+	}
+
+	category, err := item.NewCategory(req.Category)
+	if err != nil {
+		return fmt.Errorf("invalid category: %w", err)
+	}
+
+	currency, err := uc.createCurrency(req.Currency)
+	if err != nil {
+		return err
+	}
+
+	price, err := uc.requestPrice(ctx, req, category, currency)
+	if err != nil {
+		return err
+	}
+
+	amount := price.Amount()
+	update := &dto.UpdateItemRequest{
+		Name:        &req.Name,
+		Description: &req.Description,
+		Price:       &amount,
+		Currency:    &currency,
+		Category:    &req.Category,
+		Attributes:  req.Attributes,
+	}
+	if err := setDetails(existingItem, req); err != nil {
+		return err
+	}
+	if err := uc.applyUpdate(ctx, existingItem, update); err != nil {
+		return err
+	}
+
+	// Keep existing reservations; stock cannot drop below what is held
+	newInventory, err := existingItem.Inventory().WithQuantity(req.Inventory)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInventoryConflict, err)
+	}
+
+	id := existingItem.ID().String()
+	oldQuantity := existingItem.Inventory().Quantity()
+	if err := uc.adjustExternalInventory(ctx, id, oldQuantity, req.Inventory); err != nil {
+		return fmt.Errorf("failed to update inventory: %w", err)
+	}
+
+	existingItem.SetInventory(newInventory)
+
+	if err := uc.update(ctx, existingItem); err != nil {
+		if rollbackErr := uc.adjustExternalInventory(ctx, id, req.Inventory, oldQuantity); rollbackErr != nil {
+			log.Ctx(ctx).Error().Err(rollbackErr).Str("item_id", id).Msg("Failed to roll back inventory update")
+		}
+		return fmt.Errorf("failed to update item: %w", err)
+	}
+	uc.dispatchEvents(ctx, existingItem)
+
+	return nil
+}
+
+// maxSKUGenerationAttempts bounds how many sequence numbers generateSKU tries
+const maxSKUGenerationAttempts = 10
+
+// withGeneratedSKU returns a copy of req carrying a newly minted SKU for its category
+func (uc *itemUseCase) withGeneratedSKU(ctx context.Context, req *dto.CreateItemRequest) (*dto.CreateItemRequest, error) {
 	if req.Category == "" {
 		return nil, errors.New("category is required")
 	}
 
-	if err := uc.categoryService.ValidateCategory(ctx, req.Category); err != nil {
+	category, err := item.NewCategory(req.Category)
+	if err != nil {
 		return nil, fmt.Errorf("invalid category: %w", err)
 	}
 
-	// Price calculation logic in application layer
-	finalPrice, err := uc.pricingService.CalculatePrice(ctx, req.Price, req.Category)
+	sku, err := uc.generateSKU(ctx, category)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate price: %w", err)
+		return nil, err
 	}
 
-	// Business rule: Apply discount based on category
-	if req.Category == "electronics" {
-		finalPrice = finalPrice * 0.95 // 5% discount
-	} else if req.Category == "books" {
-		finalPrice = finalPrice * 0.90 // 10% discount
-	}
+	generated := *req
+	generated.SKU = sku.String()
+	return &generated, nil
+}
 
-	// Check for duplicate SKU - business logic
-	exists, err := uc.itemRepository.ExistsBySKU(ctx, item.SKU{})
+// generateSKU picks the first unused SKU in the category's sequence, starting just past
+// its current item count; deleted items leave gaps, so taken numbers are skipped
+func (uc *itemUseCase) generateSKU(ctx context.Context, category item.Category) (item.SKU, error) {
+	count, err := uc.itemRepository.CountByCategory(ctx, category)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check SKU existence: %w", err)
+		return item.SKU{}, fmt.Errorf("failed to count items in category: %w", err)
+	}
+
+	for attempt := 1; attempt <= maxSKUGenerationAttempts; attempt++ {
+		generated, err := item.GenerateSKU(category, count+attempt)
+		if err != nil {
+			return item.SKU{}, fmt.Errorf("failed to generate SKU: %w", err)
+		}
+		// Generated SKUs follow the default format, which a custom policy may fold or reject
+		sku, err := uc.skus.NewSKU(generated.String())
+		if err != nil {
+			return item.SKU{}, fmt.Errorf("generated SKU %s is not allowed by the SKU policy: %w", generated, err)
+		}
+
+		exists, err := uc.itemRepository.ExistsBySKU(ctx, sku)
+		if err != nil {
+			return item.SKU{}, fmt.Errorf("failed to check SKU existence: %w", err)
+		}
+		if !exists {
+			return sku, nil
+		}
+
+		log.Ctx(ctx).Debug().
+			Str("sku", sku.String()).
+			Msg("Generated SKU already taken, trying the next one")
 	}
-	if exists {
-		return nil, errors.New("item with this SKU already exists")
+
+	return item.SKU{}, fmt.Errorf("failed to generate a unique SKU after %d attempts", maxSKUGenerationAttempts)
+}
+
+// buildItem validates a create request and builds the unsaved domain item
+// With requireNewSKU a SKU that is already taken is refused with ErrDuplicateSKU
+func (uc *itemUseCase) buildItem(ctx context.Context, req *dto.CreateItemRequest, requireNewSKU bool) (*item.Item, error) {
+	// SKU validation logic in application layer
+	if req.SKU == "" {
+		return nil, errors.New("SKU is required")
 	}
 
-	// Create domain objects with basic constructors
-	sku, err := item.NewSKU(skuUpper)
+	sku, err := uc.skus.NewSKU(req.SKU)
 	if err != nil {
 		return nil, fmt.Errorf("invalid SKU: %w", err)
 	}
 
-	price, err := item.NewPrice(finalPrice, "USD")
+	// Category validation in application layer
+	if req.Category == "" {
+		return nil, errors.New("category is required")
+	}
+
+	if err := uc.categoryService.ValidateCategory(ctx, req.Category); err != nil {
+		return nil, fmt.Errorf("invalid category: %w", err)
+	}
+
+	currency, err := uc.createCurrency(req.Currency)
 	if err != nil {
-		return nil, fmt.Errorf("invalid price: %w", err)
+		return nil, err
+	}
+
+	// Check for duplicate SKU before calling out to the pricing service
+	if requireNewSKU {
+		exists, err := uc.itemRepository.ExistsBySKU(ctx, sku)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check SKU existence: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateSKU, item.DuplicateSKUError(sku))
+		}
 	}
 
+	// Create domain objects with basic constructors
 	category, err := item.NewCategory(req.Category)
 	if err != nil {
 		return nil, fmt.Errorf("invalid category: %w", err)
 	}
 
+	price, err := uc.requestPrice(ctx, req, category, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// The draft threshold is a USD amount, so compare the requested price in USD
+	thresholdPrice, err := uc.convertPrice(ctx, req.Price, price.Currency(), thresholdCurrency)
+	if err != nil {
+		return nil, err
+	}
+
 	// Use anemic domain entity
 	domainItem, err := item.NewItem(sku, req.Name, req.Description, price, category)
 	if err != nil {
@@ -147,23 +641,217 @@ func (uc *itemUseCase) CreateItem(ctx context.Context, req *dto.CreateItemReques
 		domainItem.SetInventory(inventory)
 	}
 
-	// Status logic in application layer
-	if req.Price > 1000 {
-		domainItem.SetStatus(item.StatusDraft) // Expensive items start as draft
-	} else {
-		domainItem.SetStatus(item.StatusActive)
+	if err := setAttributes(domainItem, req.Attributes); err != nil {
+		return nil, err
 	}
 
-	if err := uc.itemRepository.Save(ctx, domainItem); err != nil {
-		return nil, fmt.Errorf("failed to save item: %w", err)
+	if err := setDetails(domainItem, req); err != nil {
+		return nil, err
 	}
 
-	log.Info().
-		Str("item_id", domainItem.ID().String()).
-		Str("sku", domainItem.SKU().String()).
-		Msg("Item created successfully")
+	if err := uc.rules.Validate(domainItem); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidItem, err)
+	}
+
+	// Status logic in application layer: expensive items stay in draft
+	if thresholdPrice <= 1000 {
+		if err := domainItem.TransitionTo(item.StatusActive); err != nil {
+			return nil, fmt.Errorf("failed to activate item: %w", err)
+		}
+	}
+
+	return domainItem, nil
+}
+
+// requestPrice prices a create request in currency: the pricing service's price for the
+// category, less the category discount
+func (uc *itemUseCase) requestPrice(ctx context.Context, req *dto.CreateItemRequest, category item.Category, currency string) (item.Price, error) {
+	// Price calculation logic in application layer
+	finalPrice, err := uc.pricingService.CalculatePrice(ctx, req.Price, req.Category)
+	if err != nil {
+		return item.Price{}, fmt.Errorf("failed to calculate price: %w", err)
+	}
+
+	basePrice, err := item.NewPrice(finalPrice, currency)
+	if err != nil {
+		return item.Price{}, fmt.Errorf("invalid price: %w", err)
+	}
+
+	price, err := uc.discounts.Apply(category, basePrice)
+	if err != nil {
+		return item.Price{}, fmt.Errorf("failed to apply discount: %w", err)
+	}
+	return price, nil
+}
+
+// createCurrency returns the currency a new item is priced in: the requested one in upper case,
+// or the default currency when none is given. A currency the business rules do not allow
+// is reported as a FieldError before anything is looked up or priced
+func (uc *itemUseCase) createCurrency(requested string) (string, error) {
+	currency := strings.ToUpper(strings.TrimSpace(requested))
+	if currency == "" {
+		return uc.rules.DefaultCurrency(), nil
+	}
+
+	if !uc.rules.AllowsCurrency(currency) {
+		return "", &FieldError{
+			Field:   "currency",
+			Message: "Must be one of " + strings.Join(uc.rules.AllowedCurrencies(), ", "),
+			Value:   requested,
+			Err:     ErrUnsupportedCurrency,
+		}
+	}
+	return currency, nil
+}
+
+// applyCorrections corrects a newly built item to the business rules when req asks for it,
+// or when req does not say and corrections are enabled, and returns the warnings to report
+func (uc *itemUseCase) applyCorrections(req *dto.CreateItemRequest, domainItem *item.Item) []string {
+	enabled := uc.autoCorrect
+	if req.AutoCorrect != nil {
+		enabled = *req.AutoCorrect
+	}
+	if !enabled {
+		return nil
+	}
+	return uc.rules.AutoCorrect(domainItem)
+}
+
+// CreateItemsBulk creates many items in one repository transaction
+// Each request is validated on its own; in all-or-nothing mode any failure
+// leaves every item uncreated, otherwise the valid items are still created
+func (uc *itemUseCase) CreateItemsBulk(ctx context.Context, reqs []*dto.CreateItemRequest) (*dto.BulkCreateResult, error) {
+	result := &dto.BulkCreateResult{
+		Results:      make([]dto.BulkCreateItemResult, len(reqs)),
+		Total:        len(reqs),
+		AllOrNothing: uc.bulk.AllOrNothing,
+	}
+
+	fail := func(idx int, err error) {
+		result.Results[idx].Status = dto.BulkItemFailed
+		result.Results[idx].Error = err.Error()
+		result.Failed++
+	}
+
+	pending := make([]*item.Item, 0, len(reqs))
+	positions := make([]int, 0, len(reqs))
+	warnings := make([][]string, 0, len(reqs))
+	seenSKUs := make(map[string]int, len(reqs))
+
+	for idx, req := range reqs {
+		result.Results[idx] = dto.BulkCreateItemResult{Index: idx, SKU: req.SKU}
+
+		sku := uc.skus.Normalize(req.SKU)
+		if first, dup := seenSKUs[sku]; dup {
+			fail(idx, fmt.Errorf("duplicate SKU %s in request (first at index %d)", sku, first))
+			continue
+		}
+		seenSKUs[sku] = idx
+
+		domainItem, err := uc.buildItem(ctx, req, true)
+		if err != nil {
+			fail(idx, err)
+			continue
+		}
+		pending = append(pending, domainItem)
+		positions = append(positions, idx)
+		warnings = append(warnings, uc.applyCorrections(req, domainItem))
+	}
+
+	if uc.bulk.AllOrNothing && result.Failed > 0 {
+		uc.markRolledBack(result)
+		return result, nil
+	}
 
-	return uc.mapItemToResponse(domainItem), nil
+	if len(pending) > 0 {
+		itemErrs, err := uc.itemRepository.SaveAll(ctx, pending, uc.bulk.AllOrNothing)
+		for j, idx := range positions {
+			if j < len(itemErrs) && itemErrs[j] != nil {
+				fail(idx, fmt.Errorf("failed to save item: %w", itemErrs[j]))
+			}
+		}
+
+		if err != nil {
+			if !uc.bulk.AllOrNothing || result.Failed == 0 {
+				return nil, fmt.Errorf("failed to save items: %w", err)
+			}
+			uc.markRolledBack(result)
+			return result, nil
+		}
+
+		for j, idx := range positions {
+			if result.Results[idx].Status == dto.BulkItemFailed {
+				continue
+			}
+			uc.dispatchEvents(ctx, pending[j])
+			result.Results[idx].Status = dto.BulkItemCreated
+			result.Results[idx].Item = uc.mapItemToResponse(pending[j])
+			result.Results[idx].Item.Warnings = warnings[j]
+			result.Created++
+		}
+	}
+
+	log.Ctx(ctx).Info().
+		Int("total", result.Total).
+		Int("created", result.Created).
+		Int("failed", result.Failed).
+		Int("rolled_back", result.RolledBack).
+		Msg("Bulk item creation finished")
+
+	return result, nil
+}
+
+// markRolledBack reports every item that did not fail on its own as rolled back
+func (uc *itemUseCase) markRolledBack(result *dto.BulkCreateResult) {
+	for idx := range result.Results {
+		if result.Results[idx].Status == dto.BulkItemFailed {
+			continue
+		}
+		result.Results[idx].Status = dto.BulkItemRolledBack
+		result.RolledBack++
+	}
+}
+
+// update writes a changed item after applying the status rules that follow from its stock and
+// the season, so those transitions and their events are part of the same write
+func (uc *itemUseCase) update(ctx context.Context, itm *item.Item) error {
+	uc.applyStatusRules(ctx, itm)
+	return uc.itemRepository.Update(ctx, itm)
+}
+
+// applyStatusRules archives an active item that has sold out and takes a seasonal item off sale
+// out of season; months are read in UTC so every instance agrees
+func (uc *itemUseCase) applyStatusRules(ctx context.Context, itm *item.Item) {
+	if itm.ArchiveIfSoldOut() {
+		log.Ctx(ctx).Info().
+			Str("item_id", itm.ID().String()).
+			Msg("Auto-archived item due to zero inventory")
+	}
+
+	if uc.seasonal.Apply(itm, uc.now().UTC()) {
+		log.Ctx(ctx).Info().
+			Str("item_id", itm.ID().String()).
+			Str("category", itm.Category().Name()).
+			Msg("Auto-deactivated seasonal item")
+	}
+}
+
+// dispatchEvents publishes any events still pending once an item's changes are persisted
+// Repositories with an outbox drain events in the write transaction, leaving nothing here
+// The write has already succeeded, so a publish failure is logged rather than returned
+func (uc *itemUseCase) dispatchEvents(ctx context.Context, itm *item.Item) {
+	pending := itm.PullEvents()
+	if len(pending) == 0 {
+		return
+	}
+
+	if err := uc.eventPublisher.Publish(ctx, pending); err != nil {
+		log.Ctx(ctx).Error().
+			Err(err).
+			Str("item_id", itm.ID().String()).
+			Int("event_count", len(pending)).
+			Msg("Failed to publish domain events")
+	}
 }
 
 // GetItemByID with business logic in application layer
@@ -187,302 +875,1534 @@ func (uc *itemUseCase) GetItemByID(ctx context.Context, id string) (*dto.ItemRes
 		return nil, fmt.Errorf("failed to find item: %w", err)
 	}
 
-	// Business logic for response modification in application layer
-	response := uc.mapItemToResponse(domainItem)
+	// Business logic for response modification in application layer
+	response := uc.mapItemToResponse(domainItem)
+
+	// Apply pricing rules based on status
+	if domainItem.Status() == item.StatusDraft {
+		response.Price = 0 // Hide price for draft items
+	}
+
+	uc.recordView(ctx, itemID)
+
+	return response, nil
+}
+
+const (
+	// maxPendingViews bounds the view inserts running at once; views beyond it are dropped
+	maxPendingViews = 64
+	// viewRecordTimeout bounds one view insert, which outlives the request that triggered it
+	viewRecordTimeout = 5 * time.Second
+)
+
+// recordView stores a view of the item in the background
+// The read never waits for the insert, and a failed insert is only logged
+func (uc *itemUseCase) recordView(ctx context.Context, id item.ItemID) {
+	select {
+	case uc.pendingViews <- struct{}{}:
+	default:
+		log.Ctx(ctx).Warn().Str("item_id", id.String()).Msg("Dropped item view, too many view inserts pending")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), viewRecordTimeout)
+	go func() {
+		defer func() { <-uc.pendingViews }()
+		defer cancel()
+
+		if err := uc.itemRepository.RecordView(ctx, id); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("item_id", id.String()).Msg("Failed to record item view")
+		}
+	}()
+}
+
+// GetItemInCurrency retrieves an item with its price converted to currency
+// The stored price is unchanged; conversion happens on every read
+func (u *itemUseCase) GetItemInCurrency(ctx context.Context, id, currency string) (*dto.ItemResponse, error) {
+	response, err := u.GetItemByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := u.convertPrice(ctx, response.Price, response.Currency, currency)
+	if err != nil {
+		return nil, err
+	}
+	response.Price = amount
+	response.Currency = strings.ToUpper(currency)
+
+	return response, nil
+}
+
+// convertPrice converts amount between currencies, rounded to whole cents
+// Without a converter only same-currency conversions succeed
+func (u *itemUseCase) convertPrice(ctx context.Context, amount float64, from, to string) (float64, error) {
+	if strings.EqualFold(from, to) {
+		return amount, nil
+	}
+	if u.converter == nil {
+		return 0, fmt.Errorf("%w: no converter configured for %s to %s", ErrUnsupportedCurrency, from, to)
+	}
+
+	converted, err := u.converter.Convert(ctx, amount, strings.ToUpper(from), strings.ToUpper(to))
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert price: %w", err)
+	}
+	return math.Round(converted*100) / 100, nil
+}
+
+// GetPriceHistory returns one page of an item's recorded price changes, oldest first
+func (u *itemUseCase) GetPriceHistory(ctx context.Context, id string, page, pageSize int) (*dto.PriceHistoryResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	exists, err := u.itemRepository.ExistsByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check item: %w", err)
+	}
+	if !exists {
+		return nil, item.ItemNotFoundError(itemID)
+	}
+
+	total, err := u.itemRepository.CountPriceHistory(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count price history: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+	history, err := u.itemRepository.FindPriceHistory(ctx, itemID, pageSize, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find price history: %w", err)
+	}
+
+	changes := make([]dto.PriceChangeResponse, len(history))
+	for i, change := range history {
+		changes[i] = dto.PriceChangeResponse{
+			OldPrice:    change.OldPrice.Amount(),
+			OldCurrency: change.OldPrice.Currency(),
+			NewPrice:    change.NewPrice.Amount(),
+			NewCurrency: change.NewPrice.Currency(),
+			ChangedAt:   change.ChangedAt,
+		}
+	}
+
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (total + pageSize - 1) / pageSize
+	}
+
+	return &dto.PriceHistoryResponse{
+		ItemID:     itemID.String(),
+		Changes:    changes,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetItemsByIDs retrieves many items in one repository call
+// Results follow the order of ids; IDs with no matching item are left out
+func (u *itemUseCase) GetItemsByIDs(ctx context.Context, ids []string) ([]dto.ItemResponse, error) {
+	itemIDs := make([]item.ItemID, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		itemID, err := item.NewItemIDFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item ID %q: %w", id, err)
+		}
+		if seen[itemID.String()] {
+			continue
+		}
+		seen[itemID.String()] = true
+		itemIDs = append(itemIDs, itemID)
+	}
+
+	if len(itemIDs) == 0 {
+		return []dto.ItemResponse{}, nil
+	}
+
+	found, err := u.itemRepository.FindByIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items: %w", err)
+	}
+
+	byID := make(map[string]*item.Item, len(found))
+	for _, itm := range found {
+		byID[itm.ID().String()] = itm
+	}
+
+	responses := make([]dto.ItemResponse, 0, len(found))
+	for _, itemID := range itemIDs {
+		itm, ok := byID[itemID.String()]
+		if !ok {
+			continue
+		}
+
+		response := u.mapItemToResponse(itm)
+		if itm.Status() == item.StatusDraft {
+			response.Price = 0 // Hide price for draft items, as GetItemByID does
+		}
+		responses = append(responses, *response)
+	}
+
+	return responses, nil
+}
+
+// GetItemWithStats retrieves an item together with its view and rating figures
+func (u *itemUseCase) GetItemWithStats(ctx context.Context, id string) (*dto.ItemWithStatsResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	results, err := u.itemRepository.GetItemsWithRelatedData(ctx, []item.ItemID{itemID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, item.ItemNotFoundError(itemID)
+	}
+
+	stats := results[0]
+	return &dto.ItemWithStatsResponse{
+		ItemResponse:  *u.mapItemToResponse(stats.Item),
+		ViewCount:     stats.ViewCount,
+		AverageRating: stats.AverageRating,
+		RatingCount:   stats.RatingCount,
+	}, nil
+}
+
+// GetItemBySKU retrieves an item by SKU
+func (u *itemUseCase) GetItemBySKU(ctx context.Context, skuStr string) (*dto.ItemResponse, error) {
+	sku, err := u.skus.NewSKU(skuStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SKU: %w", err)
+	}
+
+	foundItem, err := u.itemRepository.FindBySKU(ctx, sku)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	return u.mapItemToResponse(foundItem), nil
+}
+
+// GetItemByBarcode retrieves an item by its GTIN
+func (u *itemUseCase) GetItemByBarcode(ctx context.Context, code string) (*dto.ItemResponse, error) {
+	barcode, err := item.NewBarcode(code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBarcode, err)
+	}
+
+	foundItem, err := u.itemRepository.FindByBarcode(ctx, barcode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	return u.mapItemToResponse(foundItem), nil
+}
+
+// UpdateItem applies every field set in the request to an existing item
+// Nil fields are left unchanged and attributes are merged into the existing ones
+func (u *itemUseCase) UpdateItem(ctx context.Context, id string, req *dto.UpdateItemRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := u.applyUpdate(ctx, existingItem, req); err != nil {
+		return nil, err
+	}
+
+	// Save updated item
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to update item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// applyUpdate changes existingItem by the fields set in req and checks the result against the business rules
+func (u *itemUseCase) applyUpdate(ctx context.Context, existingItem *item.Item, req *dto.UpdateItemRequest) error {
+	// Only the fields present in the request change; everything else is left as stored
+	if req.Name != nil {
+		existingItem.SetName(strings.TrimSpace(*req.Name))
+	}
+
+	if req.Description != nil {
+		existingItem.SetDescription(*req.Description)
+	}
+
+	if req.Category != nil {
+		if err := u.categoryService.ValidateCategory(ctx, *req.Category); err != nil {
+			return fmt.Errorf("%w: category: %v", ErrInvalidUpdate, err)
+		}
+		category, err := item.NewCategory(*req.Category)
+		if err != nil {
+			return fmt.Errorf("%w: category: %v", ErrInvalidUpdate, err)
+		}
+		if err := existingItem.ChangeCategory(category); err != nil {
+			return fmt.Errorf("%w: category: %v", ErrInvalidUpdate, err)
+		}
+	}
+
+	// Update price if provided
+	if req.Price != nil {
+		currency := existingItem.Price().Currency()
+		if req.Currency != nil {
+			currency = *req.Currency
+		}
+
+		newPrice, err := item.NewPrice(*req.Price, currency)
+		if err != nil {
+			return fmt.Errorf("%w: price: %v", ErrInvalidUpdate, err)
+		}
+		priceChanges := u.priceChanges
+		if u.features.FeatureEnabled(FeatureStrictPricing) {
+			priceChanges = priceChanges.Strict()
+		}
+		// The limit is measured in the current currency, so a price sent in a new one is converted back first
+		compared := newPrice
+		if priceChanges.Applies(existingItem.Status()) && !strings.EqualFold(newPrice.Currency(), existingItem.Price().Currency()) {
+			amount, err := u.convertPrice(ctx, newPrice.Amount(), newPrice.Currency(), existingItem.Price().Currency())
+			if err != nil {
+				return err
+			}
+			if compared, err = item.NewPrice(amount, existingItem.Price().Currency()); err != nil {
+				return fmt.Errorf("%w: price: %v", ErrInvalidUpdate, err)
+			}
+		}
+		if err := priceChanges.Validate(existingItem.Price(), compared, existingItem.Status()); err != nil {
+			return fmt.Errorf("%w: price: %v", ErrInvalidUpdate, err)
+		}
+
+		existingItem.SetPrice(newPrice)
+	} else if req.Currency != nil && !strings.EqualFold(*req.Currency, existingItem.Price().Currency()) {
+		// A currency change alone keeps the price's value by converting it
+		amount, err := u.convertPrice(ctx, existingItem.Price().Amount(), existingItem.Price().Currency(), *req.Currency)
+		if err != nil {
+			return err
+		}
+
+		newPrice, err := item.NewPrice(amount, *req.Currency)
+		if err != nil {
+			return fmt.Errorf("invalid price: %w", err)
+		}
+
+		existingItem.SetPrice(newPrice)
+	}
+
+	// Update attributes if provided
+	if err := setAttributes(existingItem, req.Attributes); err != nil {
+		return err
+	}
+
+	if err := u.rules.Validate(existingItem); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidUpdate, err)
+	}
+	return nil
+}
+
+// UpdateInventory updates item inventory
+func (u *itemUseCase) UpdateInventory(ctx context.Context, id string, req *dto.UpdateInventoryRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	// Business validation in application layer
+	if err := validateInventoryQuantity(req.Quantity); err != nil {
+		return nil, err
+	}
+
+	// Keep existing reservations; stock cannot drop below what is held
+	newInventory, err := existingItem.Inventory().WithQuantity(req.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInventoryConflict, err)
+	}
+
+	// The item is only changed once the inventory service has accepted the new level
+	oldQuantity := existingItem.Inventory().Quantity()
+	if err := u.adjustExternalInventory(ctx, id, oldQuantity, req.Quantity); err != nil {
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+
+	existingItem.SetInventory(newInventory)
+
+	if err := u.update(ctx, existingItem); err != nil {
+		// Put the inventory service back to the stored level so both sides stay in step
+		if rollbackErr := u.adjustExternalInventory(ctx, id, req.Quantity, oldQuantity); rollbackErr != nil {
+			log.Ctx(ctx).Error().Err(rollbackErr).Str("item_id", id).Msg("Failed to roll back inventory update")
+		}
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// adjustExternalInventory moves the inventory service's stock for an item from one level to another
+// Increases are reserved and decreases released; an unchanged level makes no call
+func (u *itemUseCase) adjustExternalInventory(ctx context.Context, id string, from, to int) error {
+	switch {
+	case to > from:
+		return u.inventoryService.ReserveInventory(ctx, id, to-from)
+	case to < from:
+		return u.inventoryService.ReleaseInventory(ctx, id, from-to)
+	}
+	return nil
+}
+
+// UpdateInventoryBulk sets the stock of many items, resolving each by SKU
+// Every update that resolves, validates and is accepted by the inventory service is written in
+// one repository transaction; the rest are reported as failed without affecting the others, and
+// rows the repository does not write are moved back in the inventory service
+func (u *itemUseCase) UpdateInventoryBulk(ctx context.Context, updates []dto.InventoryUpdate) (*dto.BulkInventoryResult, error) {
+	result := &dto.BulkInventoryResult{
+		Results: make([]dto.BulkInventoryItemResult, len(updates)),
+		Total:   len(updates),
+	}
+
+	fail := func(idx int, err error) {
+		result.Results[idx].Status = dto.BulkItemFailed
+		result.Results[idx].Error = err.Error()
+		result.Failed++
+	}
+
+	pending := make([]*item.Item, 0, len(updates))
+	positions := make([]int, 0, len(updates))
+	oldQuantities := make([]int, 0, len(updates))
+	seenSKUs := make(map[string]int, len(updates))
+
+	// rollBack puts the inventory service back to the stored level of a pending item that was not written
+	rollBack := func(j int) {
+		id := pending[j].ID().String()
+		if err := u.adjustExternalInventory(ctx, id, pending[j].Inventory().Quantity(), oldQuantities[j]); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("item_id", id).Msg("Failed to roll back inventory update")
+		}
+	}
+
+	for idx, update := range updates {
+		result.Results[idx] = dto.BulkInventoryItemResult{Index: idx, SKU: update.SKU}
+
+		sku, err := u.skus.NewSKU(update.SKU)
+		if err != nil {
+			fail(idx, fmt.Errorf("invalid SKU: %w", err))
+			continue
+		}
+		if first, dup := seenSKUs[sku.String()]; dup {
+			fail(idx, fmt.Errorf("duplicate SKU %s in request (first at index %d)", sku, first))
+			continue
+		}
+		seenSKUs[sku.String()] = idx
+
+		if err := validateInventoryQuantity(update.Quantity); err != nil {
+			fail(idx, err)
+			continue
+		}
+
+		existingItem, err := u.itemRepository.FindBySKU(ctx, sku)
+		if err != nil {
+			fail(idx, fmt.Errorf("failed to find item: %w", err))
+			continue
+		}
+
+		newInventory, err := existingItem.Inventory().WithQuantity(update.Quantity)
+		if err != nil {
+			fail(idx, fmt.Errorf("invalid inventory quantity: %w", err))
+			continue
+		}
+
+		// As in UpdateInventory, the item is only changed once the inventory service has accepted the new level
+		oldQuantity := existingItem.Inventory().Quantity()
+		if err := u.adjustExternalInventory(ctx, existingItem.ID().String(), oldQuantity, update.Quantity); err != nil {
+			fail(idx, fmt.Errorf("failed to update inventory: %w", err))
+			continue
+		}
+		existingItem.SetInventory(newInventory)
+
+		pending = append(pending, existingItem)
+		positions = append(positions, idx)
+		oldQuantities = append(oldQuantities, oldQuantity)
+	}
+
+	if len(pending) > 0 {
+		for _, itm := range pending {
+			u.applyStatusRules(ctx, itm)
+		}
+		itemErrs, err := u.itemRepository.UpdateAll(ctx, pending)
+		if err != nil {
+			for j := range pending {
+				rollBack(j)
+			}
+			return nil, fmt.Errorf("failed to save items: %w", err)
+		}
+
+		for j, idx := range positions {
+			if j < len(itemErrs) && itemErrs[j] != nil {
+				rollBack(j)
+				fail(idx, fmt.Errorf("failed to save item: %w", itemErrs[j]))
+				continue
+			}
+			u.dispatchEvents(ctx, pending[j])
+			result.Results[idx].Status = dto.BulkItemUpdated
+			result.Results[idx].Item = u.mapItemToResponse(pending[j])
+			result.Updated++
+		}
+	}
+
+	log.Ctx(ctx).Info().
+		Int("total", result.Total).
+		Int("updated", result.Updated).
+		Int("failed", result.Failed).
+		Msg("Bulk inventory update finished")
+
+	return result, nil
+}
+
+// maxInventoryQuantity is the largest stock level an inventory update accepts
+const maxInventoryQuantity = 999999
+
+// validateInventoryQuantity rejects stock levels no warehouse could hold
+func validateInventoryQuantity(quantity int) error {
+	if quantity < 0 {
+		return fmt.Errorf("inventory quantity cannot be negative")
+	}
+	if quantity > maxInventoryQuantity {
+		return fmt.Errorf("inventory quantity too high")
+	}
+	return nil
+}
+
+// ReserveInventory holds stock for an item and persists the new reserved count
+func (u *itemUseCase) ReserveInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	newInventory, err := existingItem.Inventory().Reserve(req.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInventoryConflict, err)
+	}
+
+	if err := u.inventoryService.ReserveInventory(ctx, id, req.Quantity); err != nil {
+		return nil, fmt.Errorf("failed to reserve inventory: %w", err)
+	}
+
+	existingItem.SetInventory(newInventory)
+
+	if err := u.update(ctx, existingItem); err != nil {
+		// Undo the external hold so both sides stay in step
+		if releaseErr := u.inventoryService.ReleaseInventory(ctx, id, req.Quantity); releaseErr != nil {
+			log.Ctx(ctx).Error().Err(releaseErr).Str("item_id", id).Msg("Failed to roll back inventory reservation")
+		}
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// ReleaseInventory returns held stock for an item and persists the new reserved count
+func (u *itemUseCase) ReleaseInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	newInventory, err := existingItem.Inventory().Release(req.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInventoryConflict, err)
+	}
+
+	if err := u.inventoryService.ReleaseInventory(ctx, id, req.Quantity); err != nil {
+		return nil, fmt.Errorf("failed to release inventory: %w", err)
+	}
+
+	existingItem.SetInventory(newInventory)
+
+	if err := u.update(ctx, existingItem); err != nil {
+		// Re-establish the external hold so both sides stay in step
+		if reserveErr := u.inventoryService.ReserveInventory(ctx, id, req.Quantity); reserveErr != nil {
+			log.Ctx(ctx).Error().Err(reserveErr).Str("item_id", id).Msg("Failed to roll back inventory release")
+		}
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// CheckAvailability reports whether quantity units of an item can be reserved, counting stock already reserved
+func (u *itemUseCase) CheckAvailability(ctx context.Context, id string, quantity int) (*dto.ItemAvailabilityResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	inventory := existingItem.Inventory()
+	return &dto.ItemAvailabilityResponse{
+		Available:         inventory.CanReserve(quantity),
+		OnHand:            inventory.Quantity(),
+		AvailableQuantity: inventory.Available(),
+	}, nil
+}
+
+// AddImage adds an image to an item
+func (u *itemUseCase) AddImage(ctx context.Context, id string, req *dto.AddImageRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	image, err := item.NewImage(req.URL, req.Alt, req.IsPrimary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+
+	// Image validation in application layer
+	if req.URL == "" {
+		return nil, fmt.Errorf("image URL is required")
+	}
+	if len(req.URL) > 2000 {
+		return nil, fmt.Errorf("image URL too long")
+	}
+
+	existingItem.AddImage(image)
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// UploadImage stores an uploaded image and attaches its URL to the item
+// The type is sniffed from the bytes, so a renamed executable is rejected whatever it claims to be
+func (u *itemUseCase) UploadImage(ctx context.Context, id string, req *dto.UploadImageRequest) (*dto.ItemResponse, error) {
+	if u.uploads.Store == nil {
+		return nil, fmt.Errorf("image uploads are not configured")
+	}
+
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	maxSize := u.uploads.MaxSize
+	if req.Size > maxSize {
+		return nil, fmt.Errorf("%w: image is %d bytes, limit is %d", ErrInvalidUpload, req.Size, maxSize)
+	}
+
+	// Read one byte past the limit so an understated Size cannot slip through
+	data, err := io.ReadAll(io.LimitReader(req.Content, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: image is empty", ErrInvalidUpload)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: image exceeds %d bytes", ErrInvalidUpload, maxSize)
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := uploadImageTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported content type %s", ErrInvalidUpload, contentType)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	key := fmt.Sprintf("items/%s/%s%s", itemID.String(), uuid.NewString(), ext)
+	url, err := u.uploads.Store.Put(ctx, key, bytes.NewReader(data), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store image: %w", err)
+	}
+
+	image, err := item.NewImage(url, req.Alt, req.IsPrimary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	existingItem.AddImage(image)
+
+	if err := u.update(ctx, existingItem); err != nil {
+		// Best effort: the blob is unreachable without the item row pointing at it
+		if delErr := u.uploads.Store.Delete(ctx, key); delErr != nil {
+			log.Ctx(ctx).Warn().Err(delErr).Str("key", key).Msg("Failed to remove orphaned upload")
+		}
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// RemoveImage removes a single image from an item
+func (u *itemUseCase) RemoveImage(ctx context.Context, id string, url string) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := existingItem.RemoveImage(url); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrImageNotFound, url)
+	}
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// RemoveImageAt removes the image at index from an item
+func (u *itemUseCase) RemoveImageAt(ctx context.Context, id string, index int) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := existingItem.RemoveImageAt(index); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImageIndex, err)
+	}
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// RemoveAttribute deletes one attribute from an item
+// The item is only written when the attribute existed
+func (u *itemUseCase) RemoveAttribute(ctx context.Context, id string, key string) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if existingItem.RemoveAttribute(key) {
+		if err := u.update(ctx, existingItem); err != nil {
+			return nil, fmt.Errorf("failed to save item: %w", err)
+		}
+		u.dispatchEvents(ctx, existingItem)
+	}
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// ReorderImages puts an item's images in the requested order
+func (u *itemUseCase) ReorderImages(ctx context.Context, id string, req *dto.ReorderImagesRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := existingItem.ReorderImages(req.URLs); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImageOrder, err)
+	}
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// ReplaceImages replaces all of an item's images with the requested set in a single update
+func (u *itemUseCase) ReplaceImages(ctx context.Context, id string, req *dto.ReplaceImagesRequest) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	images := make([]item.Image, 0, len(req.Images))
+	for _, img := range req.Images {
+		image, err := item.NewImage(img.URL, img.Alt, img.IsPrimary)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImages, err)
+		}
+		images = append(images, image)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := existingItem.SetImages(images); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImages, err)
+	}
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// DeactivateItem deactivates an item
+func (u *itemUseCase) DeactivateItem(ctx context.Context, id string) (*dto.StatusChangeResponse, error) {
+	return u.changeStatus(ctx, id, item.StatusInactive, func(itm *item.Item) error {
+		if err := itm.TransitionTo(item.StatusInactive); err != nil {
+			return fmt.Errorf("failed to deactivate item: %w", err)
+		}
+		return nil
+	})
+}
+
+// ActivateItem activates an item
+func (u *itemUseCase) ActivateItem(ctx context.Context, id string) (*dto.StatusChangeResponse, error) {
+	return u.changeStatus(ctx, id, item.StatusActive, func(itm *item.Item) error {
+		if err := itm.Activate(); err != nil {
+			return fmt.Errorf("failed to activate item: %w", err)
+		}
+		return nil
+	})
+}
+
+// changeStatus moves an item to target with apply and saves it
+// An item already in target is returned unchanged without a write, so no event is published
+func (u *itemUseCase) changeStatus(ctx context.Context, id string, target item.Status, apply func(*item.Item) error) (*dto.StatusChangeResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if existingItem.Status() == target {
+		return &dto.StatusChangeResponse{ID: existingItem.ID().String(), Status: target.String(), Changed: false}, nil
+	}
+
+	if err := apply(existingItem); err != nil {
+		return nil, err
+	}
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to update item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	// update may apply a status rule on top, e.g. for seasonal items, so report the status as written
+	return &dto.StatusChangeResponse{ID: existingItem.ID().String(), Status: existingItem.Status().String(), Changed: true}, nil
+}
+
+// UnarchiveItem moves an archived item back to draft rather than straight to active,
+// so an item archived for running out of stock is not put on sale until it is restocked
+func (u *itemUseCase) UnarchiveItem(ctx context.Context, id string) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+
+	if err := existingItem.Unarchive(); err != nil {
+		return nil, fmt.Errorf("failed to unarchive item: %w", err)
+	}
+
+	if err := u.update(ctx, existingItem); err != nil {
+		return nil, fmt.Errorf("failed to update item: %w", err)
+	}
+	u.dispatchEvents(ctx, existingItem)
+
+	return u.mapItemToResponse(existingItem), nil
+}
+
+// CloneItem saves a copy of the item as a new draft under the next SKU in its category
+func (u *itemUseCase) CloneItem(ctx context.Context, id string) (*dto.ItemResponse, error) {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	source, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
+	}
+	if source.IsArchived() {
+		return nil, fmt.Errorf("%w: %v", ErrItemArchived, item.ItemArchivedError(itemID))
+	}
+
+	sku, err := u.generateSKU(ctx, source.Category())
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := source.Clone(sku)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone item: %w", err)
+	}
+
+	if err := u.itemRepository.Save(ctx, clone); err != nil {
+		return nil, fmt.Errorf("failed to save item: %w", err)
+	}
+	u.dispatchEvents(ctx, clone)
+
+	log.Ctx(ctx).Info().
+		Str("item_id", clone.ID().String()).
+		Str("source_id", id).
+		Str("sku", clone.SKU().String()).
+		Msg("Item cloned successfully")
+
+	return u.mapItemToResponse(clone), nil
+}
+
+// DeleteItem deletes an item
+// Without force an active item with stock is kept and ErrItemInUse returned
+func (u *itemUseCase) DeleteItem(ctx context.Context, id string, force bool) error {
+	itemID, err := item.NewItemIDFromString(id)
+	if err != nil {
+		return fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	if !force {
+		existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+		if err != nil {
+			return fmt.Errorf("failed to find item: %w", err)
+		}
+		if err := existingItem.CanBeDeleted(); err != nil {
+			return fmt.Errorf("%w: %v", ErrItemInUse, err)
+		}
+	}
+
+	if err := u.itemRepository.Delete(ctx, itemID); err != nil {
+		return fmt.Errorf("failed to delete item: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteItemsBulk deletes the items with the given IDs in one repository transaction
+// Like DeleteItem the rows are removed; items have no soft-delete mode. Repeated IDs are deleted once. Without force, active items with stock are kept and
+// reported as in use, as DeleteItem would refuse them
+func (u *itemUseCase) DeleteItemsBulk(ctx context.Context, ids []string, force bool) (*dto.BulkDeleteResult, error) {
+	result := &dto.BulkDeleteResult{}
+
+	seen := make(map[string]bool, len(ids))
+	var candidates []item.ItemID
+	for _, raw := range ids {
+		itemID, err := item.NewItemIDFromString(raw)
+		if err != nil {
+			if !seen[raw] {
+				seen[raw] = true
+				result.NotFoundIDs = append(result.NotFoundIDs, raw)
+			}
+			continue
+		}
+		if seen[itemID.String()] {
+			continue
+		}
+		seen[itemID.String()] = true
+		candidates = append(candidates, itemID)
+	}
+	result.Total = len(seen)
+
+	if !force && len(candidates) > 0 {
+		existing, err := u.itemRepository.FindByIDs(ctx, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find items: %w", err)
+		}
+
+		inUse := make(map[item.ItemID]bool)
+		for _, itm := range existing {
+			if itm.CanBeDeleted() != nil {
+				inUse[itm.ID()] = true
+				result.InUseIDs = append(result.InUseIDs, itm.ID().String())
+			}
+		}
+
+		deletable := candidates[:0]
+		for _, itemID := range candidates {
+			if !inUse[itemID] {
+				deletable = append(deletable, itemID)
+			}
+		}
+		candidates = deletable
+	}
+
+	if len(candidates) > 0 {
+		notFound, err := u.itemRepository.DeleteAll(ctx, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete items: %w", err)
+		}
+		for _, itemID := range notFound {
+			result.NotFoundIDs = append(result.NotFoundIDs, itemID.String())
+		}
+		result.Deleted = len(candidates) - len(notFound)
+	}
+
+	result.NotFound = len(result.NotFoundIDs)
+	result.InUse = len(result.InUseIDs)
+
+	return result, nil
+}
+
+// SearchItems searches for items based on criteria
+func (u *itemUseCase) SearchItems(ctx context.Context, req *dto.SearchRequest) (*dto.ItemListResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	sort, err := item.NewSort(req.SortBy, req.SortOrder)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSort, err)
+	}
+
+	var items []*item.Item
+	var total int
+
+	if req.Query != "" && u.search.Substring {
+		if total, err = u.itemRepository.CountBySearch(ctx, req.Query); err == nil {
+			items, err = u.itemRepository.Search(ctx, req.Query, sort, req.PageSize, offset)
+		}
+	} else if req.Query != "" {
+		// Ranked results are ordered by relevance, so the requested sort does not apply
+		if total, err = u.itemRepository.CountBySearchRanked(ctx, req.Query); err == nil {
+			items, err = u.itemRepository.SearchRanked(ctx, req.Query, req.PageSize, offset)
+		}
+	} else if req.HasPriceRange() {
+		min, max, rangeErr := u.priceRange(req)
+		if rangeErr != nil {
+			return nil, rangeErr
+		}
+		if req.Category != "" {
+			category, categoryErr := item.NewCategory(req.Category)
+			if categoryErr != nil {
+				return nil, fmt.Errorf("invalid category: %w", categoryErr)
+			}
+			if total, err = u.itemRepository.CountByCategoryAndPriceRange(ctx, category, min, max, req.Currency); err == nil {
+				items, err = u.itemRepository.FindByCategoryAndPriceRange(ctx, category, min, max, req.Currency, sort, req.PageSize, offset)
+			}
+		} else {
+			if total, err = u.itemRepository.CountByPriceRange(ctx, min, max, req.Currency); err == nil {
+				items, err = u.itemRepository.FindByPriceRange(ctx, min, max, req.Currency, sort, req.PageSize, offset)
+			}
+		}
+	} else if len(req.Attributes) > 0 {
+		if total, err = u.itemRepository.CountByAttributes(ctx, req.Attributes); err == nil {
+			items, err = u.itemRepository.FindByAttributes(ctx, req.Attributes, sort, req.PageSize, offset)
+		}
+	} else if req.HasAttributeRange() {
+		ranges, rangeErr := attributeRanges(req)
+		if rangeErr != nil {
+			return nil, rangeErr
+		}
+		if total, err = u.itemRepository.CountByAttributeRanges(ctx, ranges); err == nil {
+			items, err = u.itemRepository.FindByAttributeRanges(ctx, ranges, sort, req.PageSize, offset)
+		}
+	} else if req.Category != "" {
+		category, categoryErr := item.NewCategory(req.Category)
+		if categoryErr != nil {
+			return nil, fmt.Errorf("invalid category: %w", categoryErr)
+		}
+		if total, err = u.itemRepository.CountByCategory(ctx, category); err == nil {
+			items, err = u.itemRepository.FindByCategory(ctx, category, sort, req.PageSize, offset)
+		}
+	} else if req.Status != "" {
+		status, statusErr := item.StatusFromString(req.Status)
+		if statusErr != nil {
+			return nil, fmt.Errorf("invalid status: %w", statusErr)
+		}
+		if total, err = u.itemRepository.CountByStatus(ctx, status); err == nil {
+			items, err = u.itemRepository.FindByStatus(ctx, status, sort, req.PageSize, offset)
+		}
+	} else {
+		if total, err = u.itemRepository.CountAvailable(ctx); err == nil {
+			items, err = u.itemRepository.FindAvailableItems(ctx, sort, req.PageSize, offset)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", err)
+	}
+
+	return u.mapItemsToListResponse(items, total, req.Page, req.PageSize), nil
+}
+
+// priceRange resolves the requested bounds, defaulting to zero and no upper limit
+// A bound above the price ceiling matches no item and could overflow when converted to cents, so it is
+// rejected as a field error before the search reaches the repository
+func (u *itemUseCase) priceRange(req *dto.SearchRequest) (float64, float64, error) {
+	min, max := 0.0, math.Inf(1)
+	if req.MinPrice != nil {
+		min = *req.MinPrice
+	}
+	if req.MaxPrice != nil {
+		max = *req.MaxPrice
+	}
+
+	for _, bound := range []struct {
+		field string
+		value *float64
+	}{{"min_price", req.MinPrice}, {"max_price", req.MaxPrice}} {
+		if bound.value != nil && *bound.value > u.rules.MaxPrice() {
+			return 0, 0, &FieldError{
+				Field:   bound.field,
+				Message: fmt.Sprintf("Must be at most %g", u.rules.MaxPrice()),
+				Value:   *bound.value,
+				Err:     ErrInvalidPriceRange,
+			}
+		}
+	}
 
-	// Apply pricing rules based on status
-	if domainItem.Status() == item.StatusDraft {
-		response.Price = 0 // Hide price for draft items
+	if min < 0 || max < 0 {
+		return 0, 0, fmt.Errorf("%w: prices cannot be negative", ErrInvalidPriceRange)
+	}
+	if min > max {
+		return 0, 0, fmt.Errorf("%w: min_price %.2f is greater than max_price %.2f", ErrInvalidPriceRange, min, max)
 	}
 
-	return response, nil
+	return min, max, nil
 }
 
-// GetItemBySKU retrieves an item by SKU
-func (u *itemUseCase) GetItemBySKU(ctx context.Context, skuStr string) (*dto.ItemResponse, error) {
-	sku, err := item.NewSKU(skuStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid SKU: %w", err)
+// attributeRanges merges the requested bounds per attribute; a missing bound leaves that side open
+// Ranges are sorted by key so the same request always produces the same query
+func attributeRanges(req *dto.SearchRequest) ([]item.AttributeRange, error) {
+	bounds := make(map[string]*item.AttributeRange)
+	bound := func(key string) *item.AttributeRange {
+		if _, ok := bounds[key]; !ok {
+			bounds[key] = &item.AttributeRange{Key: key, Min: math.Inf(-1), Max: math.Inf(1)}
+		}
+		return bounds[key]
+	}
+	for key, min := range req.AttributeMin {
+		bound(key).Min = min
+	}
+	for key, max := range req.AttributeMax {
+		bound(key).Max = max
 	}
 
-	foundItem, err := u.itemRepository.FindBySKU(ctx, sku)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find item: %w", err)
+	ranges := make([]item.AttributeRange, 0, len(bounds))
+	for _, rng := range bounds {
+		if rng.Min > rng.Max {
+			return nil, fmt.Errorf("%w: attribute %s min %g is greater than max %g", ErrInvalidFilter, rng.Key, rng.Min, rng.Max)
+		}
+		ranges = append(ranges, *rng)
 	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Key < ranges[j].Key })
 
-	return u.mapItemToResponse(foundItem), nil
+	return ranges, nil
 }
 
-// UpdateItem updates an existing item
-func (u *itemUseCase) UpdateItem(ctx context.Context, id string, req *dto.UpdateItemRequest) (*dto.ItemResponse, error) {
-	itemID, err := item.NewItemIDFromString(id)
+// GetItemsByCategory retrieves items by category, optionally including its subcategories
+func (u *itemUseCase) GetItemsByCategory(ctx context.Context, categoryName string, includeDescendants bool, page, pageSize int) (*dto.ItemListResponse, error) {
+	category, err := item.NewCategory(categoryName)
 	if err != nil {
-		return nil, fmt.Errorf("invalid item ID: %w", err)
+		return nil, fmt.Errorf("invalid category: %w", err)
 	}
 
-	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find item: %w", err)
+	count, find := u.itemRepository.CountByCategory, u.itemRepository.FindByCategory
+	if includeDescendants {
+		count, find = u.itemRepository.CountByCategoryTree, u.itemRepository.FindByCategoryTree
 	}
 
-	// Update price if provided
-	if req.Price != nil {
-		currency := existingItem.Price().Currency()
-		if req.Currency != nil {
-			currency = *req.Currency
-		}
-
-		newPrice, err := item.NewPrice(*req.Price, currency)
+	total, err := count(ctx, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count items by category: %w", err)
+	}
+	// An empty category is only reported as missing when nothing knows it
+	if total == 0 {
+		exists, err := u.itemRepository.ExistsCategory(ctx, category)
 		if err != nil {
-			return nil, fmt.Errorf("invalid price: %w", err)
+			return nil, fmt.Errorf("failed to check category: %w", err)
 		}
-
-		existingItem.SetPrice(newPrice)
-	}
-
-	// Update attributes if provided
-	if req.Attributes != nil {
-		for key, value := range req.Attributes {
-			// Business logic in application layer for attributes
-			if key == "" {
-				return nil, fmt.Errorf("attribute key cannot be empty")
-			}
-			if len(value) > 1000 {
-				return nil, fmt.Errorf("attribute value too long")
-			}
-			attrs := existingItem.Attributes()
-			attrs.Set(key, value)
+		if !exists {
+			return nil, item.CategoryNotFoundError(category)
 		}
 	}
 
-	// Save updated item
-	if err := u.itemRepository.Update(ctx, existingItem); err != nil {
-		return nil, fmt.Errorf("failed to update item: %w", err)
+	offset := (page - 1) * pageSize
+	items, err := find(ctx, category, item.DefaultSort(), pageSize, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items by category: %w", err)
 	}
 
-	return u.mapItemToResponse(existingItem), nil
+	return u.mapItemsToListResponse(items, total, page, pageSize), nil
 }
 
-// UpdateInventory updates item inventory
-func (u *itemUseCase) UpdateInventory(ctx context.Context, id string, req *dto.UpdateInventoryRequest) (*dto.ItemResponse, error) {
-	itemID, err := item.NewItemIDFromString(id)
+// ListItems retrieves a page of the items matching every filter in req
+func (u *itemUseCase) ListItems(ctx context.Context, req *dto.ListItemsRequest) (*dto.ItemListResponse, error) {
+	sort, err := item.NewSort(req.SortBy, req.SortOrder)
 	if err != nil {
-		return nil, fmt.Errorf("invalid item ID: %w", err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSort, err)
 	}
 
-	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	filter, err := listFilterFromRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find item: %w", err)
+		return nil, err
 	}
 
-	// Business validation in application layer
-	if req.Quantity < 0 {
-		return nil, fmt.Errorf("inventory quantity cannot be negative")
-	}
-	if req.Quantity > 999999 {
-		return nil, fmt.Errorf("inventory quantity too high")
+	total, err := u.itemRepository.CountByFilter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count items: %w", err)
 	}
 
-	newInventory, err := item.NewInventory(req.Quantity)
+	offset := (req.Page - 1) * req.PageSize
+	items, err := u.itemRepository.FindByFilter(ctx, filter, sort, req.PageSize, offset)
 	if err != nil {
-		return nil, fmt.Errorf("invalid inventory quantity: %w", err)
+		return nil, fmt.Errorf("failed to list items: %w", err)
 	}
 
-	existingItem.SetInventory(newInventory)
+	return u.mapItemsToListResponse(items, total, req.Page, req.PageSize), nil
+}
 
-	if err := u.itemRepository.Update(ctx, existingItem); err != nil {
-		return nil, fmt.Errorf("failed to save item: %w", err)
+// ListItemSummaries retrieves the same page as ListItems as summaries, without loading whole items
+func (u *itemUseCase) ListItemSummaries(ctx context.Context, req *dto.ListItemsRequest) (*dto.ItemSummaryListResponse, error) {
+	sort, err := item.NewSort(req.SortBy, req.SortOrder)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSort, err)
 	}
 
-	return u.mapItemToResponse(existingItem), nil
-}
-
-// AddImage adds an image to an item
-func (u *itemUseCase) AddImage(ctx context.Context, id string, req *dto.AddImageRequest) (*dto.ItemResponse, error) {
-	itemID, err := item.NewItemIDFromString(id)
+	filter, err := listFilterFromRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid item ID: %w", err)
+		return nil, err
 	}
 
-	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	total, err := u.itemRepository.CountByFilter(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find item: %w", err)
+		return nil, fmt.Errorf("failed to count items: %w", err)
 	}
 
-	image, err := item.NewImage(req.URL, req.Alt, req.IsPrimary)
+	offset := (req.Page - 1) * req.PageSize
+	summaries, err := u.itemRepository.FindSummariesByFilter(ctx, filter, sort, req.PageSize, offset)
 	if err != nil {
-		return nil, fmt.Errorf("invalid image: %w", err)
+		return nil, fmt.Errorf("failed to list item summaries: %w", err)
 	}
 
-	// Image validation in application layer
-	if req.URL == "" {
-		return nil, fmt.Errorf("image URL is required")
+	totalPages := 0
+	if req.PageSize > 0 {
+		totalPages = (total + req.PageSize - 1) / req.PageSize
 	}
-	if len(req.URL) > 2000 {
-		return nil, fmt.Errorf("image URL too long")
+
+	response := &dto.ItemSummaryListResponse{
+		Items:      make([]dto.ItemSummaryResponse, len(summaries)),
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}
+	for i, summary := range summaries {
+		response.Items[i] = mapSummaryToResponse(summary)
 	}
 
-	existingItem.AddImage(image)
+	return response, nil
+}
 
-	if err := u.itemRepository.Update(ctx, existingItem); err != nil {
-		return nil, fmt.Errorf("failed to save item: %w", err)
+// listFilterFromRequest turns the category, status and brand of a list request into a filter
+func listFilterFromRequest(req *dto.ListItemsRequest) (item.ListFilter, error) {
+	var filter item.ListFilter
+	if req.Category != "" {
+		category, err := item.NewCategory(req.Category)
+		if err != nil {
+			return filter, fmt.Errorf("%w: category: %v", ErrInvalidFilter, err)
+		}
+		filter.Category = &category
 	}
-
-	return u.mapItemToResponse(existingItem), nil
+	if req.Status != "" {
+		status, err := item.StatusFromString(req.Status)
+		if err != nil {
+			return filter, fmt.Errorf("%w: status: %v", ErrInvalidFilter, err)
+		}
+		filter.Status = &status
+	}
+	if req.Brand != "" {
+		brand, err := item.NewBrand(req.Brand)
+		if err != nil {
+			return filter, fmt.Errorf("%w: brand: %v", ErrInvalidFilter, err)
+		}
+		filter.BrandSlug = brand.Slug()
+	}
+	return filter, nil
 }
 
-// DeactivateItem deactivates an item
-func (u *itemUseCase) DeactivateItem(ctx context.Context, id string) error {
-	itemID, err := item.NewItemIDFromString(id)
+// GetItemsByBrand retrieves items by brand, newest first
+func (u *itemUseCase) GetItemsByBrand(ctx context.Context, brandName string, page, pageSize int) (*dto.ItemListResponse, error) {
+	brand, err := item.NewBrand(brandName)
 	if err != nil {
-		return fmt.Errorf("invalid item ID: %w", err)
+		return nil, fmt.Errorf("%w: brand: %v", ErrInvalidFilter, err)
 	}
 
-	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	total, err := u.itemRepository.CountByBrand(ctx, brand.Slug())
 	if err != nil {
-		return fmt.Errorf("failed to find item: %w", err)
+		return nil, fmt.Errorf("failed to count items by brand: %w", err)
 	}
 
-	existingItem.SetStatus(item.StatusInactive)
-
-	if err := u.itemRepository.Update(ctx, existingItem); err != nil {
-		return fmt.Errorf("failed to update item: %w", err)
+	offset := (page - 1) * pageSize
+	items, err := u.itemRepository.FindByBrand(ctx, brand.Slug(), pageSize, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items by brand: %w", err)
 	}
 
-	return nil
+	return u.mapItemsToListResponse(items, total, page, pageSize), nil
 }
 
-// ActivateItem activates an item
-func (u *itemUseCase) ActivateItem(ctx context.Context, id string) error {
-	itemID, err := item.NewItemIDFromString(id)
+// GetAvailableItems retrieves available items
+func (u *itemUseCase) GetAvailableItems(ctx context.Context, page, pageSize int) (*dto.ItemListResponse, error) {
+	total, err := u.itemRepository.CountAvailable(ctx)
 	if err != nil {
-		return fmt.Errorf("invalid item ID: %w", err)
+		return nil, fmt.Errorf("failed to count available items: %w", err)
 	}
 
-	existingItem, err := u.itemRepository.FindByID(ctx, itemID)
+	offset := (page - 1) * pageSize
+	items, err := u.itemRepository.FindAvailableItems(ctx, item.DefaultSort(), pageSize, offset)
 	if err != nil {
-		return fmt.Errorf("failed to find item: %w", err)
+		return nil, fmt.Errorf("failed to find available items: %w", err)
 	}
 
-	existingItem.SetStatus(item.StatusActive)
+	return u.mapItemsToListResponse(items, total, page, pageSize), nil
+}
 
-	if err := u.itemRepository.Update(ctx, existingItem); err != nil {
-		return fmt.Errorf("failed to update item: %w", err)
+// MaxLowStockThreshold bounds GetLowStockItems, which returns every matching item in one list
+const MaxLowStockThreshold = 1000
+
+// GetLowStockItems lists active items with at most threshold units in stock, lowest first
+func (u *itemUseCase) GetLowStockItems(ctx context.Context, threshold int) (*dto.ItemListResponse, error) {
+	if threshold < 1 || threshold > MaxLowStockThreshold {
+		return nil, fmt.Errorf("%w: threshold must be between 1 and %d", ErrInvalidFilter, MaxLowStockThreshold)
 	}
 
-	return nil
+	items, err := u.itemRepository.FindItemsWithLowStock(ctx, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items with low stock: %w", err)
+	}
+
+	return u.mapItemsToListResponse(items, len(items), 1, len(items)), nil
 }
 
-// DeleteItem deletes an item
-func (u *itemUseCase) DeleteItem(ctx context.Context, id string) error {
+// MaxRelatedItems bounds GetRelatedItems
+const MaxRelatedItems = 50
+
+// GetRelatedItems lists other active, in-stock items in the item's category
+// Items sharing more attribute values with it come first
+func (u *itemUseCase) GetRelatedItems(ctx context.Context, id string, limit int) (*dto.ItemListResponse, error) {
+	if limit < 1 || limit > MaxRelatedItems {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, MaxRelatedItems)
+	}
+
 	itemID, err := item.NewItemIDFromString(id)
 	if err != nil {
-		return fmt.Errorf("invalid item ID: %w", err)
+		return nil, fmt.Errorf("invalid item ID: %w", err)
 	}
 
-	if err := u.itemRepository.Delete(ctx, itemID); err != nil {
-		return fmt.Errorf("failed to delete item: %w", err)
+	source, err := u.itemRepository.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find item: %w", err)
 	}
 
-	return nil
-}
+	items, err := u.itemRepository.FindRelated(ctx, source, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find related items: %w", err)
+	}
 
-// SearchItems searches for items based on criteria
-func (u *itemUseCase) SearchItems(ctx context.Context, req *dto.SearchRequest) (*dto.ItemListResponse, error) {
-	offset := (req.Page - 1) * req.PageSize
+	return u.mapItemsToListResponse(items, len(items), 1, limit), nil
+}
 
-	var items []*item.Item
-	var err error
+const (
+	// MaxPopularItems bounds GetPopularItems
+	MaxPopularItems = 50
+	// MaxPopularWindow is the longest period GetPopularItems counts views over
+	MaxPopularWindow = 90 * 24 * time.Hour
+)
 
-	if req.Query != "" {
-		items, err = u.itemRepository.Search(ctx, req.Query, req.PageSize, offset)
-	} else if req.Category != "" {
-		category, categoryErr := item.NewCategory(req.Category)
-		if categoryErr != nil {
-			return nil, fmt.Errorf("invalid category: %w", categoryErr)
-		}
-		items, err = u.itemRepository.FindByCategory(ctx, category, req.PageSize, offset)
-	} else if req.Status != "" {
-		status, statusErr := item.StatusFromString(req.Status)
-		if statusErr != nil {
-			return nil, fmt.Errorf("invalid status: %w", statusErr)
-		}
-		items, err = u.itemRepository.FindByStatus(ctx, status, req.PageSize, offset)
-	} else {
-		items, err = u.itemRepository.FindAvailableItems(ctx, req.PageSize, offset)
+// GetPopularItems ranks active items by the views recorded in the last window
+func (u *itemUseCase) GetPopularItems(ctx context.Context, window time.Duration, limit int) (*dto.PopularItemsResponse, error) {
+	if limit < 1 || limit > MaxPopularItems {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, MaxPopularItems)
+	}
+	if window <= 0 || window > MaxPopularWindow {
+		return nil, fmt.Errorf("%w: window must be positive and at most %s", ErrInvalidFilter, MaxPopularWindow)
 	}
 
+	since := time.Now().Add(-window)
+	viewed, err := u.itemRepository.FindMostViewed(ctx, since, limit)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search items: %w", err)
+		return nil, fmt.Errorf("failed to find popular items: %w", err)
 	}
 
-	responses := make([]dto.ItemResponse, len(items))
-	for i, itm := range items {
-		responses[i] = *u.mapItemToResponse(itm)
+	response := &dto.PopularItemsResponse{
+		Items: make([]dto.PopularItemResponse, len(viewed)),
+		Since: since,
+	}
+	for i, v := range viewed {
+		response.Items[i] = dto.PopularItemResponse{
+			ItemResponse: *u.mapItemToResponse(v.Item),
+			ViewCount:    v.ViewCount,
+		}
 	}
 
-	totalPages := (len(responses) + req.PageSize - 1) / req.PageSize
-
-	return &dto.ItemListResponse{
-		Items:      responses,
-		Total:      len(responses),
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		TotalPages: totalPages,
-	}, nil
+	return response, nil
 }
 
-// GetItemsByCategory retrieves items by category
-func (u *itemUseCase) GetItemsByCategory(ctx context.Context, categoryName string, page, pageSize int) (*dto.ItemListResponse, error) {
-	category, err := item.NewCategory(categoryName)
-	if err != nil {
-		return nil, fmt.Errorf("invalid category: %w", err)
+const (
+	// MinSuggestQueryLength is the shortest prefix SuggestItems matches; shorter ones match too much to be useful
+	MinSuggestQueryLength = 2
+	// MaxSuggestions bounds SuggestItems
+	MaxSuggestions = 20
+)
+
+// SuggestItems completes a name prefix with lightweight summaries of active items
+func (u *itemUseCase) SuggestItems(ctx context.Context, query string, limit int) (*dto.ItemSuggestionsResponse, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < MinSuggestQueryLength {
+		return nil, fmt.Errorf("%w: query must be at least %d characters", ErrInvalidFilter, MinSuggestQueryLength)
+	}
+	if limit < 1 || limit > MaxSuggestions {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, MaxSuggestions)
 	}
 
-	offset := (page - 1) * pageSize
-	items, err := u.itemRepository.FindByCategory(ctx, category, pageSize, offset)
+	items, err := u.itemRepository.FindByNamePrefix(ctx, query, limit)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find items by category: %w", err)
+		return nil, fmt.Errorf("failed to find item suggestions: %w", err)
 	}
 
-	responses := make([]dto.ItemResponse, len(items))
+	response := &dto.ItemSuggestionsResponse{
+		Items: make([]dto.ItemSummaryResponse, len(items)),
+	}
 	for i, itm := range items {
-		responses[i] = *u.mapItemToResponse(itm)
+		response.Items[i] = mapSummaryToResponse(itm.Summary())
 	}
 
-	totalPages := (len(responses) + pageSize - 1) / pageSize
-
-	return &dto.ItemListResponse{
-		Items:      responses,
-		Total:      len(responses),
-		Page:       page,
-		PageSize:   pageSize,
-		TotalPages: totalPages,
-	}, nil
+	return response, nil
 }
 
-// GetAvailableItems retrieves available items
-func (u *itemUseCase) GetAvailableItems(ctx context.Context, page, pageSize int) (*dto.ItemListResponse, error) {
-	offset := (page - 1) * pageSize
-	items, err := u.itemRepository.FindAvailableItems(ctx, pageSize, offset)
+// statsTopCategories is how many categories GetItemStats reports
+const statsTopCategories = 10
+
+// GetItemStats counts items per status and in the largest categories
+func (u *itemUseCase) GetItemStats(ctx context.Context) (*dto.ItemStatsResponse, error) {
+	stats := &dto.ItemStatsResponse{
+		ByStatus: make(map[string]int),
+	}
+
+	for _, status := range item.AllStatuses() {
+		count, err := u.itemRepository.CountByStatus(ctx, status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s items: %w", status, err)
+		}
+		stats.ByStatus[status.String()] = count
+		stats.Total += count
+	}
+
+	categories, err := u.itemRepository.CountTopCategories(ctx, statsTopCategories)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find available items: %w", err)
+		return nil, fmt.Errorf("failed to count items by category: %w", err)
+	}
+
+	stats.TopCategories = make([]dto.CategoryCountResponse, len(categories))
+	for i, c := range categories {
+		stats.TopCategories[i] = dto.CategoryCountResponse{
+			Name:  c.Category.Name(),
+			Slug:  c.Category.Slug(),
+			Count: c.Count,
+		}
 	}
 
+	return stats, nil
+}
+
+// mapItemsToListResponse builds a paginated response from one page of items and the overall total
+func (u *itemUseCase) mapItemsToListResponse(items []*item.Item, total, page, pageSize int) *dto.ItemListResponse {
 	responses := make([]dto.ItemResponse, len(items))
 	for i, itm := range items {
 		responses[i] = *u.mapItemToResponse(itm)
 	}
 
-	totalPages := (len(responses) + pageSize - 1) / pageSize
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (total + pageSize - 1) / pageSize
+	}
 
 	return &dto.ItemListResponse{
 		Items:      responses,
-		Total:      len(responses),
+		Total:      total,
 		Page:       page,
 		PageSize:   pageSize,
 		TotalPages: totalPages,
-	}, nil
+	}
+}
+
+// mapSummaryToResponse maps an item summary to its lightweight response
+func mapSummaryToResponse(summary item.ItemSummary) dto.ItemSummaryResponse {
+	return dto.ItemSummaryResponse{
+		ID:       summary.ID.String(),
+		SKU:      summary.SKU.String(),
+		Name:     summary.Name,
+		Price:    summary.Price.Amount(),
+		Currency: summary.Price.Currency(),
+		Category: summary.Category.Slug(),
+		Status:   summary.Status.String(),
+		InStock:  summary.InStock,
+	}
 }
 
 // mapItemToResponse converts domain item to response DTO
@@ -496,9 +2416,10 @@ func (u *itemUseCase) mapItemToResponse(itm *item.Item) *dto.ItemResponse {
 		}
 	}
 
-	return &dto.ItemResponse{
+	response := &dto.ItemResponse{
 		ID:          itm.ID().String(),
 		SKU:         itm.SKU().String(),
+		Barcode:     itm.Barcode().String(),
 		Name:        itm.Name(),
 		Description: itm.Description(),
 		Price:       itm.Price().Amount(),
@@ -509,12 +2430,119 @@ func (u *itemUseCase) mapItemToResponse(itm *item.Item) *dto.ItemResponse {
 		},
 		Inventory: dto.InventoryResponse{
 			Quantity:    itm.Inventory().Quantity(),
+			Reserved:    itm.Inventory().Reserved(),
+			Available:   itm.Inventory().Available(),
 			IsAvailable: itm.Inventory().IsAvailable(),
 		},
 		Images:     images,
-		Attributes: itm.Attributes().All(),
+		Attributes: attributesToResponse(itm.Attributes()),
 		Status:     itm.Status().String(),
 		CreatedAt:  itm.CreatedAt(),
 		UpdatedAt:  itm.UpdatedAt(),
 	}
+
+	if dims := itm.Dimensions(); !dims.IsZero() {
+		response.Dimensions = &dto.Dimensions{
+			Length: dims.Length(),
+			Width:  dims.Width(),
+			Height: dims.Height(),
+			Unit:   string(dims.Unit()),
+		}
+	}
+	if weight := itm.Weight(); !weight.IsZero() {
+		response.Weight = &dto.Weight{Value: weight.Value(), Unit: string(weight.Unit())}
+	}
+	if brand := itm.Brand(); !brand.IsZero() {
+		response.Brand = &dto.BrandResponse{Name: brand.Name(), Slug: brand.Slug()}
+	}
+
+	return response
+}
+
+// setMeasurements stores the requested dimensions and weight on the item; nil leaves them unset
+func setMeasurements(itm *item.Item, dimensions *dto.Dimensions, weight *dto.Weight) error {
+	if dimensions != nil {
+		dims, err := item.NewDimensions(dimensions.Length, dimensions.Width, dimensions.Height, dimensions.Unit)
+		if err != nil {
+			return fmt.Errorf("%w: dimensions: %v", ErrInvalidMeasurement, err)
+		}
+		itm.SetDimensions(dims)
+	}
+
+	if weight != nil {
+		w, err := item.NewWeight(weight.Value, weight.Unit)
+		if err != nil {
+			return fmt.Errorf("%w: weight: %v", ErrInvalidMeasurement, err)
+		}
+		itm.SetWeight(w)
+	}
+
+	return nil
+}
+
+// setDetails sets the measurements, barcode and brand a create request gives; absent ones are left as they are
+func setDetails(itm *item.Item, req *dto.CreateItemRequest) error {
+	if err := setMeasurements(itm, req.Dimensions, req.Weight); err != nil {
+		return err
+	}
+
+	if req.Barcode != "" {
+		barcode, err := item.NewBarcode(req.Barcode)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidBarcode, err)
+		}
+		itm.SetBarcode(barcode)
+	}
+
+	if req.Brand != "" {
+		brand, err := item.NewBrand(req.Brand)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidBrand, err)
+		}
+		itm.SetBrand(brand)
+	}
+
+	return nil
+}
+
+// setAttributes stores request attributes on the item
+// JSON strings, numbers and booleans keep their type; anything else is rejected
+func setAttributes(itm *item.Item, values map[string]interface{}) error {
+	attrs := itm.Attributes()
+	for key, raw := range values {
+		var value item.AttributeValue
+		switch v := raw.(type) {
+		case string:
+			if len(v) > 1000 {
+				return fmt.Errorf("%w: %s is longer than 1000 characters", ErrInvalidAttribute, key)
+			}
+			value = item.StringAttribute(v)
+		case bool:
+			value = item.BoolAttribute(v)
+		case float64:
+			number, err := item.NumberAttribute(v)
+			if err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrInvalidAttribute, key, err)
+			}
+			value = number
+		default:
+			return fmt.Errorf("%w: %s must be a string, number or boolean", ErrInvalidAttribute, key)
+		}
+
+		if err := attrs.SetValue(key, value); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidAttribute, err)
+		}
+	}
+	itm.SetAttributes(attrs)
+	return nil
+}
+
+// attributesToResponse returns attribute values with their JSON types
+func attributesToResponse(attrs item.Attributes) map[string]interface{} {
+	values := attrs.Values()
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		result[key] = value.Interface()
+	}
+	return result
 }
@@ -1,11 +1,19 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
 	"item-pdp-service/internal/application/dto"
 	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/events"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -55,6 +63,15 @@ func (m *MockPricingService) ApplyDiscounts(ctx context.Context, price float64,
 	return args.Get(0).(float64), args.Error(1)
 }
 
+type MockCurrencyConverter struct {
+	mock.Mock
+}
+
+func (m *MockCurrencyConverter) Convert(ctx context.Context, amount float64, from, to string) (float64, error) {
+	args := m.Called(ctx, amount, from, to)
+	return args.Get(0).(float64), args.Error(1)
+}
+
 // MockItemRepository implementation
 type MockItemRepository struct {
 	mock.Mock
@@ -65,6 +82,14 @@ func (m *MockItemRepository) Save(ctx context.Context, itm *item.Item) error {
 	return args.Error(0)
 }
 
+func (m *MockItemRepository) SaveAll(ctx context.Context, items []*item.Item, allOrNothing bool) ([]error, error) {
+	args := m.Called(ctx, items, allOrNothing)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]error), args.Error(1)
+}
+
 func (m *MockItemRepository) FindByID(ctx context.Context, id item.ItemID) (*item.Item, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -81,33 +106,113 @@ func (m *MockItemRepository) FindBySKU(ctx context.Context, sku item.SKU) (*item
 	return args.Get(0).(*item.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) FindByBarcode(ctx context.Context, barcode item.Barcode) (*item.Item, error) {
+	args := m.Called(ctx, barcode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByIDs(ctx context.Context, ids []item.ItemID) ([]*item.Item, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetItemsWithRelatedData(ctx context.Context, ids []item.ItemID) ([]item.ItemWithStats, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]item.ItemWithStats), args.Error(1)
+}
+
 func (m *MockItemRepository) Update(ctx context.Context, itm *item.Item) error {
 	args := m.Called(ctx, itm)
 	return args.Error(0)
 }
 
+func (m *MockItemRepository) UpdateAll(ctx context.Context, items []*item.Item) ([]error, error) {
+	args := m.Called(ctx, items)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]error), args.Error(1)
+}
+
 func (m *MockItemRepository) Delete(ctx context.Context, id item.ItemID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockItemRepository) FindByCategory(ctx context.Context, category item.Category, limit, offset int) ([]*item.Item, error) {
-	args := m.Called(ctx, category, limit, offset)
+func (m *MockItemRepository) DeleteAll(ctx context.Context, ids []item.ItemID) ([]item.ItemID, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]item.ItemID), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByCategory(ctx context.Context, category item.Category, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, category, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByCategoryTree(ctx context.Context, category item.Category, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, category, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByBrand(ctx context.Context, brandSlug string, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, brandSlug, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByFilter(ctx context.Context, filter item.ListFilter, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, filter, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindSummariesByFilter(ctx context.Context, filter item.ListFilter, sort item.Sort, limit, offset int) ([]item.ItemSummary, error) {
+	args := m.Called(ctx, filter, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]item.ItemSummary), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByStatus(ctx context.Context, status item.Status, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, status, sort, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*item.Item), args.Error(1)
 }
 
-func (m *MockItemRepository) FindByStatus(ctx context.Context, status item.Status, limit, offset int) ([]*item.Item, error) {
-	args := m.Called(ctx, status, limit, offset)
+func (m *MockItemRepository) Search(ctx context.Context, query string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, query, sort, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*item.Item), args.Error(1)
 }
 
-func (m *MockItemRepository) Search(ctx context.Context, query string, limit, offset int) ([]*item.Item, error) {
+func (m *MockItemRepository) SearchRanked(ctx context.Context, query string, limit, offset int) ([]*item.Item, error) {
 	args := m.Called(ctx, query, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -115,8 +220,8 @@ func (m *MockItemRepository) Search(ctx context.Context, query string, limit, of
 	return args.Get(0).([]*item.Item), args.Error(1)
 }
 
-func (m *MockItemRepository) FindAvailableItems(ctx context.Context, limit, offset int) ([]*item.Item, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockItemRepository) FindAvailableItems(ctx context.Context, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, sort, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -134,16 +239,133 @@ func (m *MockItemRepository) ExistsByID(ctx context.Context, id item.ItemID) (bo
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockItemRepository) ExistsCategory(ctx context.Context, category item.Category) (bool, error) {
+	args := m.Called(ctx, category)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockItemRepository) CountByCategory(ctx context.Context, category item.Category) (int, error) {
 	args := m.Called(ctx, category)
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockItemRepository) CountByCategoryTree(ctx context.Context, category item.Category) (int, error) {
+	args := m.Called(ctx, category)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountByBrand(ctx context.Context, brandSlug string) (int, error) {
+	args := m.Called(ctx, brandSlug)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountByFilter(ctx context.Context, filter item.ListFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockItemRepository) CountByStatus(ctx context.Context, status item.Status) (int, error) {
 	args := m.Called(ctx, status)
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockItemRepository) CountTopCategories(ctx context.Context, limit int) ([]item.CategoryCount, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]item.CategoryCount), args.Error(1)
+}
+
+func (m *MockItemRepository) CountBySearch(ctx context.Context, query string) (int, error) {
+	args := m.Called(ctx, query)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountBySearchRanked(ctx context.Context, query string) (int, error) {
+	args := m.Called(ctx, query)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountByPriceRange(ctx context.Context, min, max float64, currency string) (int, error) {
+	args := m.Called(ctx, min, max, currency)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountByCategoryAndPriceRange(ctx context.Context, category item.Category, min, max float64, currency string) (int, error) {
+	args := m.Called(ctx, category, min, max, currency)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByPriceRange(ctx context.Context, min, max float64, currency string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, min, max, currency, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByCategoryAndPriceRange(ctx context.Context, category item.Category, min, max float64, currency string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, category, min, max, currency, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) CountByAttributes(ctx context.Context, filters map[string]string) (int, error) {
+	args := m.Called(ctx, filters)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByAttributes(ctx context.Context, filters map[string]string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, filters, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) CountByAttributeRanges(ctx context.Context, ranges []item.AttributeRange) (int, error) {
+	args := m.Called(ctx, ranges)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByAttributeRanges(ctx context.Context, ranges []item.AttributeRange, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	args := m.Called(ctx, ranges, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) Stream(ctx context.Context, filter item.StreamFilter, fn func(*item.Item) error) error {
+	args := m.Called(ctx, filter)
+	if items, ok := args.Get(0).([]*item.Item); ok {
+		for _, itm := range items {
+			if err := fn(itm); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockItemRepository) FindPriceHistory(ctx context.Context, id item.ItemID, limit, offset int) ([]item.PriceChange, error) {
+	args := m.Called(ctx, id, limit, offset)
+	return args.Get(0).([]item.PriceChange), args.Error(1)
+}
+
+func (m *MockItemRepository) CountPriceHistory(ctx context.Context, id item.ItemID) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockItemRepository) CountAvailable(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockItemRepository) FindItemsWithLowStock(ctx context.Context, threshold int) ([]*item.Item, error) {
 	args := m.Called(ctx, threshold)
 	if args.Get(0) == nil {
@@ -152,6 +374,35 @@ func (m *MockItemRepository) FindItemsWithLowStock(ctx context.Context, threshol
 	return args.Get(0).([]*item.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*item.Item, error) {
+	args := m.Called(ctx, prefix, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindRelated(ctx context.Context, source *item.Item, limit int) ([]*item.Item, error) {
+	args := m.Called(ctx, source, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*item.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindMostViewed(ctx context.Context, since time.Time, limit int) ([]item.ItemViewCount, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]item.ItemViewCount), args.Error(1)
+}
+
+func (m *MockItemRepository) RecordView(ctx context.Context, id item.ItemID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func TestItemUseCase_CreateItem(t *testing.T) {
 	t.Run("successful creation", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
@@ -159,7 +410,7 @@ func TestItemUseCase_CreateItem(t *testing.T) {
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
 		req := &dto.CreateItemRequest{
 			SKU:         "TEST-001",
@@ -175,6 +426,7 @@ func TestItemUseCase_CreateItem(t *testing.T) {
 		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "electronics").Return(99.99, nil)
 		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
 		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 10).Return(nil)
 
 		result, err := useCase.CreateItem(context.Background(), req)
 
@@ -182,241 +434,3837 @@ func TestItemUseCase_CreateItem(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Equal(t, "Test Item", result.Name)
 		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
 		mockCategory.AssertExpectations(t)
 		mockPricing.AssertExpectations(t)
 	})
 
-	t.Run("duplicate SKU error", func(t *testing.T) {
+	t.Run("publishes ItemCreated after save", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		var received []item.DomainEvent
+		dispatcher := events.NewDispatcher()
+		dispatcher.Subscribe("ItemCreated", events.HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+			received = append(received, event)
+			return nil
+		}))
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, dispatcher)
 
 		req := &dto.CreateItemRequest{
-			SKU:         "TEST-001",
-			Name:        "Test Item",
-			Description: "Test Description",
-			Price:       99.99,
-			Category:    "electronics",
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    99.99,
+			Category: "electronics",
 		}
 
-		// Setup expectations for business validation in application layer
 		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
 		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "electronics").Return(99.99, nil)
-		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(true, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
 
 		result, err := useCase.CreateItem(context.Background(), req)
 
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+		assert.Equal(t, result.ID, received[0].AggregateID())
+	})
+
+	t.Run("no events when save fails", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		published := 0
+		dispatcher := events.NewDispatcher()
+		dispatcher.Subscribe("ItemCreated", events.HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+			published++
+			return nil
+		}))
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, dispatcher)
+
+		req := &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    99.99,
+			Category: "electronics",
+		}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "electronics").Return(99.99, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(errors.New("db down"))
+
+		_, err := useCase.CreateItem(context.Background(), req)
+
 		assert.Error(t, err)
-		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "already exists")
-		mockRepo.AssertExpectations(t)
+		assert.Equal(t, 0, published)
 	})
 
-	t.Run("invalid SKU", func(t *testing.T) {
+	t.Run("duplicate SKU error", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
 		req := &dto.CreateItemRequest{
-			SKU:         "", // Invalid empty SKU
+			SKU:         "TEST-001",
 			Name:        "Test Item",
 			Description: "Test Description",
 			Price:       99.99,
 			Category:    "electronics",
 		}
 
+		// Setup expectations for business validation in application layer
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.MatchedBy(func(sku item.SKU) bool {
+			return sku.String() == "TEST-001"
+		})).Return(true, nil)
+
 		result, err := useCase.CreateItem(context.Background(), req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "SKU is required")
+		assert.Contains(t, err.Error(), "already exists")
+		assert.ErrorIs(t, err, ErrDuplicateSKU)
+		mockRepo.AssertExpectations(t)
+		mockPricing.AssertNotCalled(t, "CalculatePrice", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
 	})
-}
 
-func TestItemUseCase_GetItemByID(t *testing.T) {
-	t.Run("successful retrieval", func(t *testing.T) {
+	t.Run("SKU is checked in upper case", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
-		// Create test item
-		testItem := createTestItem(t)
-		itemID := testItem.ID()
+		req := &dto.CreateItemRequest{
+			SKU:      "test-002",
+			Name:     "Test Item",
+			Price:    99.99,
+			Category: "electronics",
+		}
 
-		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.MatchedBy(func(sku item.SKU) bool {
+			return sku.String() == "TEST-002"
+		})).Return(true, nil)
 
-		result, err := useCase.GetItemByID(context.Background(), itemID.String())
+		_, err := useCase.CreateItem(context.Background(), req)
 
-		assert.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, testItem.Name(), result.Name)
+		assert.Error(t, err)
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("item not found", func(t *testing.T) {
+	t.Run("invalid SKU", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
-		itemID := item.NewItemID()
-		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+		req := &dto.CreateItemRequest{
+			SKU:         "BAD SKU!",
+			Name:        "Test Item",
+			Description: "Test Description",
+			Price:       99.99,
+			Category:    "electronics",
+		}
 
-		result, err := useCase.GetItemByID(context.Background(), itemID.String())
+		result, err := useCase.CreateItem(context.Background(), req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		mockRepo.AssertExpectations(t)
+		assert.Contains(t, err.Error(), "invalid SKU")
 	})
 
-	t.Run("invalid ID format", func(t *testing.T) {
-		mockRepo := &MockItemRepository{}
-		mockInventory := &MockInventoryService{}
-		mockCategory := &MockCategoryService{}
-		mockPricing := &MockPricingService{}
+	t.Run("item rules are checked before saving", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			itemName  string
+			price     float64
+			wantError string
+		}{
+			{"name too short", "TV", 99.99, "item name must be at least 3 characters"},
+			{"price above ceiling", "Test Item", 2000000, "exceeds the maximum of 999999.00"},
+		}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := &MockItemRepository{}
+				mockCategory := &MockCategoryService{}
+				mockPricing := &MockPricingService{}
+				useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
 
-		result, err := useCase.GetItemByID(context.Background(), "invalid-id")
+				mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+				mockPricing.On("CalculatePrice", mock.Anything, tt.price, "electronics").Return(tt.price, nil)
+				mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
 
-		assert.Error(t, err)
-		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "invalid item ID format")
+				_, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+					SKU:      "TEST-001",
+					Name:     tt.itemName,
+					Price:    tt.price,
+					Category: "electronics",
+				})
+
+				assert.ErrorIs(t, err, ErrInvalidItem)
+				assert.ErrorContains(t, err, tt.wantError)
+				mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+			})
+		}
 	})
 }
 
-func TestItemUseCase_UpdateInventory(t *testing.T) {
-	t.Run("successful update", func(t *testing.T) {
+func TestItemUseCase_CreateItemSKUPolicy(t *testing.T) {
+	policy, err := item.NewSKUPolicy(3, 30, "^[A-Z0-9-]+$", item.SKUCaseUpper)
+	require.NoError(t, err)
+
+	newUseCase := func() (ItemUseCase, *MockItemRepository) {
 		mockRepo := &MockItemRepository{}
-		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "electronics").Return(99.99, nil)
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(), WithSKUPolicy(policy)), mockRepo
+	}
+	newRequest := func(sku string) *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{SKU: sku, Name: "Test Item", Price: 99.99, Category: "electronics"}
+	}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+	t.Run("accepts SKUs up to the configured length", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
 
-		testItem := createTestItem(t)
+		result, err := useCase.CreateItem(context.Background(), newRequest("warehouse-7-aisle-12-bin-0042"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "WAREHOUSE-7-AISLE-12-BIN-0042", result.SKU)
+	})
+
+	t.Run("rejects characters the policy disallows", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+
+		_, err := useCase.CreateItem(context.Background(), newRequest("ABC_123"))
+
+		assert.ErrorContains(t, err, "invalid SKU")
+		mockRepo.AssertNotCalled(t, "ExistsBySKU", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_CreateItemBusinessRules(t *testing.T) {
+	rules, err := item.NewBusinessRules(50, 5, "eur", nil)
+	require.NoError(t, err)
+
+	newUseCase := func(price float64) (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, price, "electronics").Return(price, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		converter := &MockCurrencyConverter{}
+		converter.On("Convert", mock.Anything, mock.Anything, "EUR", "USD").Return(price, nil)
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(),
+			WithCurrencyConverter(converter), WithBusinessRules(rules)), mockRepo
+	}
+
+	t.Run("configured price ceiling replaces the default", func(t *testing.T) {
+		useCase, mockRepo := newUseCase(99.99)
+
+		_, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU: "TEST-001", Name: "Test Item", Price: 99.99, Category: "electronics",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidItem)
+		assert.ErrorContains(t, err, "exceeds the maximum of 50.00")
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("configured default currency is used when none is given", func(t *testing.T) {
+		useCase, mockRepo := newUseCase(19.99)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU: "TEST-001", Name: "Test Item", Price: 19.99, Category: "electronics",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", result.Currency)
+	})
+}
+
+func TestItemUseCase_CreateItemAutoCorrect(t *testing.T) {
+	enabled, disabled := true, false
+
+	newUseCase := func(price float64, quantity int, opts ...Option) (ItemUseCase, *MockItemRepository, *MockInventoryService) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, price, "toys").Return(price, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), quantity).Return(nil)
+		return NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher(), opts...), mockRepo, mockInventory
+	}
+	newRequest := func(price float64, quantity int, autoCorrect *bool) *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{SKU: "TEST-001", Name: "Test Item", Price: price, Category: "toys", Inventory: quantity, AutoCorrect: autoCorrect}
+	}
+
+	t.Run("submitted values are kept when corrections are off", func(t *testing.T) {
+		useCase, mockRepo, mockInventory := newUseCase(5000, 150)
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(5000, 150, nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, 150, result.Inventory.Quantity)
+		assert.Equal(t, "draft", result.Status)
+		assert.Empty(t, result.Warnings)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("low stock is kept when corrections are off", func(t *testing.T) {
+		useCase, _, mockInventory := newUseCase(20, 2)
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(20, 2, nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Inventory.Quantity)
+		assert.Empty(t, result.Warnings)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("request opts in and gets a warning per correction", func(t *testing.T) {
+		useCase, _, mockInventory := newUseCase(20, 5)
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(20, 2, &enabled))
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Inventory.Quantity)
+		assert.Equal(t, []string{"inventory raised from 2 to the minimum level of 5"}, result.Warnings)
+		// The inventory service is told about the corrected stock, not the submitted one
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("configured default activates high stock drafts", func(t *testing.T) {
+		useCase, _, _ := newUseCase(5000, 150, WithAutoCorrect(true))
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(5000, 150, nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, "active", result.Status)
+		assert.Equal(t, []string{"item activated because its inventory of 150 exceeds 100"}, result.Warnings)
+	})
+
+	t.Run("request can opt out of the configured default", func(t *testing.T) {
+		useCase, _, _ := newUseCase(5000, 150, WithAutoCorrect(true))
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(5000, 150, &disabled))
+
+		require.NoError(t, err)
+		assert.Equal(t, "draft", result.Status)
+		assert.Empty(t, result.Warnings)
+	})
+}
+
+func TestItemUseCase_CreateItemGeneratesSKU(t *testing.T) {
+	newRequest := func() *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{
+			Name:     "Test Item",
+			Price:    99.99,
+			Category: "Electronics",
+		}
+	}
+	skuOf := func(raw string) item.SKU {
+		sku, err := item.NewSKU(raw)
+		require.NoError(t, err)
+		return sku
+	}
+
+	t.Run("next number in the category", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(122, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, skuOf("ELEC-000123")).Return(false, nil)
+		mockCategory.On("ValidateCategory", mock.Anything, "Electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "Electronics").Return(99.99, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		req := newRequest()
+		result, err := useCase.CreateItem(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ELEC-000123", result.SKU)
+		assert.Empty(t, req.SKU, "the caller's request is left untouched")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("collision retries with the next number", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(4, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, skuOf("ELEC-000005")).Return(true, nil).Once()
+		mockRepo.On("ExistsBySKU", mock.Anything, skuOf("ELEC-000006")).Return(true, nil).Once()
+		mockRepo.On("ExistsBySKU", mock.Anything, skuOf("ELEC-000007")).Return(false, nil)
+		mockCategory.On("ValidateCategory", mock.Anything, "Electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "Electronics").Return(99.99, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), newRequest())
+
+		require.NoError(t, err)
+		assert.Equal(t, "ELEC-000007", result.SKU)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("gives up after repeated collisions", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(true, nil)
+
+		_, err := useCase.CreateItem(context.Background(), newRequest())
+
+		assert.ErrorContains(t, err, "failed to generate a unique SKU")
+		mockRepo.AssertNumberOfCalls(t, "ExistsBySKU", maxSKUGenerationAttempts)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("category is still required", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		req := newRequest()
+		req.Category = ""
+		_, err := useCase.CreateItem(context.Background(), req)
+
+		assert.ErrorContains(t, err, "category is required")
+		mockRepo.AssertNotCalled(t, "CountByCategory", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_CreateItemDiscounts(t *testing.T) {
+	newUseCase := func(category string, opts ...Option) (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, category).Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 100.0, category).Return(100.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(), opts...), mockRepo
+	}
+	create := func(useCase ItemUseCase, category string) *dto.ItemResponse {
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    100,
+			Currency: "USD",
+			Category: category,
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("default policy discounts a category once", func(t *testing.T) {
+		useCase, _ := newUseCase("Electronics")
+		assert.Equal(t, 95.0, create(useCase, "Electronics").Price)
+	})
+
+	t.Run("configured policy", func(t *testing.T) {
+		policy, err := item.NewDiscountPolicy(map[string]float64{"toys": 0.5})
+		require.NoError(t, err)
+
+		useCase, _ := newUseCase("toys", WithDiscountPolicy(policy))
+		assert.Equal(t, 50.0, create(useCase, "toys").Price)
+
+		useCase, _ = newUseCase("electronics", WithDiscountPolicy(policy))
+		assert.Equal(t, 100.0, create(useCase, "electronics").Price)
+	})
+}
+
+func TestItemUseCase_CreateItemAttributes(t *testing.T) {
+	newUseCase := func() (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "bags").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 100.0, "bags").Return(100.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher()), mockRepo
+	}
+	request := func(attributes map[string]interface{}) *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{
+			SKU:        "BAG-001",
+			Name:       "Backpack",
+			Price:      100,
+			Currency:   "USD",
+			Category:   "bags",
+			Attributes: attributes,
+		}
+	}
+
+	t.Run("typed values are kept", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+		mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(itm *item.Item) bool {
+			weight, ok := itm.Attributes().Value("weight")
+			number, isNumber := weight.Number()
+			return ok && isNumber && number == 1.5
+		})).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), request(map[string]interface{}{
+			"weight":     1.5,
+			"waterproof": true,
+			"color":      "black",
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.Attributes{"weight": 1.5, "waterproof": true, "color": "black"}, result.Attributes)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("nested values are rejected", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+
+		_, err := useCase.CreateItem(context.Background(), request(map[string]interface{}{
+			"dimensions": map[string]interface{}{"width": 30.0},
+		}))
+
+		assert.ErrorIs(t, err, ErrInvalidAttribute)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_CreateItemMeasurements(t *testing.T) {
+	newUseCase := func() (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "bags").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 100.0, "bags").Return(100.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher()), mockRepo
+	}
+	request := func(dimensions *dto.Dimensions, weight *dto.Weight) *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{
+			SKU:        "BAG-001",
+			Name:       "Backpack",
+			Price:      100,
+			Currency:   "USD",
+			Category:   "bags",
+			Dimensions: dimensions,
+			Weight:     weight,
+		}
+	}
+
+	t.Run("dimensions and weight are stored", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+		mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(itm *item.Item) bool {
+			return itm.Dimensions().Height() == 15 && itm.Weight().Unit() == item.WeightUnitKilogram
+		})).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), request(
+			&dto.Dimensions{Length: 45, Width: 30, Height: 15, Unit: "cm"},
+			&dto.Weight{Value: 0.9, Unit: "KG"},
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, &dto.Dimensions{Length: 45, Width: 30, Height: 15, Unit: "cm"}, result.Dimensions)
+		assert.Equal(t, &dto.Weight{Value: 0.9, Unit: "kg"}, result.Weight)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("omitted measurements stay unset", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), request(nil, nil))
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Dimensions)
+		assert.Nil(t, result.Weight)
+	})
+
+	t.Run("negative dimensions are rejected", func(t *testing.T) {
+		useCase, mockRepo := newUseCase()
+
+		_, err := useCase.CreateItem(context.Background(), request(
+			&dto.Dimensions{Length: -45, Width: 30, Height: 15, Unit: "cm"}, nil,
+		))
+
+		assert.ErrorIs(t, err, ErrInvalidMeasurement)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_Barcode(t *testing.T) {
+	barcode, err := item.NewBarcode("4006381333931")
+	require.NoError(t, err)
+
+	t.Run("lookup by a valid GTIN", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		stored := createTestItem(t)
+		stored.SetBarcode(barcode)
+		mockRepo.On("FindByBarcode", mock.Anything, barcode).Return(stored, nil)
+
+		result, err := useCase.GetItemByBarcode(context.Background(), "4006-3813-3393-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "4006381333931", result.Barcode)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("transposed digits are rejected before the lookup", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.GetItemByBarcode(context.Background(), "4006381339331")
+
+		assert.ErrorIs(t, err, ErrInvalidBarcode)
+		mockRepo.AssertNotCalled(t, "FindByBarcode", mock.Anything, mock.Anything)
+	})
+
+	t.Run("create stores the normalized barcode", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockCategory.On("ValidateCategory", mock.Anything, "bags").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 100.0, "bags").Return(100.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(itm *item.Item) bool {
+			return itm.Barcode() == barcode
+		})).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU: "BAG-001", Barcode: "4006381 333931", Name: "Backpack", Price: 100, Currency: "USD", Category: "bags",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "4006381333931", result.Barcode)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("create rejects an invalid barcode", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockCategory.On("ValidateCategory", mock.Anything, "bags").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 100.0, "bags").Return(100.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		_, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU: "BAG-001", Barcode: "4006381339331", Name: "Backpack", Price: 100, Currency: "USD", Category: "bags",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidBarcode)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_Currency(t *testing.T) {
+	unsupported := fmt.Errorf("%w: CHF", ErrUnsupportedCurrency)
+
+	t.Run("create keeps the request currency and checks the draft threshold in USD", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		converter := &MockCurrencyConverter{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 1000.0, "toys").Return(1000.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+		// 1000 EUR is above the 1000 USD threshold
+		converter.On("Convert", mock.Anything, 1000.0, "EUR", "USD").Return(1087.0, nil)
+
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    1000,
+			Currency: "eur",
+			Category: "toys",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1000.0, result.Price)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.Equal(t, "draft", result.Status)
+		converter.AssertExpectations(t)
+	})
+
+	t.Run("create in an unsupported currency saves nothing", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		converter := &MockCurrencyConverter{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 10.0, "toys").Return(10.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		converter.On("Convert", mock.Anything, 10.0, "CHF", "USD").Return(0.0, unsupported)
+
+		_, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    10,
+			Currency: "CHF",
+			Category: "toys",
+		})
+
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("create in an allowed currency prices the item in it", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		converter := &MockCurrencyConverter{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 25.0, "toys").Return(25.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		converter.On("Convert", mock.Anything, 25.0, "EUR", "USD").Return(27.18, nil)
+
+		var saved *item.Item
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).
+			Run(func(args mock.Arguments) { saved = args.Get(1).(*item.Item) }).
+			Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    25,
+			Currency: "EUR",
+			Category: "toys",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", result.Currency)
+		require.NotNil(t, saved)
+		assert.Equal(t, "EUR", saved.Price().Currency())
+		assert.Equal(t, 25.0, saved.Price().Amount())
+	})
+
+	t.Run("create without a currency uses the default", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 25.0, "toys").Return(25.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    25,
+			Category: "toys",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "USD", result.Currency)
+	})
+
+	t.Run("create in a currency that is not allowed is a field error", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher(),
+			WithCurrencyConverter(&MockCurrencyConverter{}))
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+
+		_, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    25,
+			Currency: "XYZ",
+			Category: "toys",
+		})
+
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "currency", fieldErr.Field)
+		assert.Equal(t, "XYZ", fieldErr.Value)
+		assert.Equal(t, "Must be one of EUR, GBP, JPY, USD", fieldErr.Message)
+		mockRepo.AssertNotCalled(t, "ExistsBySKU", mock.Anything, mock.Anything)
+		mockPricing.AssertNotCalled(t, "CalculatePrice", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without a converter only USD prices can be created", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 10.0, "toys").Return(10.0, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		_, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    10,
+			Currency: "EUR",
+			Category: "toys",
+		})
+
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+	})
+
+	t.Run("get converts the response price", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		converter := &MockCurrencyConverter{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		testItem := createTestItem(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusActive))
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("RecordView", mock.Anything, testItem.ID()).Return(nil).Maybe()
+		converter.On("Convert", mock.Anything, 99.99, "USD", "EUR").Return(91.9908, nil)
+
+		result, err := useCase.GetItemInCurrency(context.Background(), testItem.ID().String(), "eur")
+
+		require.NoError(t, err)
+		assert.Equal(t, 91.99, result.Price)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.Equal(t, 99.99, testItem.Price().Amount(), "stored price is unchanged")
+	})
+
+	t.Run("get in an unsupported currency", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		converter := &MockCurrencyConverter{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("RecordView", mock.Anything, testItem.ID()).Return(nil).Maybe()
+		converter.On("Convert", mock.Anything, mock.Anything, "USD", "CHF").Return(0.0, unsupported)
+
+		result, err := useCase.GetItemInCurrency(context.Background(), testItem.ID().String(), "CHF")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+	})
+
+	t.Run("update with only a currency converts the stored price", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		converter := &MockCurrencyConverter{}
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+		converter.On("Convert", mock.Anything, 99.99, "USD", "GBP").Return(78.9921, nil)
+
+		currency := "GBP"
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Currency: &currency})
+
+		require.NoError(t, err)
+		assert.Equal(t, 78.99, result.Price)
+		assert.Equal(t, "GBP", result.Currency)
+	})
+}
+
+func TestItemUseCase_CreateItemReservesInventory(t *testing.T) {
+	newCreateUseCase := func() (ItemUseCase, *MockItemRepository, *MockInventoryService) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "electronics").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 99.99, "electronics").Return(99.99, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+		return useCase, mockRepo, mockInventory
+	}
+	newRequest := func(inventory int) *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{SKU: "TEST-001", Name: "Test Item", Price: 99.99, Category: "electronics", Inventory: inventory}
+	}
+
+	t.Run("opening stock is reserved for the new item", func(t *testing.T) {
+		useCase, mockRepo, mockInventory := newCreateUseCase()
+
+		var savedID string
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil).Run(func(args mock.Arguments) {
+			savedID = args.Get(1).(*item.Item).ID().String()
+		})
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 25).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(25))
+
+		require.NoError(t, err)
+		assert.Equal(t, savedID, result.ID)
+		mockInventory.AssertCalled(t, "ReserveInventory", mock.Anything, savedID, 25)
+	})
+
+	t.Run("a reserve failure aborts the create", func(t *testing.T) {
+		useCase, mockRepo, mockInventory := newCreateUseCase()
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 25).Return(errors.New("warehouse unavailable"))
+
+		result, err := useCase.CreateItem(context.Background(), newRequest(25))
+
+		assert.ErrorContains(t, err, "failed to reserve inventory")
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a save failure releases the reservation", func(t *testing.T) {
+		useCase, mockRepo, mockInventory := newCreateUseCase()
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(errors.New("connection reset"))
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 25).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, mock.AnythingOfType("string"), 25).Return(nil)
+
+		_, err := useCase.CreateItem(context.Background(), newRequest(25))
+
+		assert.ErrorContains(t, err, "failed to save item")
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("no stock makes no inventory service call", func(t *testing.T) {
+		useCase, mockRepo, mockInventory := newCreateUseCase()
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		_, err := useCase.CreateItem(context.Background(), newRequest(0))
+
+		require.NoError(t, err)
+		mockInventory.AssertNotCalled(t, "ReserveInventory", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_GetItemByID(t *testing.T) {
+	t.Run("successful retrieval", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		// Create test item
+		testItem := createTestItem(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("RecordView", mock.Anything, itemID).Return(nil).Maybe()
+
+		result, err := useCase.GetItemByID(context.Background(), itemID.String())
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, testItem.Name(), result.Name)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		itemID := item.NewItemID()
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+
+		result, err := useCase.GetItemByID(context.Background(), itemID.String())
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid ID format", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		result, err := useCase.GetItemByID(context.Background(), "invalid-id")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid item ID format")
+	})
+}
+
+func TestItemUseCase_GetItemByIDRecordsView(t *testing.T) {
+	waitForView := func(t *testing.T, recorded <-chan error) error {
+		t.Helper()
+		select {
+		case err := <-recorded:
+			return err
+		case <-time.After(time.Second):
+			t.Fatal("view was not recorded")
+			return nil
+		}
+	}
+
+	t.Run("fetching an item records a view", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		recorded := make(chan error, 1)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("RecordView", mock.Anything, testItem.ID()).Return(nil).Run(func(args mock.Arguments) {
+			recorded <- args.Get(0).(context.Context).Err()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := useCase.GetItemByID(ctx, testItem.ID().String())
+		cancel()
+
+		require.NoError(t, err)
+		assert.NoError(t, waitForView(t, recorded), "the insert outlives the request context")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("the read does not wait for the insert", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		release := make(chan struct{})
+		recorded := make(chan error, 1)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("RecordView", mock.Anything, testItem.ID()).Return(nil).Run(func(mock.Arguments) {
+			<-release
+			recorded <- nil
+		})
+
+		result, err := useCase.GetItemByID(context.Background(), testItem.ID().String())
+
+		require.NoError(t, err)
+		assert.Equal(t, testItem.ID().String(), result.ID)
+		close(release)
+		waitForView(t, recorded)
+	})
+
+	t.Run("a failed insert does not fail the read", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		recorded := make(chan error, 1)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("RecordView", mock.Anything, testItem.ID()).Return(errors.New("connection reset")).Run(func(mock.Arguments) {
+			recorded <- nil
+		})
+
+		result, err := useCase.GetItemByID(context.Background(), testItem.ID().String())
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		waitForView(t, recorded)
+	})
+
+	t.Run("a missing item records no view", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		itemID := item.NewItemID()
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+
+		_, err := useCase.GetItemByID(context.Background(), itemID.String())
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_UpdateItem(t *testing.T) {
+	newUpdateUseCase := func(t *testing.T) (ItemUseCase, *MockItemRepository, *MockCategoryService, *item.Item) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		attrs := testItem.Attributes()
+		require.NoError(t, attrs.Set("color", "red"))
+		testItem.SetAttributes(attrs)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		return useCase, mockRepo, mockCategory, testItem
+	}
+
+	t.Run("patching only the name leaves everything else untouched", func(t *testing.T) {
+		useCase, mockRepo, mockCategory, testItem := newUpdateUseCase(t)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		name := "Renamed Item"
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Name: &name})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed Item", result.Name)
+		assert.Equal(t, 99.99, result.Price)
+		assert.Equal(t, "USD", result.Currency)
+		assert.Equal(t, "Test Description", result.Description)
+		assert.Equal(t, "electronics", result.Category.Slug)
+		assert.Equal(t, dto.Attributes{"color": "red"}, result.Attributes)
+		mockCategory.AssertNotCalled(t, "ValidateCategory", mock.Anything, mock.Anything)
+	})
+
+	t.Run("patching the category updates its name and slug", func(t *testing.T) {
+		useCase, mockRepo, mockCategory, testItem := newUpdateUseCase(t)
+		mockCategory.On("ValidateCategory", mock.Anything, "Home & Garden").Return(nil)
+		var saved []item.DomainEvent
+		mockRepo.On("Update", mock.Anything, testItem).Run(func(mock.Arguments) { saved = testItem.Events() }).Return(nil)
+
+		category := "Home & Garden"
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Category: &category})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Home & Garden", result.Category.Name)
+		assert.Equal(t, "home-garden", result.Category.Slug)
+		assert.Equal(t, "Test Item", result.Name)
+		assert.Equal(t, 99.99, result.Price)
+		require.NotEmpty(t, saved)
+		changed, ok := saved[len(saved)-1].(*item.ItemCategoryChangedEvent)
+		require.True(t, ok, "expected an ItemCategoryChangedEvent, got %T", saved[len(saved)-1])
+		assert.Equal(t, "electronics", changed.OldCategory.Slug())
+		assert.Equal(t, "home-garden", changed.NewCategory.Slug())
+	})
+
+	t.Run("moving to the restricted category is rejected", func(t *testing.T) {
+		useCase, mockRepo, mockCategory, testItem := newUpdateUseCase(t)
+		mockCategory.On("ValidateCategory", mock.Anything, "Restricted").Return(nil)
+
+		category := "Restricted"
+		_, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Category: &category})
+
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+		assert.ErrorContains(t, err, `category "Restricted" is restricted`)
+		assert.Equal(t, "electronics", testItem.Category().Slug())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("every field is applied and attributes are merged", func(t *testing.T) {
+		useCase, mockRepo, mockCategory, testItem := newUpdateUseCase(t)
+		mockCategory.On("ValidateCategory", mock.Anything, "books").Return(nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		name, description, category, currency := "New Name", "", "books", "EUR"
+		price := 12.5
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{
+			Name:        &name,
+			Description: &description,
+			Price:       &price,
+			Currency:    &currency,
+			Category:    &category,
+			Attributes:  map[string]interface{}{"pages": float64(320)},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "New Name", result.Name)
+		assert.Empty(t, result.Description)
+		assert.Equal(t, 12.5, result.Price)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.Equal(t, "books", result.Category.Slug)
+		assert.Equal(t, dto.Attributes{"color": "red", "pages": float64(320)}, result.Attributes)
+	})
+
+	t.Run("invalid values are rejected before saving", func(t *testing.T) {
+		useCase, mockRepo, mockCategory, testItem := newUpdateUseCase(t)
+		mockCategory.On("ValidateCategory", mock.Anything, "!!!").Return(nil)
+
+		short, category := "ab", "!!!"
+		_, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Name: &short})
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+
+		_, err = useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Category: &category})
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+
+		tooHigh := float64(item.DefaultMaxPrice + 1)
+		_, err = useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Price: &tooHigh})
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("raising an active item's price by more than half is rejected", func(t *testing.T) {
+		useCase, mockRepo, _, testItem := newUpdateUseCase(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusActive))
+
+		price := 150.0
+		_, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Price: &price})
+
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+		assert.Contains(t, err.Error(), "exceeds maximum allowed")
+		assert.Equal(t, 99.99, testItem.Price().Amount())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("an active item's price and currency changing together are limited in the current currency", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		converter := &MockCurrencyConverter{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+			WithCurrencyConverter(converter))
+
+		testItem := createTestItem(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusActive))
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		// 200 EUR is 217.40 USD, more than half above 99.99 USD
+		converter.On("Convert", mock.Anything, 200.0, "EUR", "USD").Return(217.4, nil)
+
+		price, currency := 200.0, "EUR"
+		_, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Price: &price, Currency: &currency})
+
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+		assert.Contains(t, err.Error(), "exceeds maximum allowed")
+		assert.Equal(t, "USD", testItem.Price().Currency())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+		// 120 EUR is 130.44 USD, within the limit
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+		converter.On("Convert", mock.Anything, 120.0, "EUR", "USD").Return(130.44, nil)
+
+		price = 120.0
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Price: &price, Currency: &currency})
+
+		require.NoError(t, err)
+		assert.Equal(t, 120.0, result.Price)
+		assert.Equal(t, "EUR", result.Currency)
+		converter.AssertExpectations(t)
+	})
+
+	t.Run("a draft item's price may rise by more than half", func(t *testing.T) {
+		useCase, mockRepo, _, testItem := newUpdateUseCase(t)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		price := 150.0
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Price: &price})
+
+		require.NoError(t, err)
+		assert.Equal(t, 150.0, testItem.Price().Amount())
+		assert.Equal(t, "draft", result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+// featureFlags switches on the features set to true; any other name is off
+type featureFlags map[string]bool
+
+func (f featureFlags) FeatureEnabled(name string) bool { return f[name] }
+
+func TestItemUseCase_FeatureFlags(t *testing.T) {
+	// updateDraftPrice raises a draft item's price from 99.99 to 300 with flags configured
+	updateDraftPrice := func(t *testing.T, opts ...Option) (*dto.ItemResponse, *MockItemRepository, error) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(), opts...)
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil).Maybe()
+
+		price := 300.0
+		result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Price: &price})
+		return result, mockRepo, err
+	}
+
+	t.Run("strict pricing limits rises of draft items", func(t *testing.T) {
+		_, mockRepo, err := updateDraftPrice(t, WithFeatureFlags(featureFlags{FeatureStrictPricing: true}))
+
+		assert.ErrorIs(t, err, ErrInvalidUpdate)
+		assert.Contains(t, err.Error(), "exceeds maximum allowed")
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("disabled strict pricing leaves draft items unlimited", func(t *testing.T) {
+		result, mockRepo, err := updateDraftPrice(t, WithFeatureFlags(featureFlags{FeatureStrictPricing: false}))
+
+		require.NoError(t, err)
+		assert.Equal(t, 300.0, result.Price)
+		mockRepo.AssertNumberOfCalls(t, "Update", 1)
+	})
+
+	t.Run("unknown flags and no flags are off", func(t *testing.T) {
+		for _, opts := range [][]Option{nil, {WithFeatureFlags(featureFlags{"unrelated": true})}, {WithFeatureFlags(nil)}} {
+			result, _, err := updateDraftPrice(t, opts...)
+
+			require.NoError(t, err)
+			assert.Equal(t, 300.0, result.Price)
+		}
+	})
+}
+
+func TestItemUseCase_UpdateInventory(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		itemID := testItem.ID()
+
+		req := &dto.UpdateInventoryRequest{
+			Quantity: 50,
+		}
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+		mockInventory.On("ReserveInventory", mock.Anything, itemID.String(), 50).Return(nil)
+
+		result, err := useCase.UpdateInventory(context.Background(), itemID.String(), req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("lowering stock releases the difference", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventory(30)
+		testItem.SetInventory(inventory)
+
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, testItem.ID().String(), 12).Return(nil)
+
+		result, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: 18})
+
+		require.NoError(t, err)
+		assert.Equal(t, 18, result.Inventory.Quantity)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("unchanged stock makes no inventory service call", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventory(30)
+		testItem.SetInventory(inventory)
+
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		_, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: 30})
+
+		require.NoError(t, err)
+		mockInventory.AssertNotCalled(t, "ReserveInventory", mock.Anything, mock.Anything, mock.Anything)
+		mockInventory.AssertNotCalled(t, "ReleaseInventory", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("inventory service failure leaves the item unchanged", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockInventory.On("ReserveInventory", mock.Anything, testItem.ID().String(), 50).Return(errors.New("warehouse unavailable"))
+
+		result, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: 50})
+
+		assert.ErrorContains(t, err, "warehouse unavailable")
+		assert.Nil(t, result)
+		assert.Equal(t, 0, testItem.Inventory().Quantity())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("save failure restores the inventory service level", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(errors.New("connection reset"))
+		mockInventory.On("ReserveInventory", mock.Anything, testItem.ID().String(), 50).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, testItem.ID().String(), 50).Return(nil)
+
+		_, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: 50})
+
+		assert.ErrorContains(t, err, "failed to save item")
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		itemID := item.NewItemID()
+		req := &dto.UpdateInventoryRequest{
+			Quantity: 50,
+		}
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+
+		result, err := useCase.UpdateInventory(context.Background(), itemID.String(), req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_StatusRules(t *testing.T) {
+	newActiveItem := func(t *testing.T, categoryName string, quantity int) *item.Item {
+		t.Helper()
+		testItem := createTestItem(t)
+		category, err := item.NewCategory(categoryName)
+		require.NoError(t, err)
+		testItem.SetCategory(category)
+		inventory, err := item.NewInventory(quantity)
+		require.NoError(t, err)
+		testItem.SetInventory(inventory)
+		require.NoError(t, testItem.TransitionTo(item.StatusActive))
+		testItem.PullEvents()
+		return testItem
+	}
+
+	// writtenWith matches the item handed to Update, checking the status change was recorded before the write
+	writtenWith := func(status item.Status, statusEvents int) interface{} {
+		return mock.MatchedBy(func(itm *item.Item) bool {
+			changes := 0
+			for _, event := range itm.Events() {
+				if _, ok := event.(*item.ItemStatusChangedEvent); ok {
+					changes++
+				}
+			}
+			return itm.Status() == status && changes == statusEvents
+		})
+	}
+
+	t.Run("selling out archives an active item before the write", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newActiveItem(t, "Electronics", 5)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, writtenWith(item.StatusArchived, 1)).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, testItem.ID().String(), 5).Return(nil)
+
+		result, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: 0})
+
+		require.NoError(t, err)
+		assert.Equal(t, item.StatusArchived.String(), result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		wantStatus   item.Status
+		statusEvents int
+	}{
+		{name: "winter deactivates a seasonal item", now: time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC), wantStatus: item.StatusInactive, statusEvents: 1},
+		{name: "summer leaves a seasonal item active", now: time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC), wantStatus: item.StatusActive, statusEvents: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockItemRepository{}
+			useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+				WithClock(func() time.Time { return tt.now }))
+
+			testItem := newActiveItem(t, "Seasonal", 20)
+			name := "Beach Umbrella"
+			mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+			mockRepo.On("Update", mock.Anything, writtenWith(tt.wantStatus, tt.statusEvents)).Return(nil)
+
+			result, err := useCase.UpdateItem(context.Background(), testItem.ID().String(), &dto.UpdateItemRequest{Name: &name})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus.String(), result.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemUseCase_ReserveInventory(t *testing.T) {
+	t.Run("successful reservation", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventory(10)
+		testItem.SetInventory(inventory)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockInventory.On("ReserveInventory", mock.Anything, itemID.String(), 4).Return(nil)
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(itm *item.Item) bool {
+			return itm.Inventory().Reserved() == 4
+		})).Return(nil)
+
+		result, err := useCase.ReserveInventory(context.Background(), itemID.String(), &dto.InventoryReservationRequest{Quantity: 4})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, result.Inventory.Quantity)
+		assert.Equal(t, 4, result.Inventory.Reserved)
+		assert.Equal(t, 6, result.Inventory.Available)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("reserve beyond available", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventoryWithReserved(10, 8)
+		testItem.SetInventory(inventory)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		result, err := useCase.ReserveInventory(context.Background(), itemID.String(), &dto.InventoryReservationRequest{Quantity: 3})
+
+		assert.ErrorIs(t, err, ErrInventoryConflict)
+		assert.Nil(t, result)
+		mockInventory.AssertNotCalled(t, "ReserveInventory", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("persist failure releases the external hold", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventory(10)
+		testItem.SetInventory(inventory)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockInventory.On("ReserveInventory", mock.Anything, itemID.String(), 2).Return(nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(errors.New("database error"))
+		mockInventory.On("ReleaseInventory", mock.Anything, itemID.String(), 2).Return(nil)
+
+		result, err := useCase.ReserveInventory(context.Background(), itemID.String(), &dto.InventoryReservationRequest{Quantity: 2})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockInventory.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_ReleaseInventory(t *testing.T) {
+	t.Run("successful release", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventoryWithReserved(10, 4)
+		testItem.SetInventory(inventory)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, itemID.String(), 3).Return(nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.ReleaseInventory(context.Background(), itemID.String(), &dto.InventoryReservationRequest{Quantity: 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Inventory.Reserved)
+		assert.Equal(t, 9, result.Inventory.Available)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("release beyond reserved", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventoryWithReserved(10, 1)
+		testItem.SetInventory(inventory)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		result, err := useCase.ReleaseInventory(context.Background(), itemID.String(), &dto.InventoryReservationRequest{Quantity: 2})
+
+		assert.ErrorIs(t, err, ErrInventoryConflict)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_CheckAvailability(t *testing.T) {
+	// 10 on hand with 4 reserved leaves 6 that can be reserved
+	tests := []struct {
+		name          string
+		quantity      int
+		wantAvailable bool
+	}{
+		{name: "below available stock", quantity: 5, wantAvailable: true},
+		{name: "equal to available stock", quantity: 6, wantAvailable: true},
+		{name: "above available stock", quantity: 7, wantAvailable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockItemRepository{}
+			useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+			testItem := createTestItem(t)
+			inventory, _ := item.NewInventoryWithReserved(10, 4)
+			testItem.SetInventory(inventory)
+			mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+
+			result, err := useCase.CheckAvailability(context.Background(), testItem.ID().String(), tt.quantity)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAvailable, result.Available)
+			assert.Equal(t, 10, result.OnHand)
+			assert.Equal(t, 6, result.AvailableQuantity)
+			mockRepo.AssertExpectations(t)
+			mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("item not found", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		itemID := item.NewItemID()
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+
+		result, err := useCase.CheckAvailability(context.Background(), itemID.String(), 1)
+
+		assert.ErrorIs(t, err, item.ErrItemNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestItemUseCase_UpdateInventoryBulk(t *testing.T) {
+	newItemWithSKU := func(t *testing.T, raw string) *item.Item {
+		t.Helper()
+		sku, err := item.NewSKU(raw)
+		require.NoError(t, err)
+		price, err := item.NewPrice(10, "USD")
+		require.NoError(t, err)
+		category, err := item.NewCategory("Electronics")
+		require.NoError(t, err)
+		itm, err := item.NewItem(sku, "Bulk Item", "", price, category)
+		require.NoError(t, err)
+		return itm
+	}
+
+	t.Run("unknown SKU does not stop the others", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		first := newItemWithSKU(t, "BULK-001")
+		third := newItemWithSKU(t, "BULK-003")
+		missing, _ := item.NewSKU("BULK-404")
+
+		mockRepo.On("FindBySKU", mock.Anything, first.SKU()).Return(first, nil)
+		mockRepo.On("FindBySKU", mock.Anything, missing).Return(nil, item.ErrItemNotFound)
+		mockRepo.On("FindBySKU", mock.Anything, third.SKU()).Return(third, nil)
+		mockRepo.On("UpdateAll", mock.Anything, []*item.Item{first, third}).Return([]error{nil, nil}, nil)
+		// The third item already has no stock, so only the first moves in the inventory service
+		mockInventory.On("ReserveInventory", mock.Anything, first.ID().String(), 25).Return(nil)
+
+		result, err := useCase.UpdateInventoryBulk(context.Background(), []dto.InventoryUpdate{
+			{SKU: "bulk-001", Quantity: 25},
+			{SKU: "BULK-404", Quantity: 5},
+			{SKU: "BULK-003", Quantity: 0},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 2, result.Updated)
+		assert.Equal(t, 1, result.Failed)
+
+		assert.Equal(t, dto.BulkItemUpdated, result.Results[0].Status)
+		assert.Equal(t, 25, result.Results[0].Item.Inventory.Quantity)
+		assert.Equal(t, dto.BulkItemFailed, result.Results[1].Status)
+		assert.Equal(t, "BULK-404", result.Results[1].SKU)
+		assert.Contains(t, result.Results[1].Error, "item not found")
+		assert.Nil(t, result.Results[1].Item)
+		assert.Equal(t, dto.BulkItemUpdated, result.Results[2].Status)
+		assert.Equal(t, 0, result.Results[2].Item.Inventory.Quantity)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("invalid quantities and duplicates fail before any lookup", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		result, err := useCase.UpdateInventoryBulk(context.Background(), []dto.InventoryUpdate{
+			{SKU: "BULK-001", Quantity: -1},
+			{SKU: "BULK-002", Quantity: 1000000},
+			{SKU: "BULK-002", Quantity: 3},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Updated)
+		assert.Equal(t, 3, result.Failed)
+		assert.Contains(t, result.Results[0].Error, "cannot be negative")
+		assert.Contains(t, result.Results[1].Error, "too high")
+		assert.Contains(t, result.Results[2].Error, "duplicate SKU")
+		mockRepo.AssertNotCalled(t, "FindBySKU", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "UpdateAll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed row is reported per SKU and rolled back in the inventory service", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		first := newItemWithSKU(t, "BULK-001")
+		second := newItemWithSKU(t, "BULK-002")
+		mockRepo.On("FindBySKU", mock.Anything, first.SKU()).Return(first, nil)
+		mockRepo.On("FindBySKU", mock.Anything, second.SKU()).Return(second, nil)
+		mockRepo.On("UpdateAll", mock.Anything, []*item.Item{first, second}).
+			Return([]error{nil, item.ItemNotFoundError(second.ID())}, nil)
+		mockInventory.On("ReserveInventory", mock.Anything, first.ID().String(), 4).Return(nil)
+		mockInventory.On("ReserveInventory", mock.Anything, second.ID().String(), 4).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, second.ID().String(), 4).Return(nil)
+
+		result, err := useCase.UpdateInventoryBulk(context.Background(), []dto.InventoryUpdate{
+			{SKU: "BULK-001", Quantity: 4},
+			{SKU: "BULK-002", Quantity: 4},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Updated)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, dto.BulkItemFailed, result.Results[1].Status)
+		assert.Contains(t, result.Results[1].Error, "failed to save item")
+		mockInventory.AssertExpectations(t)
+		mockInventory.AssertNotCalled(t, "ReleaseInventory", mock.Anything, first.ID().String(), mock.Anything)
+	})
+
+	t.Run("inventory service refusal fails only that row", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		first := newItemWithSKU(t, "BULK-001")
+		second := newItemWithSKU(t, "BULK-002")
+		mockRepo.On("FindBySKU", mock.Anything, first.SKU()).Return(first, nil)
+		mockRepo.On("FindBySKU", mock.Anything, second.SKU()).Return(second, nil)
+		mockRepo.On("UpdateAll", mock.Anything, []*item.Item{second}).Return([]error{nil}, nil)
+		mockInventory.On("ReserveInventory", mock.Anything, first.ID().String(), 4).Return(errors.New("warehouse unavailable"))
+		mockInventory.On("ReserveInventory", mock.Anything, second.ID().String(), 6).Return(nil)
+
+		result, err := useCase.UpdateInventoryBulk(context.Background(), []dto.InventoryUpdate{
+			{SKU: "BULK-001", Quantity: 4},
+			{SKU: "BULK-002", Quantity: 6},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Updated)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, dto.BulkItemFailed, result.Results[0].Status)
+		assert.Contains(t, result.Results[0].Error, "failed to update inventory")
+		assert.Equal(t, 0, first.Inventory().Quantity())
+		assert.Equal(t, dto.BulkItemUpdated, result.Results[1].Status)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("transaction error rolls back every row in the inventory service", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		first := newItemWithSKU(t, "BULK-001")
+		mockRepo.On("FindBySKU", mock.Anything, first.SKU()).Return(first, nil)
+		mockRepo.On("UpdateAll", mock.Anything, mock.Anything).Return(nil, errors.New("connection reset"))
+		mockInventory.On("ReserveInventory", mock.Anything, first.ID().String(), 4).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, first.ID().String(), 4).Return(nil)
+
+		_, err := useCase.UpdateInventoryBulk(context.Background(), []dto.InventoryUpdate{{SKU: "BULK-001", Quantity: 4}})
+
+		assert.ErrorContains(t, err, "failed to save items")
+		mockInventory.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_UpdateInventoryKeepsReservations(t *testing.T) {
+	newReservedUseCase := func(t *testing.T) (ItemUseCase, *MockItemRepository, *MockInventoryService, *item.Item) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		useCase := NewItemUseCase(mockRepo, mockInventory, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		inventory, _ := item.NewInventoryWithReserved(10, 6)
+		testItem.SetInventory(inventory)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		return useCase, mockRepo, mockInventory, testItem
+	}
+
+	t.Run("reducing below reserved is rejected", func(t *testing.T) {
+		useCase, mockRepo, mockInventory, testItem := newReservedUseCase(t)
+
+		result, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: 5})
+
+		assert.ErrorIs(t, err, ErrInventoryConflict)
+		assert.ErrorContains(t, err, "reserved quantity cannot exceed inventory quantity")
+		assert.Nil(t, result)
+		assert.Equal(t, 10, testItem.Inventory().Quantity())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockInventory.AssertNotCalled(t, "ReleaseInventory", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	for _, quantity := range []int{8, 6} {
+		t.Run(fmt.Sprintf("reducing to %d with 6 reserved is allowed", quantity), func(t *testing.T) {
+			useCase, mockRepo, mockInventory, testItem := newReservedUseCase(t)
+			mockInventory.On("ReleaseInventory", mock.Anything, testItem.ID().String(), 10-quantity).Return(nil)
+			mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+			result, err := useCase.UpdateInventory(context.Background(), testItem.ID().String(), &dto.UpdateInventoryRequest{Quantity: quantity})
+
+			require.NoError(t, err)
+			assert.Equal(t, quantity, result.Inventory.Quantity)
+			assert.Equal(t, 6, result.Inventory.Reserved)
+			assert.Equal(t, quantity-6, result.Inventory.Available)
+			mockRepo.AssertExpectations(t)
+			mockInventory.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemUseCase_RemoveAttribute(t *testing.T) {
+	newItem := func(t *testing.T) *item.Item {
+		testItem := createTestItem(t)
+		attrs := testItem.Attributes()
+		require.NoError(t, attrs.Set("color", "red"))
+		require.NoError(t, attrs.Set("size", "L"))
+		testItem.SetAttributes(attrs)
+		return testItem
+	}
+
+	t.Run("removes and saves", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(itm *item.Item) bool {
+			_, exists := itm.Attributes().Get("color")
+			return !exists
+		})).Return(nil)
+
+		result, err := useCase.RemoveAttribute(context.Background(), testItem.ID().String(), "color")
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.Attributes{"size": "L"}, result.Attributes)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing key returns the item without saving", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+
+		result, err := useCase.RemoveAttribute(context.Background(), testItem.ID().String(), "weight")
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.Attributes{"color": "red", "size": "L"}, result.Attributes)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		itemID := item.NewItemID()
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+
+		_, err := useCase.RemoveAttribute(context.Background(), itemID.String(), "color")
+
+		assert.ErrorIs(t, err, item.ErrItemNotFound)
+	})
+}
+
+func TestItemUseCase_RemoveImage(t *testing.T) {
+	t.Run("successful removal", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		for _, url := range []string{"http://example.com/a.jpg", "http://example.com/b.jpg"} {
+			image, _ := item.NewImage(url, "", false)
+			testItem.AddImage(image)
+		}
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.RemoveImage(context.Background(), itemID.String(), "http://example.com/a.jpg")
+
+		require.NoError(t, err)
+		require.Len(t, result.Images, 1)
+		assert.Equal(t, "http://example.com/b.jpg", result.Images[0].URL)
+		assert.True(t, result.Images[0].IsPrimary)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("nonexistent image", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		result, err := useCase.RemoveImage(context.Background(), itemID.String(), "http://example.com/missing.jpg")
+
+		assert.ErrorIs(t, err, ErrImageNotFound)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_RemoveImageAt(t *testing.T) {
+	newItem := func(t *testing.T) *item.Item {
+		testItem := createTestItem(t)
+		for _, url := range []string{"http://example.com/a.jpg", "http://example.com/b.jpg"} {
+			image, _ := item.NewImage(url, "", false)
+			testItem.AddImage(image)
+		}
+		return testItem
+	}
+
+	t.Run("removing the first image promotes the next", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		result, err := useCase.RemoveImageAt(context.Background(), testItem.ID().String(), 0)
+
+		require.NoError(t, err)
+		require.Len(t, result.Images, 1)
+		assert.Equal(t, "http://example.com/b.jpg", result.Images[0].URL)
+		assert.True(t, result.Images[0].IsPrimary)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("out-of-range index", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+
+		result, err := useCase.RemoveImageAt(context.Background(), testItem.ID().String(), 2)
+
+		assert.ErrorIs(t, err, ErrInvalidImageIndex)
+		assert.ErrorContains(t, err, "image index 2 is out of range for 2 images")
+		assert.Nil(t, result)
+		assert.Len(t, testItem.Images(), 2)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+// fakeBlobStore keeps uploads in memory and records their content types
+type fakeBlobStore struct {
+	objects      map[string][]byte
+	contentTypes map[string]string
+}
+
+func newFakeBlobStore() *fakeBlobStore {
+	return &fakeBlobStore{objects: map[string][]byte{}, contentTypes: map[string]string{}}
+}
+
+func (s *fakeBlobStore) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	s.objects[key] = data
+	s.contentTypes[key] = contentType
+	return "https://cdn.example.com/" + key, nil
+}
+
+func (s *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+// testPNG is a 1x1 PNG, enough for content sniffing
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00\x1f\x15\xc4\x89")
+
+func TestItemUseCase_UploadImage(t *testing.T) {
+	newUploadUseCase := func(mockRepo *MockItemRepository, store *fakeBlobStore) ItemUseCase {
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+			WithUploadOptions(UploadOptions{Store: store, MaxSize: 1024}))
+	}
+
+	t.Run("valid PNG", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		store := newFakeBlobStore()
+		useCase := newUploadUseCase(mockRepo, store)
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.UploadImage(context.Background(), testItem.ID().String(), &dto.UploadImageRequest{
+			Filename: "photo.png",
+			Size:     int64(len(testPNG)),
+			Content:  bytes.NewReader(testPNG),
+			Alt:      "Front view",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, store.objects, 1)
+		for key, data := range store.objects {
+			assert.True(t, strings.HasPrefix(key, "items/"+testItem.ID().String()+"/"))
+			assert.True(t, strings.HasSuffix(key, ".png"))
+			assert.Equal(t, testPNG, data)
+			assert.Equal(t, "image/png", store.contentTypes[key])
+			require.Len(t, result.Images, 1)
+			assert.Equal(t, "https://cdn.example.com/"+key, result.Images[0].URL)
+		}
+		assert.Equal(t, "Front view", result.Images[0].Alt)
+		assert.True(t, result.Images[0].IsPrimary)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("executable renamed to png is rejected", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		store := newFakeBlobStore()
+		useCase := newUploadUseCase(mockRepo, store)
+
+		exe := append([]byte("MZ\x90\x00\x03\x00\x00\x00"), make([]byte, 64)...)
+		result, err := useCase.UploadImage(context.Background(), createTestItem(t).ID().String(), &dto.UploadImageRequest{
+			Filename: "setup.exe",
+			Size:     int64(len(exe)),
+			Content:  bytes.NewReader(exe),
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidUpload)
+		assert.Nil(t, result)
+		assert.Empty(t, store.objects)
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("oversized upload is rejected", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		store := newFakeBlobStore()
+		useCase := newUploadUseCase(mockRepo, store)
+
+		// The declared size understates the body, so the read limit has to catch it
+		large := append(append([]byte{}, testPNG...), make([]byte, 2048)...)
+		_, err := useCase.UploadImage(context.Background(), createTestItem(t).ID().String(), &dto.UploadImageRequest{
+			Filename: "large.png",
+			Size:     int64(len(testPNG)),
+			Content:  bytes.NewReader(large),
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidUpload)
+		assert.Empty(t, store.objects)
+	})
+
+	t.Run("failed update removes the stored blob", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		store := newFakeBlobStore()
+		useCase := newUploadUseCase(mockRepo, store)
+
+		testItem := createTestItem(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(errors.New("connection reset"))
+
+		_, err := useCase.UploadImage(context.Background(), testItem.ID().String(), &dto.UploadImageRequest{
+			Filename: "photo.png",
+			Size:     int64(len(testPNG)),
+			Content:  bytes.NewReader(testPNG),
+		})
+
+		assert.Error(t, err)
+		assert.Empty(t, store.objects)
+	})
+}
+
+func TestItemUseCase_ReorderImages(t *testing.T) {
+	newItemWithImages := func(t *testing.T) *item.Item {
+		testItem := createTestItem(t)
+		for _, url := range []string{"http://example.com/a.jpg", "http://example.com/b.jpg"} {
+			image, _ := item.NewImage(url, "", false)
+			testItem.AddImage(image)
+		}
+		return testItem
+	}
+
+	t.Run("successful reorder", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := newItemWithImages(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		req := &dto.ReorderImagesRequest{URLs: []string{"http://example.com/b.jpg", "http://example.com/a.jpg"}}
+		result, err := useCase.ReorderImages(context.Background(), itemID.String(), req)
+
+		require.NoError(t, err)
+		require.Len(t, result.Images, 2)
+		assert.Equal(t, "http://example.com/b.jpg", result.Images[0].URL)
+		assert.Equal(t, "http://example.com/a.jpg", result.Images[1].URL)
+		assert.True(t, result.Images[1].IsPrimary)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing URL", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := newItemWithImages(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		req := &dto.ReorderImagesRequest{URLs: []string{"http://example.com/b.jpg"}}
+		result, err := useCase.ReorderImages(context.Background(), itemID.String(), req)
+
+		assert.ErrorIs(t, err, ErrInvalidImageOrder)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_ReplaceImages(t *testing.T) {
+	newItemWithImage := func(t *testing.T) *item.Item {
+		testItem := createTestItem(t)
+		image, _ := item.NewImage("http://example.com/old.jpg", "", true)
+		testItem.AddImage(image)
+		return testItem
+	}
+
+	t.Run("no primary promotes the first image", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := newItemWithImage(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(updated *item.Item) bool {
+			return len(updated.Images()) == 2
+		})).Return(nil)
+
+		req := &dto.ReplaceImagesRequest{Images: []dto.AddImageRequest{
+			{URL: "http://example.com/a.jpg", Alt: "Front"},
+			{URL: "http://example.com/b.jpg", Alt: "Back"},
+		}}
+		result, err := useCase.ReplaceImages(context.Background(), itemID.String(), req)
+
+		require.NoError(t, err)
+		require.Len(t, result.Images, 2)
+		assert.Equal(t, "http://example.com/a.jpg", result.Images[0].URL)
+		assert.True(t, result.Images[0].IsPrimary)
+		assert.False(t, result.Images[1].IsPrimary)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("two primaries are rejected", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := newItemWithImage(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		req := &dto.ReplaceImagesRequest{Images: []dto.AddImageRequest{
+			{URL: "http://example.com/a.jpg", IsPrimary: true},
+			{URL: "http://example.com/b.jpg", IsPrimary: true},
+		}}
+		result, err := useCase.ReplaceImages(context.Background(), itemID.String(), req)
+
+		assert.ErrorIs(t, err, ErrInvalidImages)
+		assert.Nil(t, result)
+		require.Len(t, testItem.Images(), 1)
+		assert.Equal(t, "http://example.com/old.jpg", testItem.Images()[0].URL())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_ActivateItem(t *testing.T) {
+	t.Run("draft item is activated", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		stock, err := item.NewInventory(5)
+		require.NoError(t, err)
+		testItem.SetInventory(stock)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.ActivateItem(context.Background(), itemID.String())
+
+		require.NoError(t, err)
+		assert.True(t, result.Changed)
+		assert.Equal(t, "active", result.Status)
+		assert.Equal(t, item.StatusActive, testItem.Status())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("active item is a no-op without an update or event", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+
+		var received []item.DomainEvent
+		dispatcher := events.NewDispatcher()
+		dispatcher.Subscribe("ItemStatusChanged", events.HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+			received = append(received, event)
+			return nil
+		}))
+
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, dispatcher)
+
+		testItem := createTestItem(t)
+		stock, err := item.NewInventory(5)
+		require.NoError(t, err)
+		testItem.SetInventory(stock)
+		require.NoError(t, testItem.Activate())
+		testItem.PullEvents()
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		result, err := useCase.ActivateItem(context.Background(), itemID.String())
+
+		require.NoError(t, err)
+		assert.False(t, result.Changed)
+		assert.Equal(t, itemID.String(), result.ID)
+		assert.Equal(t, "active", result.Status)
+		assert.Empty(t, received)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("item without stock is refused", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		// Saving it active would only archive it again for having no stock
+		testItem := createTestItem(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		_, err := useCase.ActivateItem(context.Background(), itemID.String())
+
+		assert.ErrorIs(t, err, item.ErrOutOfStock)
+		assert.Equal(t, item.StatusDraft, testItem.Status())
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("archived item cannot be activated", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusArchived))
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		_, err := useCase.ActivateItem(context.Background(), itemID.String())
+
+		var transitionErr *item.StatusTransitionError
+		require.ErrorAs(t, err, &transitionErr)
+		assert.Equal(t, item.StatusArchived, transitionErr.From)
+		assert.Equal(t, item.StatusActive, transitionErr.To)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_UnarchiveItem(t *testing.T) {
+	newUseCase := func(mockRepo *MockItemRepository) ItemUseCase {
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+	}
+
+	t.Run("zero-inventory item lands in draft and cannot be activated until restocked", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := newUseCase(mockRepo)
+
+		// Archived for running out of stock
+		testItem := createTestItem(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusArchived))
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		resp, err := useCase.UnarchiveItem(context.Background(), itemID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, "draft", resp.Status)
+		assert.Equal(t, item.StatusDraft, testItem.Status())
+		mockRepo.AssertNumberOfCalls(t, "Update", 1)
+
+		_, err = useCase.ActivateItem(context.Background(), itemID.String())
+
+		assert.ErrorIs(t, err, item.ErrOutOfStock)
+		assert.Equal(t, item.StatusDraft, testItem.Status())
+		mockRepo.AssertNumberOfCalls(t, "Update", 1)
+
+		stock, err := item.NewInventory(10)
+		require.NoError(t, err)
+		testItem.SetInventory(stock)
+
+		_, err = useCase.ActivateItem(context.Background(), itemID.String())
+		require.NoError(t, err)
+		assert.Equal(t, item.StatusActive, testItem.Status())
+		mockRepo.AssertNumberOfCalls(t, "Update", 2)
+	})
+
+	t.Run("item that is not archived is refused", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := newUseCase(mockRepo)
+
+		testItem := createTestItem(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		resp, err := useCase.UnarchiveItem(context.Background(), itemID.String())
+
+		assert.Nil(t, resp)
+		var transitionErr *item.StatusTransitionError
+		require.ErrorAs(t, err, &transitionErr)
+		assert.Equal(t, item.StatusDraft, transitionErr.From)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing item", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := newUseCase(mockRepo)
+
+		itemID := item.NewItemID()
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+
+		_, err := useCase.UnarchiveItem(context.Background(), itemID.String())
+
+		assert.ErrorIs(t, err, item.ErrItemNotFound)
+	})
+}
+
+func TestItemUseCase_DeactivateItem(t *testing.T) {
+	newUseCase := func(mockRepo *MockItemRepository) ItemUseCase {
+		return NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+	}
+
+	newActiveItem := func(t *testing.T) *item.Item {
+		testItem := createTestItem(t)
+		stock, err := item.NewInventory(5)
+		require.NoError(t, err)
+		testItem.SetInventory(stock)
+		require.NoError(t, testItem.Activate())
+		return testItem
+	}
+
+	t.Run("active item is deactivated", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := newUseCase(mockRepo)
+
+		testItem := newActiveItem(t)
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+		mockRepo.On("Update", mock.Anything, testItem).Return(nil)
+
+		result, err := useCase.DeactivateItem(context.Background(), itemID.String())
+
+		require.NoError(t, err)
+		assert.True(t, result.Changed)
+		assert.Equal(t, "inactive", result.Status)
+		mockRepo.AssertNumberOfCalls(t, "Update", 1)
+	})
+
+	t.Run("inactive item is a no-op without an update", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := newUseCase(mockRepo)
+
+		testItem := newActiveItem(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusInactive))
+		itemID := testItem.ID()
+
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		result, err := useCase.DeactivateItem(context.Background(), itemID.String())
+
+		require.NoError(t, err)
+		assert.False(t, result.Changed)
+		assert.Equal(t, "inactive", result.Status)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("draft item is refused", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := newUseCase(mockRepo)
+
+		// Drafts were never published, so there is nothing to deactivate
+		testItem := createTestItem(t)
 		itemID := testItem.ID()
 
-		req := &dto.UpdateInventoryRequest{
-			Quantity: 50,
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
+
+		_, err := useCase.DeactivateItem(context.Background(), itemID.String())
+
+		var transitionErr *item.StatusTransitionError
+		assert.ErrorAs(t, err, &transitionErr)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_UpsertItem(t *testing.T) {
+	newUpsertUseCase := func() (ItemUseCase, *MockItemRepository, *MockInventoryService, *[]item.DomainEvent) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		var received []item.DomainEvent
+		dispatcher := events.NewDispatcher()
+		dispatcher.Subscribe("ItemCreated", events.HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+			received = append(received, event)
+			return nil
+		}))
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 25.0, "toys").Return(25.0, nil)
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, dispatcher)
+		return useCase, mockRepo, mockInventory, &received
+	}
+
+	req := func() *dto.CreateItemRequest {
+		return &dto.CreateItemRequest{SKU: "SYNC-001", Name: "Synced Item", Price: 25, Category: "toys", Inventory: 10}
+	}
+
+	// storedItem is an inactive item already stored under SYNC-001 with 20 in stock, 5 of them reserved
+	storedItem := func(t *testing.T) *item.Item {
+		t.Helper()
+		sku, err := item.NewSKU("SYNC-001")
+		require.NoError(t, err)
+		price, err := item.NewPrice(20, "USD")
+		require.NoError(t, err)
+		category, err := item.NewCategory("Electronics")
+		require.NoError(t, err)
+		stored, err := item.NewItem(sku, "Old Name", "Old Description", price, category)
+		require.NoError(t, err)
+		inventory, err := item.NewInventoryWithReserved(20, 5)
+		require.NoError(t, err)
+		stored.SetInventory(inventory)
+		require.NoError(t, stored.TransitionTo(item.StatusActive))
+		require.NoError(t, stored.TransitionTo(item.StatusInactive))
+		stored.PullEvents()
+		return stored
+	}
+
+	t.Run("new SKU is created", func(t *testing.T) {
+		useCase, mockRepo, mockInventory, received := newUpsertUseCase()
+
+		sku, _ := item.NewSKU("SYNC-001")
+		mockRepo.On("FindBySKU", mock.Anything, sku).Return(nil, item.ItemNotFoundBySKUError(sku))
+		mockRepo.On("ExistsBySKU", mock.Anything, sku).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 10).Return(nil)
+
+		result, err := useCase.UpsertItem(context.Background(), req())
+
+		require.NoError(t, err)
+		assert.Equal(t, "SYNC-001", result.SKU)
+		assert.Len(t, *received, 1)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("losing the race to create the SKU updates the winner's item", func(t *testing.T) {
+		useCase, mockRepo, mockInventory, received := newUpsertUseCase()
+
+		stored := storedItem(t)
+		mockRepo.On("FindBySKU", mock.Anything, stored.SKU()).Return(nil, item.ItemNotFoundBySKUError(stored.SKU())).Once()
+		mockRepo.On("ExistsBySKU", mock.Anything, stored.SKU()).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(item.DuplicateSKUError(stored.SKU()))
+		mockRepo.On("FindBySKU", mock.Anything, stored.SKU()).Return(stored, nil).Once()
+		mockRepo.On("Update", mock.Anything, stored).Return(nil)
+		// The opening stock is reserved and rolled back, then the winner's stock is moved from 20 to 10
+		mockInventory.On("ReserveInventory", mock.Anything, mock.AnythingOfType("string"), 10).Return(nil).Once()
+		mockInventory.On("ReleaseInventory", mock.Anything, mock.AnythingOfType("string"), 10).Return(nil).Twice()
+
+		result, err := useCase.UpsertItem(context.Background(), req())
+
+		require.NoError(t, err)
+		assert.Equal(t, stored.ID().String(), result.ID)
+		assert.Equal(t, "Synced Item", result.Name)
+		assert.Empty(t, *received)
+		mockRepo.AssertExpectations(t)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("taken SKU updates the stored item", func(t *testing.T) {
+		useCase, mockRepo, mockInventory, received := newUpsertUseCase()
+
+		stored := storedItem(t)
+		mockRepo.On("FindBySKU", mock.Anything, stored.SKU()).Return(stored, nil)
+		mockRepo.On("Update", mock.Anything, stored).Return(nil)
+		mockInventory.On("ReleaseInventory", mock.Anything, stored.ID().String(), 10).Return(nil)
+
+		result, err := useCase.UpsertItem(context.Background(), req())
+
+		require.NoError(t, err)
+		assert.Equal(t, stored.ID().String(), result.ID)
+		assert.Equal(t, "Synced Item", result.Name)
+		assert.Equal(t, 25.0, result.Price)
+		assert.Equal(t, "toys", result.Category.Name)
+		// Status and reservations are the stored item's; only the stock level moves
+		assert.Equal(t, item.StatusInactive.String(), result.Status)
+		assert.Equal(t, 10, result.Inventory.Quantity)
+		assert.Equal(t, 5, result.Inventory.Reserved)
+		assert.Empty(t, *received)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		mockInventory.AssertExpectations(t)
+	})
+
+	t.Run("stock below the reservations is a conflict", func(t *testing.T) {
+		useCase, mockRepo, mockInventory, _ := newUpsertUseCase()
+
+		stored := storedItem(t)
+		mockRepo.On("FindBySKU", mock.Anything, stored.SKU()).Return(stored, nil)
+
+		conflicting := req()
+		conflicting.Inventory = 3
+		_, err := useCase.UpsertItem(context.Background(), conflicting)
+
+		assert.ErrorIs(t, err, ErrInventoryConflict)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockInventory.AssertNotCalled(t, "ReleaseInventory", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository failure rolls back the inventory service", func(t *testing.T) {
+		useCase, mockRepo, mockInventory, _ := newUpsertUseCase()
+
+		stored := storedItem(t)
+		mockRepo.On("FindBySKU", mock.Anything, stored.SKU()).Return(stored, nil)
+		mockRepo.On("Update", mock.Anything, stored).Return(errors.New("connection refused"))
+		mockInventory.On("ReleaseInventory", mock.Anything, stored.ID().String(), 10).Return(nil)
+		mockInventory.On("ReserveInventory", mock.Anything, stored.ID().String(), 10).Return(nil)
+
+		_, err := useCase.UpsertItem(context.Background(), req())
+
+		assert.ErrorContains(t, err, "failed to update item")
+		mockInventory.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_CreateItemsBulk(t *testing.T) {
+	newBulkRequests := func() []*dto.CreateItemRequest {
+		return []*dto.CreateItemRequest{
+			{SKU: "BULK-001", Name: "First Item", Price: 10, Category: "toys", Inventory: 10},
+			{SKU: "BULK-002", Name: "Duplicate Item", Price: 20, Category: "toys", Inventory: 10},
+			{SKU: "BULK-003", Name: "Third Item", Price: 30, Category: "toys", Inventory: 10},
+		}
+	}
+
+	// newBulkUseCase wires mocks where the repository rejects BULK-002 as an existing SKU
+	newBulkUseCase := func(allOrNothing bool) (ItemUseCase, *MockItemRepository) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		mockCategory.On("ValidateCategory", mock.Anything, "toys").Return(nil)
+		for _, price := range []float64{10, 20, 30} {
+			mockPricing.On("CalculatePrice", mock.Anything, price, "toys").Return(price, nil)
+		}
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher(),
+			WithBulkOptions(BulkOptions{AllOrNothing: allOrNothing}))
+		return useCase, mockRepo
+	}
+
+	duplicateErr := errors.New("duplicate key value violates unique constraint \"items_sku_key\"")
+
+	t.Run("best effort creates the valid items", func(t *testing.T) {
+		useCase, mockRepo := newBulkUseCase(false)
+		mockRepo.On("SaveAll", mock.Anything, mock.AnythingOfType("[]*item.Item"), false).
+			Return([]error{nil, duplicateErr, nil}, nil)
+
+		result, err := useCase.CreateItemsBulk(context.Background(), newBulkRequests())
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, 0, result.RolledBack)
+		assert.False(t, result.AllOrNothing)
+
+		assert.Equal(t, dto.BulkItemCreated, result.Results[0].Status)
+		require.NotNil(t, result.Results[0].Item)
+		assert.Equal(t, "BULK-001", result.Results[0].Item.SKU)
+		assert.Equal(t, dto.BulkItemFailed, result.Results[1].Status)
+		assert.Contains(t, result.Results[1].Error, "items_sku_key")
+		assert.Nil(t, result.Results[1].Item)
+		assert.Equal(t, dto.BulkItemCreated, result.Results[2].Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("all or nothing rolls back every item", func(t *testing.T) {
+		useCase, mockRepo := newBulkUseCase(true)
+		mockRepo.On("SaveAll", mock.Anything, mock.AnythingOfType("[]*item.Item"), true).
+			Return([]error{nil, duplicateErr, nil}, errors.New("bulk insert rolled back"))
+
+		result, err := useCase.CreateItemsBulk(context.Background(), newBulkRequests())
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, 2, result.RolledBack)
+		assert.True(t, result.AllOrNothing)
+
+		assert.Equal(t, dto.BulkItemRolledBack, result.Results[0].Status)
+		assert.Nil(t, result.Results[0].Item)
+		assert.Equal(t, dto.BulkItemFailed, result.Results[1].Status)
+		assert.Equal(t, dto.BulkItemRolledBack, result.Results[2].Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate SKU within the request", func(t *testing.T) {
+		reqs := newBulkRequests()
+		reqs[1].SKU = "bulk-001"
+
+		t.Run("best effort", func(t *testing.T) {
+			useCase, mockRepo := newBulkUseCase(false)
+			mockRepo.On("SaveAll", mock.Anything, mock.MatchedBy(func(items []*item.Item) bool {
+				return len(items) == 2
+			}), false).Return([]error{nil, nil}, nil)
+
+			result, err := useCase.CreateItemsBulk(context.Background(), reqs)
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, result.Created)
+			assert.Equal(t, dto.BulkItemFailed, result.Results[1].Status)
+			assert.Contains(t, result.Results[1].Error, "first at index 0")
+			assert.Equal(t, dto.BulkItemCreated, result.Results[2].Status)
+			mockRepo.AssertExpectations(t)
+		})
+
+		t.Run("all or nothing skips the insert", func(t *testing.T) {
+			useCase, mockRepo := newBulkUseCase(true)
+
+			result, err := useCase.CreateItemsBulk(context.Background(), reqs)
+
+			require.NoError(t, err)
+			assert.Equal(t, 0, result.Created)
+			assert.Equal(t, 1, result.Failed)
+			assert.Equal(t, 2, result.RolledBack)
+			mockRepo.AssertNotCalled(t, "SaveAll", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("transaction failure in best effort mode", func(t *testing.T) {
+		useCase, mockRepo := newBulkUseCase(false)
+		mockRepo.On("SaveAll", mock.Anything, mock.AnythingOfType("[]*item.Item"), false).
+			Return(make([]error, 3), errors.New("failed to commit transaction"))
+
+		result, err := useCase.CreateItemsBulk(context.Background(), newBulkRequests())
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestItemUseCase_GetItemsByIDs(t *testing.T) {
+	t.Run("mix of existing and missing IDs", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		first := createTestItem(t)
+		second := createTestItem(t)
+		missing := item.NewItemID()
+
+		mockRepo.On("FindByIDs", mock.Anything, []item.ItemID{second.ID(), missing, first.ID()}).
+			Return([]*item.Item{first, second}, nil)
+
+		result, err := useCase.GetItemsByIDs(context.Background(), []string{
+			second.ID().String(), missing.String(), first.ID().String(), second.ID().String(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, second.ID().String(), result[0].ID)
+		assert.Equal(t, first.ID().String(), result[1].ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		result, err := useCase.GetItemsByIDs(context.Background(), []string{item.NewItemID().String(), "not-a-uuid"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "not-a-uuid")
+		mockRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no IDs", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		result, err := useCase.GetItemsByIDs(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		mockRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_SearchItems(t *testing.T) {
+	t.Run("search by query", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		req := &dto.SearchRequest{
+			Query:    "test",
+			Page:     1,
+			PageSize: 10,
+		}
+
+		mockRepo.On("CountBySearchRanked", mock.Anything, "test").Return(1, nil)
+		mockRepo.On("SearchRanked", mock.Anything, "test", 10, 0).Return([]*item.Item{testItem}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Len(t, result.Items, 1)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("search by query keeps the ranked order", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		titleMatch := createTestItem(t)
+		descriptionMatch := createTestItem(t)
+		mockRepo.On("CountBySearchRanked", mock.Anything, "headphones").Return(2, nil)
+		mockRepo.On("SearchRanked", mock.Anything, "headphones", 10, 0).Return([]*item.Item{titleMatch, descriptionMatch}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			Query: "headphones", SortBy: "price", SortOrder: "asc", Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, titleMatch.ID().String(), result.Items[0].ID)
+		assert.Equal(t, descriptionMatch.ID().String(), result.Items[1].ID)
+	})
+
+	t.Run("substring search when configured", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher(),
+			WithSearchOptions(SearchOptions{Substring: true}))
+
+		priceAsc := item.Sort{Field: item.SortByPrice, Order: item.SortAsc}
+		mockRepo.On("CountBySearch", mock.Anything, "test").Return(1, nil)
+		mockRepo.On("Search", mock.Anything, "test", priceAsc, 10, 0).Return([]*item.Item{createTestItem(t)}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			Query: "test", SortBy: "price", SortOrder: "asc", Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "SearchRanked", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("search by category", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		testItem := createTestItem(t)
+		req := &dto.SearchRequest{
+			Category: "electronics",
+			Page:     1,
+			PageSize: 10,
+		}
+
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(1, nil)
+		mockRepo.On("FindByCategory", mock.Anything, mock.AnythingOfType("item.Category"), item.DefaultSort(), 10, 0).Return([]*item.Item{testItem}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("search by attributes", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		redItem := createTestItem(t)
+		filters := map[string]string{"color": "red", "size": "M"}
+		req := &dto.SearchRequest{
+			Attributes: filters,
+			Page:       1,
+			PageSize:   10,
+		}
+
+		mockRepo.On("CountByAttributes", mock.Anything, filters).Return(1, nil)
+		mockRepo.On("FindByAttributes", mock.Anything, filters, item.DefaultSort(), 10, 0).Return([]*item.Item{redItem}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, 1, result.Total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockInventory := &MockInventoryService{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+		req := &dto.SearchRequest{
+			Query:    "test",
+			Page:     1,
+			PageSize: 10,
+		}
+
+		mockRepo.On("CountBySearchRanked", mock.Anything, "test").Return(1, nil)
+		mockRepo.On("SearchRanked", mock.Anything, "test", 10, 0).Return(nil, assert.AnError)
+
+		result, err := useCase.SearchItems(context.Background(), req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_SearchItemsByPriceRange(t *testing.T) {
+	newUseCase := func() (*MockItemRepository, ItemUseCase) {
+		mockRepo := &MockItemRepository{}
+		return mockRepo, NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+	}
+	price := func(v float64) *float64 { return &v }
+
+	t.Run("range includes some items", func(t *testing.T) {
+		mockRepo, useCase := newUseCase()
+		testItem := createTestItem(t)
+
+		mockRepo.On("CountByPriceRange", mock.Anything, 10.0, 50.0, "").Return(1, nil)
+		mockRepo.On("FindByPriceRange", mock.Anything, 10.0, 50.0, "", item.DefaultSort(), 10, 0).Return([]*item.Item{testItem}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			MinPrice: price(10), MaxPrice: price(50), Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, 1, result.Total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("range includes no items", func(t *testing.T) {
+		mockRepo, useCase := newUseCase()
+
+		mockRepo.On("CountByPriceRange", mock.Anything, 1000.0, 2000.0, "").Return(0, nil)
+		mockRepo.On("FindByPriceRange", mock.Anything, 1000.0, 2000.0, "", item.DefaultSort(), 10, 0).Return([]*item.Item{}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			MinPrice: price(1000), MaxPrice: price(2000), Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.Equal(t, 0, result.Total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing max leaves the range open", func(t *testing.T) {
+		mockRepo, useCase := newUseCase()
+
+		mockRepo.On("CountByPriceRange", mock.Anything, 25.0, math.Inf(1), "").Return(0, nil)
+		mockRepo.On("FindByPriceRange", mock.Anything, 25.0, math.Inf(1), "", item.DefaultSort(), 10, 0).Return([]*item.Item{}, nil)
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			MinPrice: price(25), Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("combined with category", func(t *testing.T) {
+		mockRepo, useCase := newUseCase()
+
+		mockRepo.On("CountByCategoryAndPriceRange", mock.Anything, mock.AnythingOfType("item.Category"), 0.0, 50.0, "USD").Return(0, nil)
+		mockRepo.On("FindByCategoryAndPriceRange", mock.Anything, mock.AnythingOfType("item.Category"), 0.0, 50.0, "USD", item.DefaultSort(), 10, 0).Return([]*item.Item{}, nil)
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			Category: "electronics", MaxPrice: price(50), Currency: "USD", Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("min greater than max is rejected", func(t *testing.T) {
+		mockRepo, useCase := newUseCase()
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			MinPrice: price(50), MaxPrice: price(10), Page: 1, PageSize: 10,
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidPriceRange)
+		mockRepo.AssertNotCalled(t, "FindByPriceRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("negative price is rejected", func(t *testing.T) {
+		_, useCase := newUseCase()
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			MinPrice: price(-1), Page: 1, PageSize: 10,
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidPriceRange)
+	})
+
+	t.Run("bound above the price ceiling is a field error", func(t *testing.T) {
+		mockRepo, useCase := newUseCase()
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			MaxPrice: price(1e300), Page: 1, PageSize: 10,
+		})
+
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "max_price", fieldErr.Field)
+		assert.Equal(t, "Must be at most 999999", fieldErr.Message)
+		assert.ErrorIs(t, err, ErrInvalidPriceRange)
+		mockRepo.AssertNotCalled(t, "CountByPriceRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_SearchItemsByAttributeRange(t *testing.T) {
+	t.Run("bounds are merged per attribute", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		ranges := []item.AttributeRange{
+			{Key: "capacity", Min: 20, Max: math.Inf(1)},
+			{Key: "weight", Min: 1, Max: 2.5},
+		}
+		mockRepo.On("CountByAttributeRanges", mock.Anything, ranges).Return(1, nil)
+		mockRepo.On("FindByAttributeRanges", mock.Anything, ranges, item.DefaultSort(), 10, 0).Return([]*item.Item{createTestItem(t)}, nil)
+
+		result, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			AttributeMin: map[string]float64{"weight": 1, "capacity": 20},
+			AttributeMax: map[string]float64{"weight": 2.5},
+			Page:         1,
+			PageSize:     10,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("inverted range is rejected", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			AttributeMin: map[string]float64{"weight": 3},
+			AttributeMax: map[string]float64{"weight": 1},
+			Page:         1,
+			PageSize:     10,
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "CountByAttributeRanges", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_SearchItemsSorting(t *testing.T) {
+	t.Run("sort is passed to the repository", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		priceAsc := item.Sort{Field: item.SortByPrice, Order: item.SortAsc}
+		mockRepo.On("CountAvailable", mock.Anything).Return(0, nil)
+		mockRepo.On("FindAvailableItems", mock.Anything, priceAsc, 10, 0).Return([]*item.Item{}, nil)
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			SortBy: "price", SortOrder: "asc", Page: 1, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown sort field is rejected", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.SearchItems(context.Background(), &dto.SearchRequest{
+			SortBy: "drop_table", Page: 1, PageSize: 10,
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidSort)
+		mockRepo.AssertNotCalled(t, "CountAvailable", mock.Anything)
+	})
+}
+
+func TestItemUseCase_Brand(t *testing.T) {
+	t.Run("create stores the brand", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		mockCategory := &MockCategoryService{}
+		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, mockCategory, mockPricing, events.NewDispatcher())
+
+		mockCategory.On("ValidateCategory", mock.Anything, "tools").Return(nil)
+		mockPricing.On("CalculatePrice", mock.Anything, 49.99, "tools").Return(49.99, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, mock.AnythingOfType("item.SKU")).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(itm *item.Item) bool {
+			return itm.Brand().Slug() == "acme-sons"
+		})).Return(nil)
+
+		result, err := useCase.CreateItem(context.Background(), &dto.CreateItemRequest{
+			SKU: "ANV-001", Brand: "Acme & Sons", Name: "Anvil", Price: 49.99, Currency: "USD", Category: "tools",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &dto.BrandResponse{Name: "Acme & Sons", Slug: "acme-sons"}, result.Brand)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("list by brand slug", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		branded := createTestItem(t)
+		brand, err := item.NewBrand("Acme & Sons")
+		require.NoError(t, err)
+		branded.SetBrand(brand)
+
+		mockRepo.On("CountByBrand", mock.Anything, "acme-sons").Return(11, nil)
+		mockRepo.On("FindByBrand", mock.Anything, "acme-sons", 10, 10).Return([]*item.Item{branded}, nil)
+
+		result, err := useCase.GetItemsByBrand(context.Background(), "acme-sons", 2, 10)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Acme & Sons", result.Items[0].Brand.Name)
+		assert.Equal(t, 11, result.Total)
+		assert.Equal(t, 2, result.TotalPages)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("a brand name is looked up by its slug", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("CountByBrand", mock.Anything, "acme-sons").Return(0, nil)
+		mockRepo.On("FindByBrand", mock.Anything, "acme-sons", 10, 0).Return([]*item.Item{}, nil)
+
+		_, err := useCase.GetItemsByBrand(context.Background(), "Acme & Sons", 1, 10)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("a brand without letters or digits is rejected", func(t *testing.T) {
+		useCase := NewItemUseCase(&MockItemRepository{}, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.GetItemsByBrand(context.Background(), "---", 1, 10)
+
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+func TestItemUseCase_ListItems(t *testing.T) {
+	t.Run("status and category are combined", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		category, err := item.NewCategory("Electronics")
+		require.NoError(t, err)
+		status := item.StatusActive
+		filter := item.ListFilter{Category: &category, Status: &status}
+		sort := item.Sort{Field: item.SortByPrice, Order: item.SortDesc}
+
+		mockRepo.On("CountByFilter", mock.Anything, filter).Return(25, nil)
+		mockRepo.On("FindByFilter", mock.Anything, filter, sort, 10, 20).Return([]*item.Item{createTestItem(t)}, nil)
+
+		result, err := useCase.ListItems(context.Background(), &dto.ListItemsRequest{
+			Category: "Electronics", Status: "active", SortBy: "price", Page: 3, PageSize: 10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, 25, result.Total)
+		assert.Equal(t, 3, result.Page)
+		assert.Equal(t, 3, result.TotalPages)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no filters lists every item newest first", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("CountByFilter", mock.Anything, item.ListFilter{}).Return(0, nil)
+		mockRepo.On("FindByFilter", mock.Anything, item.ListFilter{}, item.DefaultSort(), 10, 0).Return([]*item.Item{}, nil)
+
+		result, err := useCase.ListItems(context.Background(), &dto.ListItemsRequest{Page: 1, PageSize: 10})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("brand is matched by slug", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		filter := item.ListFilter{BrandSlug: "acme-sons"}
+		mockRepo.On("CountByFilter", mock.Anything, filter).Return(0, nil)
+		mockRepo.On("FindByFilter", mock.Anything, filter, item.DefaultSort(), 10, 0).Return([]*item.Item{}, nil)
+
+		_, err := useCase.ListItems(context.Background(), &dto.ListItemsRequest{Brand: "Acme & Sons", Page: 1, PageSize: 10})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid filters are rejected", func(t *testing.T) {
+		useCase := NewItemUseCase(&MockItemRepository{}, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.ListItems(context.Background(), &dto.ListItemsRequest{Status: "sold", Page: 1, PageSize: 10})
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+
+		_, err = useCase.ListItems(context.Background(), &dto.ListItemsRequest{SortBy: "sku", Page: 1, PageSize: 10})
+		assert.ErrorIs(t, err, ErrInvalidSort)
+	})
+}
+
+func TestItemUseCase_CloneItem(t *testing.T) {
+	newSource := func(t *testing.T) *item.Item {
+		source := createTestItem(t)
+		attrs := source.Attributes()
+		require.NoError(t, attrs.Set("color", "red"))
+		wattage, err := item.NumberAttribute(60)
+		require.NoError(t, err)
+		require.NoError(t, attrs.SetValue("wattage", wattage))
+		source.SetAttributes(attrs)
+		inventory, err := item.NewInventory(12)
+		require.NoError(t, err)
+		source.SetInventory(inventory)
+		require.NoError(t, source.TransitionTo(item.StatusActive))
+		return source
+	}
+
+	t.Run("copies the item under a new ID and SKU", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		source := newSource(t)
+		next, err := item.NewSKU("ELEC-000008")
+		require.NoError(t, err)
+
+		mockRepo.On("FindByID", mock.Anything, source.ID()).Return(source, nil)
+		mockRepo.On("CountByCategory", mock.Anything, source.Category()).Return(7, nil)
+		mockRepo.On("ExistsBySKU", mock.Anything, next).Return(false, nil)
+		mockRepo.On("Save", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+
+		result, err := useCase.CloneItem(context.Background(), source.ID().String())
+
+		require.NoError(t, err)
+		assert.NotEqual(t, source.ID().String(), result.ID)
+		assert.Equal(t, "ELEC-000008", result.SKU)
+		assert.Equal(t, dto.Attributes{"color": "red", "wattage": 60.0}, result.Attributes)
+		assert.Equal(t, source.Name(), result.Name)
+		assert.Equal(t, source.Price().Amount(), result.Price)
+		assert.Equal(t, "draft", result.Status)
+		assert.Equal(t, 0, result.Inventory.Quantity)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("archived items cannot be cloned", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		source := newSource(t)
+		require.NoError(t, source.TransitionTo(item.StatusArchived))
+		mockRepo.On("FindByID", mock.Anything, source.ID()).Return(source, nil)
+
+		_, err := useCase.CloneItem(context.Background(), source.ID().String())
+
+		assert.ErrorIs(t, err, ErrItemArchived)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_GetItemWithStats(t *testing.T) {
+	stored := createTestItem(t)
+
+	t.Run("item with views and ratings", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("GetItemsWithRelatedData", mock.Anything, []item.ItemID{stored.ID()}).
+			Return([]item.ItemWithStats{{Item: stored, ViewCount: 42, AverageRating: 4.5, RatingCount: 8}}, nil)
+
+		result, err := useCase.GetItemWithStats(context.Background(), stored.ID().String())
+
+		require.NoError(t, err)
+		assert.Equal(t, stored.ID().String(), result.ID)
+		assert.Equal(t, 42, result.ViewCount)
+		assert.Equal(t, 4.5, result.AverageRating)
+		assert.Equal(t, 8, result.RatingCount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("GetItemsWithRelatedData", mock.Anything, []item.ItemID{stored.ID()}).Return([]item.ItemWithStats{}, nil)
+
+		_, err := useCase.GetItemWithStats(context.Background(), stored.ID().String())
+
+		assert.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestItemUseCase_GetRelatedItems(t *testing.T) {
+	source := createTestItem(t)
+
+	t.Run("same-category items are returned", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		related := []*item.Item{createTestItem(t), createTestItem(t)}
+		mockRepo.On("FindByID", mock.Anything, source.ID()).Return(source, nil)
+		mockRepo.On("FindRelated", mock.Anything, source, 5).Return(related, nil)
+
+		result, err := useCase.GetRelatedItems(context.Background(), source.ID().String(), 5)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		for _, resp := range result.Items {
+			assert.NotEqual(t, source.ID().String(), resp.ID)
+			assert.Equal(t, source.Category().Slug(), resp.Category.Slug)
 		}
+		assert.Equal(t, 2, result.Total)
+		mockRepo.AssertExpectations(t)
+	})
 
-		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
-		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*item.Item")).Return(nil)
+	t.Run("unknown item", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
 
-		result, err := useCase.UpdateInventory(context.Background(), itemID.String(), req)
+		mockRepo.On("FindByID", mock.Anything, source.ID()).Return(nil, item.ItemNotFoundError(source.ID()))
 
-		assert.NoError(t, err)
-		assert.NotNil(t, result)
+		_, err := useCase.GetRelatedItems(context.Background(), source.ID().String(), 5)
+
+		assert.ErrorContains(t, err, "not found")
+		mockRepo.AssertNotCalled(t, "FindRelated", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("limit out of range", func(t *testing.T) {
+		useCase := NewItemUseCase(&MockItemRepository{}, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.GetRelatedItems(context.Background(), source.ID().String(), MaxRelatedItems+1)
+
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+func TestItemUseCase_GetPopularItems(t *testing.T) {
+	t.Run("items keep the repository's most viewed first order", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		most, fewer := createTestItem(t), createTestItem(t)
+		before := time.Now()
+		mockRepo.On("FindMostViewed", mock.Anything, mock.MatchedBy(func(since time.Time) bool {
+			return !since.Before(before.Add(-24*time.Hour)) && since.Before(time.Now().Add(-23*time.Hour))
+		}), 5).Return([]item.ItemViewCount{{Item: most, ViewCount: 30}, {Item: fewer, ViewCount: 4}}, nil)
+
+		result, err := useCase.GetPopularItems(context.Background(), 24*time.Hour, 5)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, most.ID().String(), result.Items[0].ID)
+		assert.Equal(t, 30, result.Items[0].ViewCount)
+		assert.Equal(t, fewer.ID().String(), result.Items[1].ID)
+		assert.Equal(t, 4, result.Items[1].ViewCount)
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("item not found", func(t *testing.T) {
+	t.Run("no views in the window", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
-		mockInventory := &MockInventoryService{}
-		mockCategory := &MockCategoryService{}
-		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		mockRepo.On("FindMostViewed", mock.Anything, mock.Anything, 10).Return(nil, nil)
 
-		itemID := item.NewItemID()
-		req := &dto.UpdateInventoryRequest{
-			Quantity: 50,
+		result, err := useCase.GetPopularItems(context.Background(), time.Hour, 10)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("limit or window out of range", func(t *testing.T) {
+		useCase := NewItemUseCase(&MockItemRepository{}, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.GetPopularItems(context.Background(), time.Hour, MaxPopularItems+1)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+
+		_, err = useCase.GetPopularItems(context.Background(), MaxPopularWindow+time.Hour, 10)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+
+		_, err = useCase.GetPopularItems(context.Background(), 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+func TestItemUseCase_ListItemSummaries(t *testing.T) {
+	t.Run("maps summaries and paging", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		status := item.StatusActive
+		filter := item.ListFilter{Status: &status}
+		summary := createTestItem(t).Summary()
+
+		mockRepo.On("CountByFilter", mock.Anything, filter).Return(11, nil)
+		mockRepo.On("FindSummariesByFilter", mock.Anything, filter, item.DefaultSort(), 10, 10).Return([]item.ItemSummary{summary}, nil)
+
+		result, err := useCase.ListItemSummaries(context.Background(), &dto.ListItemsRequest{Status: "active", Page: 2, PageSize: 10})
+
+		require.NoError(t, err)
+		assert.Equal(t, []dto.ItemSummaryResponse{{
+			ID:       summary.ID.String(),
+			SKU:      "TEST-001",
+			Name:     "Test Item",
+			Price:    99.99,
+			Currency: "USD",
+			Category: "electronics",
+			Status:   summary.Status.String(),
+			InStock:  false,
+		}}, result.Items)
+		assert.Equal(t, 11, result.Total)
+		assert.Equal(t, 2, result.TotalPages)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "FindByFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.ListItemSummaries(context.Background(), &dto.ListItemsRequest{Status: "sold", Page: 1, PageSize: 10})
+
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "CountByFilter", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_SuggestItems(t *testing.T) {
+	t.Run("prefix returns ranked summaries", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		price, err := item.NewPrice(19.99, "USD")
+		require.NoError(t, err)
+		category, err := item.NewCategory("Electronics")
+		require.NoError(t, err)
+		names := []string{"Tele", "Telescope", "Television Stand"}
+		matches := make([]*item.Item, len(names))
+		for i, name := range names {
+			sku, err := item.NewSKU(fmt.Sprintf("TEL-%d", i))
+			require.NoError(t, err)
+			matches[i], err = item.NewItem(sku, name, "", price, category)
+			require.NoError(t, err)
 		}
+		stock, err := item.NewInventory(3)
+		require.NoError(t, err)
+		matches[1].SetInventory(stock)
 
-		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
+		mockRepo.On("FindByNamePrefix", mock.Anything, "tel", 10).Return(matches, nil)
 
-		result, err := useCase.UpdateInventory(context.Background(), itemID.String(), req)
+		result, err := useCase.SuggestItems(context.Background(), "  tel ", 10)
 
-		assert.Error(t, err)
-		assert.Nil(t, result)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 3)
+		for i, name := range names {
+			assert.Equal(t, name, result.Items[i].Name)
+		}
+		assert.Equal(t, dto.ItemSummaryResponse{
+			ID:       matches[1].ID().String(),
+			SKU:      "TEL-1",
+			Name:     "Telescope",
+			Price:    19.99,
+			Currency: "USD",
+			Category: "electronics",
+			Status:   matches[1].Status().String(),
+			InStock:  true,
+		}, result.Items[1])
+		assert.False(t, result.Items[0].InStock)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("query too short or limit out of range", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		_, err := useCase.SuggestItems(context.Background(), "t", 10)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+
+		_, err = useCase.SuggestItems(context.Background(), " t ", 10)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+
+		_, err = useCase.SuggestItems(context.Background(), "tel", MaxSuggestions+1)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+
+		mockRepo.AssertNotCalled(t, "FindByNamePrefix", mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
-func TestItemUseCase_SearchItems(t *testing.T) {
-	t.Run("search by query", func(t *testing.T) {
+func TestItemUseCase_GetItemsByCategory(t *testing.T) {
+	t.Run("reports the overall total across pages", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
-		testItem := createTestItem(t)
-		req := &dto.SearchRequest{
-			Query:    "test",
-			Page:     1,
-			PageSize: 10,
+		page := make([]*item.Item, 10)
+		for i := range page {
+			page[i] = createTestItem(t)
 		}
 
-		mockRepo.On("Search", mock.Anything, "test", 10, 0).Return([]*item.Item{testItem}, nil)
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(25, nil)
+		mockRepo.On("FindByCategory", mock.Anything, mock.AnythingOfType("item.Category"), item.DefaultSort(), 10, 10).Return(page, nil)
 
-		result, err := useCase.SearchItems(context.Background(), req)
+		result, err := useCase.GetItemsByCategory(context.Background(), "electronics", false, 2, 10)
 
 		assert.NoError(t, err)
-		assert.NotNil(t, result)
+		assert.Len(t, result.Items, 10)
+		assert.Equal(t, 25, result.Total)
+		assert.Equal(t, 2, result.Page)
+		assert.Equal(t, 3, result.TotalPages)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("include descendants queries the category tree", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		isElectronics := mock.MatchedBy(func(c item.Category) bool { return c.Slug() == "electronics" })
+		mockRepo.On("CountByCategoryTree", mock.Anything, isElectronics).Return(1, nil)
+		mockRepo.On("FindByCategoryTree", mock.Anything, isElectronics, item.DefaultSort(), 10, 0).Return([]*item.Item{createTestItem(t)}, nil)
+
+		result, err := useCase.GetItemsByCategory(context.Background(), "Electronics", true, 1, 10)
+
+		require.NoError(t, err)
 		assert.Len(t, result.Items, 1)
+		assert.Equal(t, 1, result.Total)
 		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "CountByCategory", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "FindByCategory", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("search by category", func(t *testing.T) {
+	t.Run("count error", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockInventory := &MockInventoryService{}
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
-		testItem := createTestItem(t)
-		req := &dto.SearchRequest{
-			Category: "electronics",
-			Page:     1,
-			PageSize: 10,
-		}
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(0, assert.AnError)
 
-		mockRepo.On("FindByCategory", mock.Anything, mock.AnythingOfType("item.Category"), 10, 0).Return([]*item.Item{testItem}, nil)
+		result, err := useCase.GetItemsByCategory(context.Background(), "electronics", false, 1, 10)
 
-		result, err := useCase.SearchItems(context.Background(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
 
-		assert.NoError(t, err)
-		assert.NotNil(t, result)
+	t.Run("known category without items is an empty list", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(0, nil)
+		mockRepo.On("ExistsCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(true, nil)
+		mockRepo.On("FindByCategory", mock.Anything, mock.AnythingOfType("item.Category"), item.DefaultSort(), 10, 0).Return([]*item.Item{}, nil)
+
+		result, err := useCase.GetItemsByCategory(context.Background(), "garden", false, 1, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.Equal(t, 0, result.Total)
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("repository error", func(t *testing.T) {
+	t.Run("unknown category is not found", func(t *testing.T) {
 		mockRepo := &MockItemRepository{}
-		mockInventory := &MockInventoryService{}
-		mockCategory := &MockCategoryService{}
-		mockPricing := &MockPricingService{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		isUnknown := mock.MatchedBy(func(c item.Category) bool { return c.Slug() == "no-such-category" })
+		mockRepo.On("CountByCategoryTree", mock.Anything, isUnknown).Return(0, nil)
+		mockRepo.On("ExistsCategory", mock.Anything, isUnknown).Return(false, nil)
 
-		req := &dto.SearchRequest{
-			Query:    "test",
-			Page:     1,
-			PageSize: 10,
+		result, err := useCase.GetItemsByCategory(context.Background(), "No Such Category", true, 1, 10)
+
+		assert.ErrorIs(t, err, item.ErrCategoryNotFound)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "FindByCategoryTree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("categories with items are not looked up", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		// Past the last page the list is empty, but the category is known from its count
+		mockRepo.On("CountByCategory", mock.Anything, mock.AnythingOfType("item.Category")).Return(3, nil)
+		mockRepo.On("FindByCategory", mock.Anything, mock.AnythingOfType("item.Category"), item.DefaultSort(), 10, 10).Return([]*item.Item{}, nil)
+
+		result, err := useCase.GetItemsByCategory(context.Background(), "electronics", false, 2, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockRepo.AssertNotCalled(t, "ExistsCategory", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_GetPriceHistory(t *testing.T) {
+	itemID := item.NewItemID()
+
+	t.Run("returns changes in repository order with pagination", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		p100, _ := item.NewPrice(100, "USD")
+		p90, _ := item.NewPrice(90, "USD")
+		p80, _ := item.NewPrice(80, "USD")
+		first := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+		history := []item.PriceChange{
+			{ItemID: itemID, OldPrice: p100, NewPrice: p90, ChangedAt: first},
+			{ItemID: itemID, OldPrice: p90, NewPrice: p80, ChangedAt: first.Add(time.Hour)},
 		}
 
-		mockRepo.On("Search", mock.Anything, "test", 10, 0).Return(nil, assert.AnError)
+		mockRepo.On("ExistsByID", mock.Anything, itemID).Return(true, nil)
+		mockRepo.On("CountPriceHistory", mock.Anything, itemID).Return(12, nil)
+		mockRepo.On("FindPriceHistory", mock.Anything, itemID, 10, 10).Return(history, nil)
 
-		result, err := useCase.SearchItems(context.Background(), req)
+		result, err := useCase.GetPriceHistory(context.Background(), itemID.String(), 2, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, itemID.String(), result.ItemID)
+		assert.Equal(t, 12, result.Total)
+		assert.Equal(t, 2, result.TotalPages)
+		require.Len(t, result.Changes, 2)
+		assert.Equal(t, 100.0, result.Changes[0].OldPrice)
+		assert.Equal(t, 90.0, result.Changes[0].NewPrice)
+		assert.Equal(t, "USD", result.Changes[0].NewCurrency)
+		assert.Equal(t, 80.0, result.Changes[1].NewPrice)
+		assert.True(t, result.Changes[0].ChangedAt.Before(result.Changes[1].ChangedAt))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("ExistsByID", mock.Anything, itemID).Return(false, nil)
+
+		_, err := useCase.GetPriceHistory(context.Background(), itemID.String(), 1, 10)
 
 		assert.Error(t, err)
-		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "not found")
+		mockRepo.AssertNotCalled(t, "FindPriceHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_GetAvailableItems(t *testing.T) {
+	mockRepo := &MockItemRepository{}
+	mockInventory := &MockInventoryService{}
+	mockCategory := &MockCategoryService{}
+	mockPricing := &MockPricingService{}
+
+	useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
+
+	testItem := createTestItem(t)
+	mockRepo.On("CountAvailable", mock.Anything).Return(21, nil)
+	mockRepo.On("FindAvailableItems", mock.Anything, item.DefaultSort(), 10, 20).Return([]*item.Item{testItem}, nil)
+
+	result, err := useCase.GetAvailableItems(context.Background(), 3, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 21, result.Total)
+	assert.Equal(t, 3, result.TotalPages)
+	assert.Len(t, result.Items, 1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestItemUseCase_GetLowStockItems(t *testing.T) {
+	t.Run("valid threshold returns the matching items", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		scarce := createTestItem(t)
+		inventory, err := item.NewInventory(2)
+		require.NoError(t, err)
+		scarce.SetInventory(inventory)
+		mockRepo.On("FindItemsWithLowStock", mock.Anything, 5).Return([]*item.Item{scarce}, nil)
+
+		result, err := useCase.GetLowStockItems(context.Background(), 5)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, scarce.ID().String(), result.Items[0].ID)
+		assert.Equal(t, 2, result.Items[0].Inventory.Quantity)
+		assert.Equal(t, 1, result.Total)
+		assert.Equal(t, 1, result.TotalPages)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no matches is an empty list", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+		mockRepo.On("FindItemsWithLowStock", mock.Anything, 1).Return([]*item.Item{}, nil)
+
+		result, err := useCase.GetLowStockItems(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.Equal(t, 0, result.TotalPages)
+	})
+
+	t.Run("out of range thresholds are rejected", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		for _, threshold := range []int{-1, 0, MaxLowStockThreshold + 1} {
+			_, err := useCase.GetLowStockItems(context.Background(), threshold)
+			assert.ErrorIs(t, err, ErrInvalidFilter, threshold)
+		}
+		mockRepo.AssertNotCalled(t, "FindItemsWithLowStock", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_GetItemStats(t *testing.T) {
+	t.Run("counts per status and top categories", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		mockRepo.On("CountByStatus", mock.Anything, item.StatusActive).Return(12, nil)
+		mockRepo.On("CountByStatus", mock.Anything, item.StatusInactive).Return(3, nil)
+		mockRepo.On("CountByStatus", mock.Anything, item.StatusDraft).Return(2, nil)
+		mockRepo.On("CountByStatus", mock.Anything, item.StatusArchived).Return(1, nil)
+
+		electronics, err := item.NewCategory("Electronics")
+		require.NoError(t, err)
+		books, err := item.NewCategory("Books")
+		require.NoError(t, err)
+		mockRepo.On("CountTopCategories", mock.Anything, 10).Return([]item.CategoryCount{
+			{Category: electronics, Count: 11},
+			{Category: books, Count: 7},
+		}, nil)
+
+		stats, err := useCase.GetItemStats(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 18, stats.Total)
+		assert.Equal(t, map[string]int{"active": 12, "inactive": 3, "draft": 2, "archived": 1}, stats.ByStatus)
+		assert.Equal(t, []dto.CategoryCountResponse{
+			{Name: "Electronics", Slug: "electronics", Count: 11},
+			{Name: "Books", Slug: "books", Count: 7},
+		}, stats.TopCategories)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+		mockRepo.On("CountByStatus", mock.Anything, item.StatusActive).Return(0, errors.New("connection refused"))
+
+		_, err := useCase.GetItemStats(context.Background())
+
+		assert.ErrorContains(t, err, "failed to count active items")
+	})
 }
 
 func TestItemUseCase_DeleteItem(t *testing.T) {
@@ -426,12 +4274,14 @@ func TestItemUseCase_DeleteItem(t *testing.T) {
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
-		itemID := item.NewItemID()
+		testItem := createTestItem(t)
+		itemID := testItem.ID()
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(testItem, nil)
 		mockRepo.On("Delete", mock.Anything, itemID).Return(nil)
 
-		err := useCase.DeleteItem(context.Background(), itemID.String())
+		err := useCase.DeleteItem(context.Background(), itemID.String(), false)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -443,15 +4293,129 @@ func TestItemUseCase_DeleteItem(t *testing.T) {
 		mockCategory := &MockCategoryService{}
 		mockPricing := &MockPricingService{}
 
-		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing)
+		useCase := NewItemUseCase(mockRepo, mockInventory, mockCategory, mockPricing, events.NewDispatcher())
 
 		itemID := item.NewItemID()
-		mockRepo.On("Delete", mock.Anything, itemID).Return(item.ItemNotFoundError(itemID))
+		mockRepo.On("FindByID", mock.Anything, itemID).Return(nil, item.ItemNotFoundError(itemID))
 
-		err := useCase.DeleteItem(context.Background(), itemID.String())
+		err := useCase.DeleteItem(context.Background(), itemID.String(), false)
 
 		assert.Error(t, err)
 		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	newActiveInStock := func(t *testing.T) *item.Item {
+		testItem := createTestItem(t)
+		require.NoError(t, testItem.TransitionTo(item.StatusActive))
+		inventory, _ := item.NewInventoryWithReserved(12, 3)
+		testItem.SetInventory(inventory)
+		return testItem
+	}
+
+	t.Run("an active item with stock is not deleted", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newActiveInStock(t)
+		mockRepo.On("FindByID", mock.Anything, testItem.ID()).Return(testItem, nil)
+
+		err := useCase.DeleteItem(context.Background(), testItem.ID().String(), false)
+
+		assert.ErrorIs(t, err, ErrItemInUse)
+		assert.ErrorContains(t, err, "12 units in stock, 3 of them reserved")
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("force deletes an active item with stock", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		testItem := newActiveInStock(t)
+		mockRepo.On("Delete", mock.Anything, testItem.ID()).Return(nil)
+
+		err := useCase.DeleteItem(context.Background(), testItem.ID().String(), true)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestItemUseCase_DeleteItemsBulk(t *testing.T) {
+	t.Run("a batch mixing valid and unknown IDs reports accurate counts", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		draft := createTestItem(t)
+		inUse := createTestItem(t)
+		require.NoError(t, inUse.TransitionTo(item.StatusActive))
+		inventory, _ := item.NewInventory(5)
+		inUse.SetInventory(inventory)
+		unknown, vanished := item.NewItemID(), item.NewItemID()
+
+		// vanished exists when checked but is gone by the time it is deleted
+		mockRepo.On("FindByIDs", mock.Anything, []item.ItemID{draft.ID(), unknown, inUse.ID(), vanished}).
+			Return([]*item.Item{draft, inUse}, nil)
+		mockRepo.On("DeleteAll", mock.Anything, []item.ItemID{draft.ID(), unknown, vanished}).
+			Return([]item.ItemID{unknown, vanished}, nil)
+
+		result, err := useCase.DeleteItemsBulk(context.Background(), []string{
+			draft.ID().String(), unknown.String(), "not-an-id", inUse.ID().String(), draft.ID().String(), vanished.String(),
+		}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, &dto.BulkDeleteResult{
+			Total:       5,
+			Deleted:     1,
+			NotFound:    3,
+			InUse:       1,
+			NotFoundIDs: []string{"not-an-id", unknown.String(), vanished.String()},
+			InUseIDs:    []string{inUse.ID().String()},
+		}, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("force deletes items in use without loading them", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		first, second := item.NewItemID(), item.NewItemID()
+		mockRepo.On("DeleteAll", mock.Anything, []item.ItemID{first, second}).Return(nil, nil)
+
+		result, err := useCase.DeleteItemsBulk(context.Background(), []string{first.String(), second.String()}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Deleted)
+		assert.Zero(t, result.NotFound)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a repository failure deletes nothing", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		id := item.NewItemID()
+		mockRepo.On("DeleteAll", mock.Anything, []item.ItemID{id}).Return(nil, errors.New("connection reset"))
+
+		result, err := useCase.DeleteItemsBulk(context.Background(), []string{id.String()}, true)
+
+		assert.ErrorContains(t, err, "connection reset")
+		assert.Nil(t, result)
+	})
+
+	t.Run("only malformed IDs never reach the repository", func(t *testing.T) {
+		mockRepo := &MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &MockInventoryService{}, &MockCategoryService{}, &MockPricingService{}, events.NewDispatcher())
+
+		result, err := useCase.DeleteItemsBulk(context.Background(), []string{"bad", "bad", "worse"}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		assert.Equal(t, 2, result.NotFound)
+		mockRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "DeleteAll", mock.Anything, mock.Anything)
 	})
 }
 
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrUnknownMaintenanceAction is returned when an action is not on the allow-list
+var ErrUnknownMaintenanceAction = errors.New("unknown maintenance action")
+
+// MaintenanceUseCase runs a fixed set of operational maintenance actions
+type MaintenanceUseCase interface {
+	RunMaintenance(ctx context.Context, action string) (*dto.MaintenanceResponse, error)
+}
+
+// MaintenanceRepository performs maintenance operations against the datastore
+type MaintenanceRepository interface {
+	VacuumAnalyze(ctx context.Context) error
+	RefreshStats(ctx context.Context) error
+}
+
+type maintenanceUseCase struct {
+	actions map[string]func(ctx context.Context) error
+}
+
+// NewMaintenanceUseCase creates a maintenance use case with its allow-list of actions
+func NewMaintenanceUseCase(maintenanceRepository MaintenanceRepository) MaintenanceUseCase {
+	return &maintenanceUseCase{
+		actions: map[string]func(ctx context.Context) error{
+			"vacuum_analyze": maintenanceRepository.VacuumAnalyze,
+			"refresh_stats":  maintenanceRepository.RefreshStats,
+		},
+	}
+}
+
+// RunMaintenance dispatches an allow-listed action; anything else is rejected
+func (u *maintenanceUseCase) RunMaintenance(ctx context.Context, action string) (*dto.MaintenanceResponse, error) {
+	run, ok := u.actions[action]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMaintenanceAction, action)
+	}
+
+	if err := run(ctx); err != nil {
+		return nil, fmt.Errorf("failed to run maintenance action %s: %w", action, err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("action", action).
+		Msg("Maintenance action completed")
+
+	return &dto.MaintenanceResponse{
+		Action:      action,
+		Status:      "completed",
+		CompletedAt: time.Now(),
+	}, nil
+}
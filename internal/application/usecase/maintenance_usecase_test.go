@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMaintenanceRepository struct {
+	mock.Mock
+}
+
+func (m *MockMaintenanceRepository) VacuumAnalyze(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockMaintenanceRepository) RefreshStats(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func TestMaintenanceUseCase_RunMaintenance(t *testing.T) {
+	t.Run("vacuum_analyze calls the repository", func(t *testing.T) {
+		mockRepo := &MockMaintenanceRepository{}
+		useCase := NewMaintenanceUseCase(mockRepo)
+
+		mockRepo.On("VacuumAnalyze", mock.Anything).Return(nil).Once()
+
+		result, err := useCase.RunMaintenance(context.Background(), "vacuum_analyze")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "vacuum_analyze", result.Action)
+		assert.Equal(t, "completed", result.Status)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "RefreshStats", mock.Anything)
+	})
+
+	t.Run("refresh_stats calls the repository", func(t *testing.T) {
+		mockRepo := &MockMaintenanceRepository{}
+		useCase := NewMaintenanceUseCase(mockRepo)
+
+		mockRepo.On("RefreshStats", mock.Anything).Return(nil).Once()
+
+		_, err := useCase.RunMaintenance(context.Background(), "refresh_stats")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown action is rejected", func(t *testing.T) {
+		mockRepo := &MockMaintenanceRepository{}
+		useCase := NewMaintenanceUseCase(mockRepo)
+
+		result, err := useCase.RunMaintenance(context.Background(), "rm -rf /")
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrUnknownMaintenanceAction))
+		mockRepo.AssertNotCalled(t, "VacuumAnalyze", mock.Anything)
+		mockRepo.AssertNotCalled(t, "RefreshStats", mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := &MockMaintenanceRepository{}
+		useCase := NewMaintenanceUseCase(mockRepo)
+
+		mockRepo.On("VacuumAnalyze", mock.Anything).Return(assert.AnError).Once()
+
+		result, err := useCase.RunMaintenance(context.Background(), "vacuum_analyze")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.False(t, errors.Is(err, ErrUnknownMaintenanceAction))
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,421 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var useCaseTracer = tracing.Tracer("item-pdp-service/usecase")
+
+// tracingItemUseCase wraps an ItemUseCase with one span per call
+type tracingItemUseCase struct {
+	next ItemUseCase
+}
+
+// NewTracingItemUseCase decorates next so every call runs inside an "ItemUseCase.<Method>" span
+func NewTracingItemUseCase(next ItemUseCase) ItemUseCase {
+	return &tracingItemUseCase{next: next}
+}
+
+func (t *tracingItemUseCase) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return useCaseTracer.Start(ctx, "ItemUseCase."+method, trace.WithAttributes(attrs...))
+}
+
+// traceItem records the ID and SKU of a returned item
+func traceItem(span trace.Span, resp *dto.ItemResponse) {
+	if resp != nil {
+		span.SetAttributes(tracing.AttrItemID.String(resp.ID), tracing.AttrItemSKU.String(resp.SKU))
+	}
+}
+
+func (t *tracingItemUseCase) CreateItem(ctx context.Context, req *dto.CreateItemRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "CreateItem", tracing.AttrItemSKU.String(req.SKU))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.CreateItem(ctx, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) CreateItemsBulk(ctx context.Context, reqs []*dto.CreateItemRequest) (result *dto.BulkCreateResult, err error) {
+	ctx, span := t.start(ctx, "CreateItemsBulk", attribute.Int("item.count", len(reqs)))
+	defer func() { tracing.End(span, err) }()
+
+	result, err = t.next.CreateItemsBulk(ctx, reqs)
+	if result != nil {
+		span.SetAttributes(attribute.Int("bulk.created", result.Created), attribute.Int("bulk.failed", result.Failed))
+	}
+	return result, err
+}
+
+func (t *tracingItemUseCase) UpsertItem(ctx context.Context, req *dto.CreateItemRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "UpsertItem", tracing.AttrItemSKU.String(req.SKU))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.UpsertItem(ctx, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) ImportItemsCSV(ctx context.Context, r io.Reader, strict bool) (result *dto.ImportResult, err error) {
+	ctx, span := t.start(ctx, "ImportItemsCSV", attribute.Bool("import.strict", strict))
+	defer func() { tracing.End(span, err) }()
+
+	result, err = t.next.ImportItemsCSV(ctx, r, strict)
+	if result != nil {
+		span.SetAttributes(attribute.Int("import.created", result.Created), attribute.Int("import.failed", result.Failed))
+	}
+	return result, err
+}
+
+func (t *tracingItemUseCase) ExportItems(ctx context.Context, req *dto.ExportRequest, fn func(*dto.ItemResponse) error) (err error) {
+	ctx, span := t.start(ctx, "ExportItems", attribute.String("export.format", req.Format))
+	defer func() { tracing.End(span, err) }()
+
+	exported := 0
+	err = t.next.ExportItems(ctx, req, func(resp *dto.ItemResponse) error {
+		exported++
+		return fn(resp)
+	})
+	span.SetAttributes(attribute.Int("export.count", exported))
+	return err
+}
+
+func (t *tracingItemUseCase) SubscribeItemEvents(ctx context.Context, id string) (events <-chan dto.ItemEventResponse, err error) {
+	// The span covers subscribing only; the stream outlives it
+	ctx, span := t.start(ctx, "SubscribeItemEvents", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.SubscribeItemEvents(ctx, id)
+}
+
+func (t *tracingItemUseCase) GetItemByID(ctx context.Context, id string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemByID", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemByID(ctx, id)
+}
+
+func (t *tracingItemUseCase) GetItemInCurrency(ctx context.Context, id, currency string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemInCurrency", tracing.AttrItemID.String(id), attribute.String("price.currency", currency))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemInCurrency(ctx, id, currency)
+}
+
+func (t *tracingItemUseCase) GetPriceHistory(ctx context.Context, id string, page, pageSize int) (resp *dto.PriceHistoryResponse, err error) {
+	ctx, span := t.start(ctx, "GetPriceHistory", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetPriceHistory(ctx, id, page, pageSize)
+}
+
+func (t *tracingItemUseCase) GetItemBySKU(ctx context.Context, sku string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemBySKU", tracing.AttrItemSKU.String(sku))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.GetItemBySKU(ctx, sku)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) GetItemWithStats(ctx context.Context, id string) (resp *dto.ItemWithStatsResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemWithStats", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemWithStats(ctx, id)
+}
+
+func (t *tracingItemUseCase) GetItemByBarcode(ctx context.Context, code string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemByBarcode", tracing.AttrItemBarcode.String(code))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.GetItemByBarcode(ctx, code)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) GetItemsByIDs(ctx context.Context, ids []string) (resp []dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemsByIDs", attribute.Int("item.count", len(ids)))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemsByIDs(ctx, ids)
+}
+
+func (t *tracingItemUseCase) UpdateItem(ctx context.Context, id string, req *dto.UpdateItemRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "UpdateItem", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.UpdateItem(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) UpdateInventory(ctx context.Context, id string, req *dto.UpdateInventoryRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "UpdateInventory", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.UpdateInventory(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) UpdateInventoryBulk(ctx context.Context, updates []dto.InventoryUpdate) (result *dto.BulkInventoryResult, err error) {
+	ctx, span := t.start(ctx, "UpdateInventoryBulk", attribute.Int("item.count", len(updates)))
+	defer func() { tracing.End(span, err) }()
+
+	result, err = t.next.UpdateInventoryBulk(ctx, updates)
+	if result != nil {
+		span.SetAttributes(attribute.Int("bulk.updated", result.Updated), attribute.Int("bulk.failed", result.Failed))
+	}
+	return result, err
+}
+
+func (t *tracingItemUseCase) ReserveInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "ReserveInventory", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.ReserveInventory(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) ReleaseInventory(ctx context.Context, id string, req *dto.InventoryReservationRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "ReleaseInventory", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.ReleaseInventory(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) CheckAvailability(ctx context.Context, id string, quantity int) (resp *dto.ItemAvailabilityResponse, err error) {
+	ctx, span := t.start(ctx, "CheckAvailability", tracing.AttrItemID.String(id), attribute.Int("inventory.quantity", quantity))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.CheckAvailability(ctx, id, quantity)
+}
+
+func (t *tracingItemUseCase) AddImage(ctx context.Context, id string, req *dto.AddImageRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "AddImage", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.AddImage(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) UploadImage(ctx context.Context, id string, req *dto.UploadImageRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "UploadImage", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.UploadImage(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) RemoveImage(ctx context.Context, id string, url string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "RemoveImage", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.RemoveImage(ctx, id, url)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) RemoveImageAt(ctx context.Context, id string, index int) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "RemoveImageAt", tracing.AttrItemID.String(id), attribute.Int("item.image_index", index))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.RemoveImageAt(ctx, id, index)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) RemoveAttribute(ctx context.Context, id string, key string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "RemoveAttribute", tracing.AttrItemID.String(id), attribute.String("item.attribute", key))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.RemoveAttribute(ctx, id, key)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) ReorderImages(ctx context.Context, id string, req *dto.ReorderImagesRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "ReorderImages", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.ReorderImages(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) ReplaceImages(ctx context.Context, id string, req *dto.ReplaceImagesRequest) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "ReplaceImages", tracing.AttrItemID.String(id), attribute.Int("item.images.count", len(req.Images)))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.ReplaceImages(ctx, id, req)
+	traceItem(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) CloneItem(ctx context.Context, id string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "CloneItem", attribute.String("item.id", id))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.CloneItem(ctx, id)
+}
+
+func (t *tracingItemUseCase) DeleteItem(ctx context.Context, id string, force bool) (err error) {
+	ctx, span := t.start(ctx, "DeleteItem", tracing.AttrItemID.String(id), attribute.Bool("item.delete.force", force))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.DeleteItem(ctx, id, force)
+}
+
+func (t *tracingItemUseCase) DeleteItemsBulk(ctx context.Context, ids []string, force bool) (result *dto.BulkDeleteResult, err error) {
+	ctx, span := t.start(ctx, "DeleteItemsBulk", attribute.Int("item.count", len(ids)), attribute.Bool("item.delete.force", force))
+	defer func() { tracing.End(span, err) }()
+
+	result, err = t.next.DeleteItemsBulk(ctx, ids, force)
+	if result != nil {
+		span.SetAttributes(attribute.Int("bulk.deleted", result.Deleted), attribute.Int("bulk.not_found", result.NotFound))
+	}
+	return result, err
+}
+
+func (t *tracingItemUseCase) DeactivateItem(ctx context.Context, id string) (resp *dto.StatusChangeResponse, err error) {
+	ctx, span := t.start(ctx, "DeactivateItem", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.DeactivateItem(ctx, id)
+	traceStatusChange(span, resp)
+	return resp, err
+}
+
+func (t *tracingItemUseCase) ActivateItem(ctx context.Context, id string) (resp *dto.StatusChangeResponse, err error) {
+	ctx, span := t.start(ctx, "ActivateItem", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	resp, err = t.next.ActivateItem(ctx, id)
+	traceStatusChange(span, resp)
+	return resp, err
+}
+
+func traceStatusChange(span trace.Span, resp *dto.StatusChangeResponse) {
+	if resp != nil {
+		span.SetAttributes(attribute.Bool("item.status_changed", resp.Changed))
+	}
+}
+
+func (t *tracingItemUseCase) UnarchiveItem(ctx context.Context, id string) (resp *dto.ItemResponse, err error) {
+	ctx, span := t.start(ctx, "UnarchiveItem", tracing.AttrItemID.String(id))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.UnarchiveItem(ctx, id)
+}
+
+func (t *tracingItemUseCase) SearchItems(ctx context.Context, req *dto.SearchRequest) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "SearchItems",
+		attribute.String("search.query", req.Query),
+		attribute.String("search.category", req.Category),
+		attribute.Int("search.page", req.Page),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.SearchItems(ctx, req)
+}
+
+func (t *tracingItemUseCase) ListItems(ctx context.Context, req *dto.ListItemsRequest) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "ListItems",
+		attribute.String("search.category", req.Category),
+		attribute.String("search.status", req.Status),
+		attribute.String("item.brand", req.Brand),
+		attribute.Int("search.page", req.Page),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.ListItems(ctx, req)
+}
+
+func (t *tracingItemUseCase) ListItemSummaries(ctx context.Context, req *dto.ListItemsRequest) (resp *dto.ItemSummaryListResponse, err error) {
+	ctx, span := t.start(ctx, "ListItemSummaries",
+		attribute.String("search.category", req.Category),
+		attribute.String("search.status", req.Status),
+		attribute.String("item.brand", req.Brand),
+		attribute.Int("search.page", req.Page),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.ListItemSummaries(ctx, req)
+}
+
+func (t *tracingItemUseCase) GetItemsByCategory(ctx context.Context, category string, includeDescendants bool, page, pageSize int) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemsByCategory",
+		attribute.String("item.category", category),
+		attribute.Bool("item.category.include_descendants", includeDescendants),
+		attribute.Int("search.page", page),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemsByCategory(ctx, category, includeDescendants, page, pageSize)
+}
+
+func (t *tracingItemUseCase) GetItemsByBrand(ctx context.Context, brand string, page, pageSize int) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemsByBrand",
+		attribute.String("item.brand", brand),
+		attribute.Int("search.page", page),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemsByBrand(ctx, brand, page, pageSize)
+}
+
+func (t *tracingItemUseCase) GetAvailableItems(ctx context.Context, page, pageSize int) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "GetAvailableItems", attribute.Int("search.page", page))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetAvailableItems(ctx, page, pageSize)
+}
+
+func (t *tracingItemUseCase) GetLowStockItems(ctx context.Context, threshold int) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "GetLowStockItems", attribute.Int("item.low_stock.threshold", threshold))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetLowStockItems(ctx, threshold)
+}
+
+func (t *tracingItemUseCase) GetRelatedItems(ctx context.Context, id string, limit int) (resp *dto.ItemListResponse, err error) {
+	ctx, span := t.start(ctx, "GetRelatedItems", tracing.AttrItemID.String(id), attribute.Int("item.related.limit", limit))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetRelatedItems(ctx, id, limit)
+}
+
+func (t *tracingItemUseCase) GetPopularItems(ctx context.Context, window time.Duration, limit int) (resp *dto.PopularItemsResponse, err error) {
+	ctx, span := t.start(ctx, "GetPopularItems", attribute.String("item.popular.window", window.String()), attribute.Int("item.popular.limit", limit))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetPopularItems(ctx, window, limit)
+}
+
+func (t *tracingItemUseCase) SuggestItems(ctx context.Context, query string, limit int) (resp *dto.ItemSuggestionsResponse, err error) {
+	ctx, span := t.start(ctx, "SuggestItems", attribute.Int("item.suggest.limit", limit))
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.SuggestItems(ctx, query, limit)
+}
+
+func (t *tracingItemUseCase) GetItemStats(ctx context.Context) (resp *dto.ItemStatsResponse, err error) {
+	ctx, span := t.start(ctx, "GetItemStats")
+	defer func() { tracing.End(span, err) }()
+
+	return t.next.GetItemStats(ctx)
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// webhookSecretBytes is the length of a generated webhook secret before hex encoding
+const webhookSecretBytes = 32
+
+// ErrInvalidWebhook is returned when a webhook cannot be registered as requested
+var ErrInvalidWebhook = errors.New("invalid webhook")
+
+// WebhookUseCase registers callback URLs for item events
+type WebhookUseCase interface {
+	RegisterWebhook(ctx context.Context, req *dto.RegisterWebhookRequest) (*dto.WebhookResponse, error)
+}
+
+type webhookUseCase struct {
+	store events.WebhookStore
+}
+
+// NewWebhookUseCase creates a webhook use case storing webhooks in store
+func NewWebhookUseCase(store events.WebhookStore) WebhookUseCase {
+	return &webhookUseCase{store: store}
+}
+
+// RegisterWebhook stores an http(s) callback for the requested event types
+// Repeated event types are dropped; the response carries the secret deliveries are signed with
+func (u *webhookUseCase) RegisterWebhook(ctx context.Context, req *dto.RegisterWebhookRequest) (*dto.WebhookResponse, error) {
+	callback, err := url.Parse(req.URL)
+	if err != nil || (callback.Scheme != "http" && callback.Scheme != "https") || callback.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = generateWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	eventTypes := slices.Clone(req.EventTypes)
+	slices.Sort(eventTypes)
+
+	webhook := &events.Webhook{
+		ID:         uuid.NewString(),
+		URL:        callback.String(),
+		Secret:     secret,
+		EventTypes: slices.Compact(eventTypes),
+	}
+	if err := u.store.Create(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to register webhook: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("webhook_id", webhook.ID).
+		Str("url", webhook.URL).
+		Strs("event_types", webhook.EventTypes).
+		Msg("Webhook registered")
+
+	return &dto.WebhookResponse{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		Secret:     webhook.Secret,
+		CreatedAt:  webhook.CreatedAt,
+	}, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/application/dto"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockWebhookStore struct {
+	mock.Mock
+}
+
+func (m *MockWebhookStore) Create(ctx context.Context, webhook *events.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) Enqueue(ctx context.Context, eventID, eventType string, payload []byte) (int, error) {
+	args := m.Called(ctx, eventID, eventType, payload)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWebhookStore) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]events.WebhookDelivery, error) {
+	args := m.Called(ctx, limit, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]events.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookStore) RecordAttempt(ctx context.Context, attempt events.WebhookAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func TestWebhookUseCase_RegisterWebhook(t *testing.T) {
+	t.Run("generates a secret and drops repeated event types", func(t *testing.T) {
+		store := &MockWebhookStore{}
+		useCase := NewWebhookUseCase(store)
+
+		var stored *events.Webhook
+		store.On("Create", mock.Anything, mock.AnythingOfType("*events.Webhook")).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*events.Webhook) }).
+			Return(nil).Once()
+
+		result, err := useCase.RegisterWebhook(context.Background(), &dto.RegisterWebhookRequest{
+			URL:        "https://example.com/hooks/items",
+			EventTypes: []string{"ItemPriceChanged", "ItemCreated", "ItemCreated"},
+		})
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.ID)
+		assert.Equal(t, "https://example.com/hooks/items", result.URL)
+		assert.Equal(t, []string{"ItemCreated", "ItemPriceChanged"}, result.EventTypes)
+		assert.Len(t, result.Secret, 2*webhookSecretBytes)
+		assert.Equal(t, result.Secret, stored.Secret)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("keeps the given secret", func(t *testing.T) {
+		store := &MockWebhookStore{}
+		useCase := NewWebhookUseCase(store)
+		store.On("Create", mock.Anything, mock.AnythingOfType("*events.Webhook")).Return(nil).Once()
+
+		result, err := useCase.RegisterWebhook(context.Background(), &dto.RegisterWebhookRequest{
+			URL:        "http://localhost:9000/hook",
+			EventTypes: []string{"ItemDeleted"},
+			Secret:     "a-shared-secret-value",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "a-shared-secret-value", result.Secret)
+	})
+
+	t.Run("rejects non-http callbacks", func(t *testing.T) {
+		store := &MockWebhookStore{}
+		useCase := NewWebhookUseCase(store)
+
+		for _, callback := range []string{"ftp://example.com/hook", "/relative/hook", "https://"} {
+			_, err := useCase.RegisterWebhook(context.Background(), &dto.RegisterWebhookRequest{
+				URL:        callback,
+				EventTypes: []string{"ItemCreated"},
+			})
+			assert.ErrorIs(t, err, ErrInvalidWebhook, callback)
+		}
+		store.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("store failure", func(t *testing.T) {
+		store := &MockWebhookStore{}
+		useCase := NewWebhookUseCase(store)
+		store.On("Create", mock.Anything, mock.Anything).Return(errors.New("connection refused")).Once()
+
+		_, err := useCase.RegisterWebhook(context.Background(), &dto.RegisterWebhookRequest{
+			URL:        "https://example.com/hook",
+			EventTypes: []string{"ItemCreated"},
+		})
+
+		assert.ErrorContains(t, err, "failed to register webhook")
+		assert.NotErrorIs(t, err, ErrInvalidWebhook)
+	})
+}
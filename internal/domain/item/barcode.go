@@ -0,0 +1,70 @@
+package item
+
+import (
+	"fmt"
+	"strings"
+)
+
+// barcodeLength is the number of digits in a GTIN-13 (EAN-13) code
+const barcodeLength = 13
+
+// Barcode is a value object for an item's GTIN-13 (EAN-13) code
+// 12-digit UPC-A codes are stored in their GTIN-13 form with a leading zero
+// The zero value means the item has no barcode
+type Barcode struct {
+	value string
+}
+
+// NewBarcode strips spaces and hyphens from code, pads UPC-A codes to 13 digits
+// and rejects codes whose check digit does not match
+func NewBarcode(code string) (Barcode, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, code)
+
+	if digits == "" {
+		return Barcode{}, NewDomainError("barcode cannot be empty")
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return Barcode{}, NewDomainError("barcode can only contain digits")
+		}
+	}
+
+	if len(digits) == barcodeLength-1 {
+		digits = "0" + digits
+	}
+	if len(digits) != barcodeLength {
+		return Barcode{}, NewDomainError(fmt.Sprintf("barcode must have 12 or %d digits", barcodeLength))
+	}
+
+	if want := gtinCheckDigit(digits[:barcodeLength-1]); digits[barcodeLength-1] != want {
+		return Barcode{}, NewDomainError(fmt.Sprintf("invalid barcode check digit: expected %c", want))
+	}
+
+	return Barcode{value: digits}, nil
+}
+
+func (b Barcode) String() string { return b.value }
+
+// IsZero reports whether no barcode has been set
+func (b Barcode) IsZero() bool {
+	return b.value == ""
+}
+
+// gtinCheckDigit computes the GS1 mod-10 check digit for the digits preceding it
+// Weights alternate 3 and 1 starting from the rightmost digit
+func gtinCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,51 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBarcode(t *testing.T) {
+	t.Run("valid GTIN-13", func(t *testing.T) {
+		barcode, err := NewBarcode("4006381333931")
+
+		require.NoError(t, err)
+		assert.Equal(t, "4006381333931", barcode.String())
+		assert.False(t, barcode.IsZero())
+	})
+
+	t.Run("spaces and hyphens are stripped", func(t *testing.T) {
+		barcode, err := NewBarcode(" 4-006381-333931 ")
+
+		require.NoError(t, err)
+		assert.Equal(t, "4006381333931", barcode.String())
+	})
+
+	t.Run("UPC-A is padded to 13 digits", func(t *testing.T) {
+		barcode, err := NewBarcode("036000291452")
+
+		require.NoError(t, err)
+		assert.Equal(t, "0036000291452", barcode.String())
+	})
+
+	t.Run("transposed digits fail the checksum", func(t *testing.T) {
+		barcode, err := NewBarcode("4006381339331")
+
+		assert.EqualError(t, err, "invalid barcode check digit: expected 9")
+		assert.True(t, barcode.IsZero())
+	})
+
+	t.Run("malformed codes are rejected", func(t *testing.T) {
+		for raw, want := range map[string]string{
+			"":               "barcode cannot be empty",
+			"400638133393X":  "barcode can only contain digits",
+			"40063813339":    "barcode must have 12 or 13 digits",
+			"40063813339311": "barcode must have 12 or 13 digits",
+		} {
+			_, err := NewBarcode(raw)
+			assert.EqualError(t, err, want, raw)
+		}
+	})
+}
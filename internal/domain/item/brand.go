@@ -0,0 +1,43 @@
+package item
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxBrandNameLength matches the width of the brand_name column
+const maxBrandNameLength = 100
+
+// Brand is a value object for the manufacturer or label an item is sold under
+// The slug is derived from the name the same way category slugs are
+// The zero value means the item has no brand
+type Brand struct {
+	name string
+	slug string
+}
+
+// NewBrand trims name and derives its slug: "Acme & Sons" becomes "acme-sons"
+func NewBrand(name string) (Brand, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Brand{}, NewDomainError("brand name cannot be empty")
+	}
+	if len(name) > maxBrandNameLength {
+		return Brand{}, NewDomainError(fmt.Sprintf("brand name must be at most %d characters", maxBrandNameLength))
+	}
+
+	slug := slugify(name)
+	if slug == "" {
+		return Brand{}, NewDomainError("brand name must contain a letter or digit")
+	}
+
+	return Brand{name: name, slug: slug}, nil
+}
+
+func (b Brand) Name() string { return b.name }
+func (b Brand) Slug() string { return b.slug }
+
+// IsZero reports whether no brand has been set
+func (b Brand) IsZero() bool {
+	return b == Brand{}
+}
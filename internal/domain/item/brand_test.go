@@ -0,0 +1,26 @@
+package item
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBrand(t *testing.T) {
+	brand, err := NewBrand("  Acme & Sons ")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme & Sons", brand.Name())
+	assert.Equal(t, "acme-sons", brand.Slug())
+	assert.False(t, brand.IsZero())
+
+	_, err = NewBrand(" ")
+	assert.EqualError(t, err, "brand name cannot be empty")
+
+	_, err = NewBrand("&&&")
+	assert.EqualError(t, err, "brand name must contain a letter or digit")
+
+	_, err = NewBrand(strings.Repeat("a", 101))
+	assert.EqualError(t, err, "brand name must be at most 100 characters")
+}
@@ -0,0 +1,178 @@
+package item
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Name limits every item must satisfy
+const (
+	MinNameLength = 3
+	MaxNameLength = 255 // the width of the name column
+)
+
+// Default business rules, used when none are configured
+const (
+	DefaultMaxPrice          = 999999
+	DefaultMinInventoryLevel = 5
+	DefaultCurrency          = "USD"
+)
+
+// autoActivateInventory is the stock above which AutoCorrect activates draft items
+const autoActivateInventory = 100
+
+// DefaultAllowedCurrencies are the currencies items may be priced in when none are configured
+var DefaultAllowedCurrencies = []string{"USD", "EUR", "GBP", "JPY"}
+
+var defaultBusinessRules = DefaultBusinessRules()
+
+// BusinessRules are the thresholds items are validated and corrected against
+// Category discounts are configured separately through DiscountPolicy
+type BusinessRules struct {
+	maxPrice          float64
+	minInventoryLevel int
+	defaultCurrency   string
+	allowedCurrencies map[string]bool
+}
+
+// NewBusinessRules creates rules capping prices at maxPrice, raising stock below
+// minInventoryLevel to that level (0 disables it), allowing prices only in allowedCurrencies
+// (DefaultAllowedCurrencies when empty) and pricing items without a currency in
+// defaultCurrency, which must be allowed. Currency codes are three letters in any case
+func NewBusinessRules(maxPrice float64, minInventoryLevel int, defaultCurrency string, allowedCurrencies []string) (BusinessRules, error) {
+	if maxPrice <= 0 {
+		return BusinessRules{}, NewDomainError(fmt.Sprintf("maximum price must be positive, got %v", maxPrice))
+	}
+	if minInventoryLevel < 0 {
+		return BusinessRules{}, NewDomainError(fmt.Sprintf("minimum inventory level cannot be negative, got %d", minInventoryLevel))
+	}
+
+	if len(allowedCurrencies) == 0 {
+		allowedCurrencies = DefaultAllowedCurrencies
+	}
+	allowed := make(map[string]bool, len(allowedCurrencies))
+	for _, currency := range allowedCurrencies {
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if !isCurrencyCode(currency) {
+			return BusinessRules{}, NewDomainError(fmt.Sprintf("allowed currency must be a three-letter code, got %q", currency))
+		}
+		allowed[currency] = true
+	}
+
+	defaultCurrency = strings.ToUpper(defaultCurrency)
+	if !allowed[defaultCurrency] {
+		return BusinessRules{}, NewDomainError(fmt.Sprintf("unsupported default currency: %s", defaultCurrency))
+	}
+
+	return BusinessRules{
+		maxPrice:          maxPrice,
+		minInventoryLevel: minInventoryLevel,
+		defaultCurrency:   defaultCurrency,
+		allowedCurrencies: allowed,
+	}, nil
+}
+
+// isCurrencyCode reports whether code is three upper-case ASCII letters
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// DefaultBusinessRules returns the rules Item.Validate applies
+func DefaultBusinessRules() BusinessRules {
+	rules, _ := NewBusinessRules(DefaultMaxPrice, DefaultMinInventoryLevel, DefaultCurrency, nil) // the defaults are valid
+	return rules
+}
+
+func (r BusinessRules) MaxPrice() float64       { return r.maxPrice }
+func (r BusinessRules) MinInventoryLevel() int  { return r.minInventoryLevel }
+func (r BusinessRules) DefaultCurrency() string { return r.defaultCurrency }
+
+// AllowsCurrency reports whether items may be priced in currency, an upper-case code
+func (r BusinessRules) AllowsCurrency(currency string) bool {
+	return r.allowedCurrencies[currency]
+}
+
+// AllowedCurrencies returns the currencies items may be priced in, sorted
+func (r BusinessRules) AllowedCurrencies() []string {
+	currencies := make([]string, 0, len(r.allowedCurrencies))
+	for currency := range r.allowedCurrencies {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// Validate checks the rules every item must satisfy before it is stored: a name of
+// MinNameLength to MaxNameLength characters, a positive price of at most the maximum in an
+// allowed currency, a SKU without whitespace that fits the column, and non-negative stock
+// SKU patterns and lengths are left to the SKU policy, which is configurable
+func (r BusinessRules) Validate(i *Item) error {
+	name := strings.TrimSpace(i.name)
+	if len(name) < MinNameLength {
+		return NewDomainError(fmt.Sprintf("item name must be at least %d characters", MinNameLength))
+	}
+	if len(name) > MaxNameLength {
+		return NewDomainError(fmt.Sprintf("item name must be at most %d characters", MaxNameLength))
+	}
+
+	if i.price.Cents() <= 0 {
+		return NewDomainErrorWithCode(CodeInvalidPrice, "item price must be positive")
+	}
+	if i.price.Amount() > r.maxPrice {
+		return NewDomainErrorWithCode(CodeInvalidPrice, fmt.Sprintf("item price %.2f exceeds the maximum of %.2f", i.price.Amount(), r.maxPrice))
+	}
+	if !r.AllowsCurrency(i.price.Currency()) {
+		return NewDomainErrorWithCode(CodeInvalidPrice, fmt.Sprintf("unsupported currency: %s", i.price.Currency()))
+	}
+
+	sku := i.sku.String()
+	if sku == "" {
+		return NewDomainErrorWithCode(CodeInvalidSKU, "SKU cannot be empty")
+	}
+	if len(sku) > MaxSKULength {
+		return NewDomainErrorWithCode(CodeInvalidSKU, fmt.Sprintf("SKU must be at most %d characters", MaxSKULength))
+	}
+	if strings.IndexFunc(sku, unicode.IsSpace) >= 0 {
+		return NewDomainErrorWithCode(CodeInvalidSKU, "SKU cannot contain whitespace")
+	}
+
+	if i.inventory.Quantity() < 0 {
+		return NewDomainError("inventory quantity cannot be negative")
+	}
+	if i.inventory.Reserved() < 0 {
+		return NewDomainError("reserved quantity cannot be negative")
+	}
+
+	return nil
+}
+
+// AutoCorrect adjusts a new item to the rules instead of rejecting it: stock below the
+// minimum level is raised to that level and drafts holding more than 100 units are activated
+// It returns a warning describing each adjustment, or none when the item was left as it was
+func (r BusinessRules) AutoCorrect(i *Item) []string {
+	var warnings []string
+
+	if quantity := i.inventory.Quantity(); quantity > 0 && quantity < r.minInventoryLevel {
+		corrected, _ := NewInventoryWithReserved(r.minInventoryLevel, i.inventory.Reserved()) // raising stock keeps reservations valid
+		i.SetInventory(corrected)
+		warnings = append(warnings, fmt.Sprintf("inventory raised from %d to the minimum level of %d", quantity, r.minInventoryLevel))
+	}
+
+	if i.status == StatusDraft && i.inventory.Quantity() > autoActivateInventory {
+		if err := i.TransitionTo(StatusActive); err == nil {
+			warnings = append(warnings, fmt.Sprintf("item activated because its inventory of %d exceeds %d", i.inventory.Quantity(), autoActivateInventory))
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,255 @@
+package item
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewBusinessRules(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxPrice        float64
+		minInventory    int
+		defaultCurrency string
+		wantError       string
+	}{
+		{"valid", 500, 0, "eur", ""},
+		{"zero max price", 0, 5, "USD", "maximum price must be positive, got 0"},
+		{"negative minimum inventory", 500, -1, "USD", "minimum inventory level cannot be negative, got -1"},
+		{"unsupported default currency", 500, 5, "CHF", "unsupported default currency: CHF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := NewBusinessRules(tt.maxPrice, tt.minInventory, tt.defaultCurrency, nil)
+
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("Expected rules to be created, got %v", err)
+				}
+				if rules.DefaultCurrency() != "EUR" {
+					t.Errorf("Expected default currency EUR, got %s", rules.DefaultCurrency())
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantError {
+				t.Errorf("Expected error %q, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestBusinessRules_Validate(t *testing.T) {
+	validPrice, _ := NewPrice(99.99, "USD")
+	validInventory, _ := NewInventory(10)
+
+	tests := []struct {
+		name      string
+		sku       SKU
+		itemName  string
+		price     Price
+		inventory Inventory
+		wantError string
+	}{
+		{"valid item", ReconstituteSKU("TEST-001"), "Test Item", validPrice, validInventory, ""},
+		{"name too short", ReconstituteSKU("TEST-001"), "TV", validPrice, validInventory, "item name must be at least 3 characters"},
+		{"name only padding", ReconstituteSKU("TEST-001"), "  ab  ", validPrice, validInventory, "item name must be at least 3 characters"},
+		{"name too long", ReconstituteSKU("TEST-001"), strings.Repeat("a", MaxNameLength+1), validPrice, validInventory, "item name must be at most 255 characters"},
+		{"zero price", ReconstituteSKU("TEST-001"), "Test Item", Price{amount: 0, currency: "USD"}, validInventory, "item price must be positive"},
+		{"price above ceiling", ReconstituteSKU("TEST-001"), "Test Item", Price{amount: (DefaultMaxPrice + 1) * 100, currency: "USD"}, validInventory, "item price 1000000.00 exceeds the maximum of 999999.00"},
+		{"unsupported currency", ReconstituteSKU("TEST-001"), "Test Item", Price{amount: 9999, currency: "XYZ"}, validInventory, "unsupported currency: XYZ"},
+		{"empty SKU", SKU{}, "Test Item", validPrice, validInventory, "SKU cannot be empty"},
+		{"SKU too long", ReconstituteSKU(strings.Repeat("A", MaxSKULength+1)), "Test Item", validPrice, validInventory, "SKU must be at most 64 characters"},
+		{"SKU with whitespace", ReconstituteSKU("TEST 001"), "Test Item", validPrice, validInventory, "SKU cannot contain whitespace"},
+		{"negative inventory", ReconstituteSKU("TEST-001"), "Test Item", validPrice, Inventory{quantity: -1}, "inventory quantity cannot be negative"},
+		{"negative reservation", ReconstituteSKU("TEST-001"), "Test Item", validPrice, Inventory{quantity: 1, reserved: -1}, "reserved quantity cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, _ := NewCategory("Electronics")
+			item := Reconstitute(NewItemID(), tt.sku, tt.itemName, "", tt.price, category,
+				tt.inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusDraft, time.Now(), time.Now())
+
+			err := DefaultBusinessRules().Validate(item)
+
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("Expected item to be valid, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q", tt.wantError)
+			}
+			if err.Error() != tt.wantError {
+				t.Errorf("Expected error %q, got %q", tt.wantError, err.Error())
+			}
+		})
+	}
+}
+
+func TestBusinessRules_ValidateMaxPrice(t *testing.T) {
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(150, "USD")
+	category, _ := NewCategory("Electronics")
+	item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+		Inventory{}, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusDraft, time.Now(), time.Now())
+
+	if err := DefaultBusinessRules().Validate(item); err != nil {
+		t.Fatalf("Expected the default ceiling to allow 150, got %v", err)
+	}
+
+	rules, err := NewBusinessRules(100, DefaultMinInventoryLevel, DefaultCurrency, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = rules.Validate(item)
+	if err == nil || err.Error() != "item price 150.00 exceeds the maximum of 100.00" {
+		t.Errorf("Expected the lowered ceiling to reject 150, got %v", err)
+	}
+}
+
+func TestItem_Validate(t *testing.T) {
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+	item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+		Inventory{}, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusDraft, time.Now(), time.Now())
+
+	if err := item.Validate(); err != nil {
+		t.Fatalf("Expected item to be valid, got %v", err)
+	}
+
+	item.name = "TV"
+	err := item.Validate()
+	if err == nil || err.Error() != "item name must be at least 3 characters" {
+		t.Errorf("Expected the default rules to reject a short name, got %v", err)
+	}
+}
+
+func TestBusinessRules_AllowedCurrencies(t *testing.T) {
+	newItem := func(currency string) *Item {
+		sku, _ := NewSKU("TEST-001")
+		price, _ := NewPrice(50, currency)
+		category, _ := NewCategory("Electronics")
+		return Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+			Inventory{}, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusDraft, time.Now(), time.Now())
+	}
+
+	t.Run("a configured currency passes", func(t *testing.T) {
+		rules, err := NewBusinessRules(DefaultMaxPrice, DefaultMinInventoryLevel, "usd", []string{"USD", " cad "})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := rules.Validate(newItem("CAD")); err != nil {
+			t.Errorf("Expected CAD to be allowed, got %v", err)
+		}
+		if err := DefaultBusinessRules().Validate(newItem("CAD")); err == nil {
+			t.Error("Expected the default rules to reject CAD")
+		}
+	})
+
+	t.Run("an unconfigured currency fails", func(t *testing.T) {
+		rules, err := NewBusinessRules(DefaultMaxPrice, DefaultMinInventoryLevel, "CAD", []string{"CAD"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = rules.Validate(newItem("EUR"))
+		if !errors.Is(err, ErrInvalidPrice) || err.Error() != "unsupported currency: EUR" {
+			t.Errorf("Expected EUR to be rejected as an unsupported currency, got %v", err)
+		}
+	})
+
+	t.Run("an empty list keeps the defaults", func(t *testing.T) {
+		rules, err := NewBusinessRules(DefaultMaxPrice, DefaultMinInventoryLevel, DefaultCurrency, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, currency := range DefaultAllowedCurrencies {
+			if !rules.AllowsCurrency(currency) {
+				t.Errorf("Expected %s to be allowed by default", currency)
+			}
+		}
+	})
+
+	t.Run("allowed currencies are listed sorted", func(t *testing.T) {
+		rules, err := NewBusinessRules(DefaultMaxPrice, DefaultMinInventoryLevel, "usd", []string{"usd", "cad", "EUR"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := strings.Join(rules.AllowedCurrencies(), ",")
+		if got != "CAD,EUR,USD" {
+			t.Errorf("Expected CAD,EUR,USD, got %s", got)
+		}
+	})
+
+	invalid := []struct {
+		name      string
+		currency  string
+		allowed   []string
+		wantError string
+	}{
+		{"default currency not allowed", "USD", []string{"CAD"}, "unsupported default currency: USD"},
+		{"malformed code", "USD", []string{"USD", "DOLLAR"}, `allowed currency must be a three-letter code, got "DOLLAR"`},
+		{"empty code", "USD", []string{"USD", ""}, `allowed currency must be a three-letter code, got ""`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBusinessRules(DefaultMaxPrice, DefaultMinInventoryLevel, tt.currency, tt.allowed)
+			if err == nil || err.Error() != tt.wantError {
+				t.Errorf("Expected error %q, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestBusinessRules_AutoCorrect(t *testing.T) {
+	tests := []struct {
+		name         string
+		quantity     int
+		status       Status
+		wantQuantity int
+		wantStatus   Status
+		wantWarnings []string
+	}{
+		{"within the rules", 20, StatusDraft, 20, StatusDraft, nil},
+		{"no stock is left alone", 0, StatusDraft, 0, StatusDraft, nil},
+		{"low stock is raised", 2, StatusActive, 5, StatusActive, []string{"inventory raised from 2 to the minimum level of 5"}},
+		{"high stock draft is activated", 150, StatusDraft, 150, StatusActive, []string{"item activated because its inventory of 150 exceeds 100"}},
+		{"high stock archived item stays archived", 150, StatusArchived, 150, StatusArchived, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sku, _ := NewSKU("TEST-001")
+			price, _ := NewPrice(50, "USD")
+			category, _ := NewCategory("Electronics")
+			inventory, _ := NewInventory(tt.quantity)
+			item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+				inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, tt.status, time.Now(), time.Now())
+
+			warnings := DefaultBusinessRules().AutoCorrect(item)
+
+			if item.Inventory().Quantity() != tt.wantQuantity {
+				t.Errorf("Expected quantity %d, got %d", tt.wantQuantity, item.Inventory().Quantity())
+			}
+			if item.Status() != tt.wantStatus {
+				t.Errorf("Expected status %s, got %s", tt.wantStatus, item.Status())
+			}
+			if len(warnings) != len(tt.wantWarnings) {
+				t.Fatalf("Expected warnings %q, got %q", tt.wantWarnings, warnings)
+			}
+			for i := range warnings {
+				if warnings[i] != tt.wantWarnings[i] {
+					t.Errorf("Expected warning %q, got %q", tt.wantWarnings[i], warnings[i])
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package item
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -16,9 +17,16 @@ type Item struct {
 	inventory   Inventory
 	images      []Image
 	attributes  Attributes
+	dimensions  Dimensions
+	weight      Weight
+	barcode     Barcode
+	brand       Brand
 	status      Status
 	createdAt   time.Time
 	updatedAt   time.Time
+
+	// events raised since the item was last persisted
+	events []DomainEvent
 }
 
 // NewItem creates a new item with basic validation
@@ -41,10 +49,54 @@ func NewItem(sku SKU, name, description string, price Price, category Category)
 		createdAt:   time.Now(),
 		updatedAt:   time.Now(),
 	}
+	item.record(NewItemCreatedEvent(item))
 
 	return item, nil
 }
 
+// Reconstitute rebuilds an item from persisted state
+// Unlike NewItem it keeps the stored ID and timestamps as-is
+func Reconstitute(
+	id ItemID,
+	sku SKU,
+	name, description string,
+	price Price,
+	category Category,
+	inventory Inventory,
+	images []Image,
+	attributes Attributes,
+	dimensions Dimensions,
+	weight Weight,
+	barcode Barcode,
+	brand Brand,
+	status Status,
+	createdAt, updatedAt time.Time,
+) *Item {
+	if images == nil {
+		images = make([]Image, 0)
+	}
+	attributes = attributes.clone()
+
+	return &Item{
+		id:          id,
+		sku:         sku,
+		name:        name,
+		description: description,
+		price:       price,
+		category:    category,
+		inventory:   inventory,
+		images:      images,
+		attributes:  attributes,
+		dimensions:  dimensions,
+		weight:      weight,
+		barcode:     barcode,
+		brand:       brand,
+		status:      status,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
 // Basic getters - anemic model pattern
 func (i *Item) ID() ItemID             { return i.id }
 func (i *Item) SKU() SKU               { return i.sku }
@@ -54,31 +106,386 @@ func (i *Item) Price() Price           { return i.price }
 func (i *Item) Category() Category     { return i.category }
 func (i *Item) Inventory() Inventory   { return i.inventory }
 func (i *Item) Images() []Image        { return i.images }
-func (i *Item) Attributes() Attributes { return i.attributes }
+func (i *Item) Attributes() Attributes { return i.attributes.clone() }
+func (i *Item) Dimensions() Dimensions { return i.dimensions }
+func (i *Item) Weight() Weight         { return i.weight }
+func (i *Item) Barcode() Barcode       { return i.barcode }
+func (i *Item) Brand() Brand           { return i.brand }
 func (i *Item) Status() Status         { return i.status }
 func (i *Item) CreatedAt() time.Time   { return i.createdAt }
 func (i *Item) UpdatedAt() time.Time   { return i.updatedAt }
 
 // Basic setters - anemic model pattern
-func (i *Item) SetName(name string)              { i.name = name; i.updatedAt = time.Now() }
-func (i *Item) SetDescription(desc string)       { i.description = desc; i.updatedAt = time.Now() }
-func (i *Item) SetPrice(price Price)             { i.price = price; i.updatedAt = time.Now() }
-func (i *Item) SetCategory(category Category)    { i.category = category; i.updatedAt = time.Now() }
-func (i *Item) SetInventory(inventory Inventory) { i.inventory = inventory; i.updatedAt = time.Now() }
-func (i *Item) SetStatus(status Status)          { i.status = status; i.updatedAt = time.Now() }
-func (i *Item) SetImages(images []Image)         { i.images = images; i.updatedAt = time.Now() }
-
-// Simple utility methods without business logic
+func (i *Item) SetName(name string)        { i.name = name; i.updatedAt = time.Now() }
+func (i *Item) SetDescription(desc string) { i.description = desc; i.updatedAt = time.Now() }
+
+// SetCategory sets the category without checking it.
+//
+// Deprecated: use ChangeCategory, which rejects restricted categories and records the change.
+func (i *Item) SetCategory(category Category) { i.category = category; i.updatedAt = time.Now() }
+
+// ChangeCategory moves the item to category and records an ItemCategoryChangedEvent
+// The category must be valid and not restricted; moving to the current category is a no-op
+func (i *Item) ChangeCategory(category Category) error {
+	if err := category.Validate(); err != nil {
+		return err
+	}
+	if category.IsRestricted() {
+		return RestrictedCategoryError(category)
+	}
+	if category == i.category {
+		return nil
+	}
+
+	old := i.category
+	i.category = category
+	i.updatedAt = time.Now()
+	i.record(NewItemCategoryChangedEvent(i.id, old, category))
+	return nil
+}
+
+// SetDimensions sets the package size; the zero value clears it
+func (i *Item) SetDimensions(dimensions Dimensions) {
+	i.dimensions = dimensions
+	i.updatedAt = time.Now()
+}
+
+// SetWeight sets the weight; the zero value clears it
+func (i *Item) SetWeight(weight Weight) {
+	i.weight = weight
+	i.updatedAt = time.Now()
+}
+
+// SetBarcode sets the GTIN; the zero value clears it
+func (i *Item) SetBarcode(barcode Barcode) {
+	i.barcode = barcode
+	i.updatedAt = time.Now()
+}
+
+// SetBrand sets the brand; the zero value clears it
+func (i *Item) SetBrand(brand Brand) {
+	i.brand = brand
+	i.updatedAt = time.Now()
+}
+
+// SetAttributes replaces the item's attributes with a copy of attributes
+// Item.Attributes returns a snapshot, so changes made to it are saved back through here
+func (i *Item) SetAttributes(attributes Attributes) {
+	i.attributes = attributes.clone()
+	i.updatedAt = time.Now()
+}
+
+// RemoveAttribute deletes an attribute and reports whether it was present
+// Removing a missing attribute leaves the item untouched
+func (i *Item) RemoveAttribute(key string) bool {
+	if !i.attributes.Remove(key) {
+		return false
+	}
+	i.updatedAt = time.Now()
+	return true
+}
+
+// SetPrice changes the price and records an ItemPriceChangedEvent when it differs
+func (i *Item) SetPrice(price Price) {
+	old := i.price
+	i.price = price
+	i.updatedAt = time.Now()
+	if old != price {
+		i.record(NewItemPriceChangedEvent(i.id, old, price))
+	}
+}
+
+// SetInventory changes the inventory and records an ItemInventoryUpdatedEvent
+// when the stock quantity differs; reservation-only changes raise no event
+func (i *Item) SetInventory(inventory Inventory) {
+	old := i.inventory
+	i.inventory = inventory
+	i.updatedAt = time.Now()
+	if old.quantity != inventory.quantity {
+		i.record(NewItemInventoryUpdatedEvent(i.id, old.quantity, inventory.quantity))
+	}
+}
+
+// SetStatus sets the status without checking the transition.
+//
+// Deprecated: use TransitionTo, which enforces the status lifecycle.
+func (i *Item) SetStatus(status Status) {
+	old := i.status
+	i.status = status
+	i.updatedAt = time.Now()
+	if old != status {
+		i.record(NewItemStatusChangedEvent(i.id, old, status))
+	}
+}
+
+// TransitionTo moves the item to target if the status lifecycle allows it
+// Transitioning to the current status is a no-op
+func (i *Item) TransitionTo(target Status) error {
+	if i.status == target {
+		return nil
+	}
+	if !i.status.CanTransitionTo(target) {
+		return &StatusTransitionError{From: i.status, To: target}
+	}
+	old := i.status
+	i.status = target
+	i.updatedAt = time.Now()
+	i.record(NewItemStatusChangedEvent(i.id, old, target))
+	return nil
+}
+
+// Activate puts the item on sale; an item without stock is refused, since saving it
+// active would archive it again straight away
+func (i *Item) Activate() error {
+	if i.status != StatusActive && i.status.CanTransitionTo(StatusActive) && i.inventory.Quantity() == 0 {
+		return OutOfStockError(i.id)
+	}
+	return i.TransitionTo(StatusActive)
+}
+
+// ArchiveIfSoldOut archives an active item that has no stock left, reporting whether it did
+// The change is a regular transition, so it records an ItemStatusChangedEvent
+func (i *Item) ArchiveIfSoldOut() bool {
+	if i.status != StatusActive || i.inventory.Quantity() != 0 {
+		return false
+	}
+	return i.TransitionTo(StatusArchived) == nil
+}
+
+// Unarchive restores an archived item to draft, so it is reviewed and restocked before it
+// is activated again. Items that are not archived are refused with a StatusTransitionError
+func (i *Item) Unarchive() error {
+	if !i.IsArchived() {
+		return &StatusTransitionError{From: i.status, To: StatusDraft}
+	}
+	return i.TransitionTo(StatusDraft)
+}
+
+// CanBeDeleted refuses to let an active item with stock be deleted
+// Such an item is on sale and may hold units for pending orders; deactivate it first
+func (i *Item) CanBeDeleted() error {
+	if i.status == StatusActive && i.inventory.Quantity() > 0 {
+		return ItemInUseError(i.id, i.inventory)
+	}
+	return nil
+}
+
+// Clone creates a new draft item with sku, copying the name, description, price, category,
+// attributes, images, brand and measurements; stock starts at zero and the barcode is left
+// out, since it identifies the source product. Archived items cannot be cloned
+func (i *Item) Clone(sku SKU) (*Item, error) {
+	if i.IsArchived() {
+		return nil, ItemArchivedError(i.id)
+	}
+
+	clone, err := NewItem(sku, i.name, i.description, i.price, i.category)
+	if err != nil {
+		return nil, err
+	}
+	clone.images = append(clone.images, i.images...)
+	clone.attributes = i.attributes.clone()
+	clone.dimensions = i.dimensions
+	clone.weight = i.weight
+	clone.brand = i.brand
+
+	return clone, nil
+}
+
+// Summary returns the item's list-view fields, matching what the repository reads for FindSummariesByFilter
+func (i *Item) Summary() ItemSummary {
+	return ItemSummary{
+		ID:       i.id,
+		SKU:      i.sku,
+		Name:     i.name,
+		Price:    i.price,
+		Category: i.category,
+		Status:   i.status,
+		InStock:  i.inventory.Quantity() > 0,
+	}
+}
+
+// Validate checks the item against the default business rules
+// Callers with configured rules use BusinessRules.Validate instead
+func (i *Item) Validate() error {
+	return defaultBusinessRules.Validate(i)
+}
+
+// Events returns the pending events without clearing them
+func (i *Item) Events() []DomainEvent {
+	return i.events
+}
+
+// PullEvents returns the events raised since the last call and clears them
+func (i *Item) PullEvents() []DomainEvent {
+	events := i.events
+	i.events = nil
+	return events
+}
+
+func (i *Item) record(event DomainEvent) {
+	i.events = append(i.events, event)
+}
+
+// AddImage appends an image while keeping exactly one primary image
+// The first image becomes primary by default; a new primary replaces the old one
 func (i *Item) AddImage(image Image) {
+	if len(i.images) == 0 {
+		image.isPrimary = true
+	}
+	if image.isPrimary {
+		for j := range i.images {
+			i.images[j].isPrimary = false
+		}
+	}
 	i.images = append(i.images, image)
 	i.updatedAt = time.Now()
 }
 
+// SetPrimaryImage makes the image with the given URL the only primary image
+func (i *Item) SetPrimaryImage(url string) error {
+	idx := i.imageIndex(url)
+	if idx < 0 {
+		return ErrImageNotFound
+	}
+	for j := range i.images {
+		i.images[j].isPrimary = j == idx
+	}
+	i.updatedAt = time.Now()
+	return nil
+}
+
+// PrimaryImage returns the primary image, if the item has any images
+func (i *Item) PrimaryImage() (Image, bool) {
+	for _, img := range i.images {
+		if img.isPrimary {
+			return img, true
+		}
+	}
+	return Image{}, false
+}
+
+// RemoveImage removes the image with the given URL
+// Removing the primary promotes the next image, or the first when the last one was removed
+func (i *Item) RemoveImage(url string) error {
+	idx := i.imageIndex(url)
+	if idx < 0 {
+		return ErrImageNotFound
+	}
+	i.removeImageAt(idx)
+	return nil
+}
+
+// RemoveImageAt removes the image at index, counting from 0 in display order
+// A removed primary is replaced the same way as in RemoveImage
+func (i *Item) RemoveImageAt(index int) error {
+	if index < 0 || index >= len(i.images) {
+		return NewDomainError(fmt.Sprintf("image index %d is out of range for %d images", index, len(i.images)))
+	}
+	i.removeImageAt(index)
+	return nil
+}
+
+// removeImageAt drops the image at idx; a removed primary passes to the image that took its
+// place, or to the first image when it was the last one
+func (i *Item) removeImageAt(idx int) {
+	removed := i.images[idx]
+	images := make([]Image, 0, len(i.images)-1)
+	images = append(images, i.images[:idx]...)
+	images = append(images, i.images[idx+1:]...)
+
+	if removed.isPrimary && len(images) > 0 {
+		if idx >= len(images) {
+			idx = 0
+		}
+		images[idx].isPrimary = true
+	}
+
+	i.images = images
+	i.updatedAt = time.Now()
+}
+
+// RemovePrimary removes the current primary image and promotes a fallback
+func (i *Item) RemovePrimary() error {
+	primary, ok := i.PrimaryImage()
+	if !ok {
+		return ErrImageNotFound
+	}
+	return i.RemoveImage(primary.url)
+}
+
+// ReorderImages puts the images in the given URL order
+// The URLs must name every current image exactly once
+func (i *Item) ReorderImages(urls []string) error {
+	if len(urls) != len(i.images) {
+		return ErrImageOrderMismatch
+	}
+
+	reordered := make([]Image, 0, len(urls))
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		idx := i.imageIndex(url)
+		if idx < 0 {
+			return ErrImageNotFound
+		}
+		if seen[url] {
+			return ErrImageOrderMismatch
+		}
+		seen[url] = true
+		reordered = append(reordered, i.images[idx])
+	}
+
+	i.images = reordered
+	i.updatedAt = time.Now()
+	return nil
+}
+
+// SetImages replaces every image in one step
+// Each image must be valid and listed once; when none is primary the first one is promoted,
+// and more than one primary is rejected. A rejected set leaves the images untouched
+func (i *Item) SetImages(images []Image) error {
+	replaced := make([]Image, len(images))
+	copy(replaced, images)
+
+	seen := make(map[string]bool, len(replaced))
+	primaries := 0
+	for _, img := range replaced {
+		if err := img.Validate(); err != nil {
+			return err
+		}
+		if seen[img.url] {
+			return ErrDuplicateImage
+		}
+		seen[img.url] = true
+		if img.isPrimary {
+			primaries++
+		}
+	}
+	if primaries > 1 {
+		return ErrMultiplePrimaryImages
+	}
+	if primaries == 0 && len(replaced) > 0 {
+		replaced[0].isPrimary = true
+	}
+
+	i.images = replaced
+	i.updatedAt = time.Now()
+	return nil
+}
+
 func (i *Item) ClearImages() {
 	i.images = make([]Image, 0)
 	i.updatedAt = time.Now()
 }
 
+func (i *Item) imageIndex(url string) int {
+	for j, img := range i.images {
+		if img.url == url {
+			return j
+		}
+	}
+	return -1
+}
+
 // Basic status checks without business rules
 func (i *Item) IsActive() bool   { return i.status == StatusActive }
 func (i *Item) IsDraft() bool    { return i.status == StatusDraft }
@@ -1,6 +1,8 @@
 package item
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -98,6 +100,187 @@ func TestItem_AddImage(t *testing.T) {
 	if len(item.Images()) != 1 {
 		t.Errorf("Expected 1 image, got %d", len(item.Images()))
 	}
+
+	if !item.Images()[0].IsPrimary() {
+		t.Error("First image should become primary by default")
+	}
+}
+
+func newImageTestItem(t *testing.T, urls ...string) *Item {
+	t.Helper()
+
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+	item, _ := NewItem(sku, "Test Item", "Test Description", price, category)
+
+	for _, url := range urls {
+		image, err := NewImage(url, "", false)
+		if err != nil {
+			t.Fatalf("Failed to create image: %v", err)
+		}
+		item.AddImage(image)
+	}
+	return item
+}
+
+func primaryURLs(item *Item) []string {
+	var urls []string
+	for _, img := range item.Images() {
+		if img.IsPrimary() {
+			urls = append(urls, img.URL())
+		}
+	}
+	return urls
+}
+
+func TestItem_AddImage_SinglePrimary(t *testing.T) {
+	item := newImageTestItem(t)
+
+	first, _ := NewImage("http://example.com/first.jpg", "First", true)
+	second, _ := NewImage("http://example.com/second.jpg", "Second", true)
+	item.AddImage(first)
+	item.AddImage(second)
+
+	primaries := primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != second.URL() {
+		t.Errorf("Expected only %s to be primary, got %v", second.URL(), primaries)
+	}
+
+	third, _ := NewImage("http://example.com/third.jpg", "Third", false)
+	item.AddImage(third)
+
+	primaries = primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != second.URL() {
+		t.Errorf("Adding a non-primary image should keep %s primary, got %v", second.URL(), primaries)
+	}
+}
+
+func TestItem_SetPrimaryImage(t *testing.T) {
+	item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg")
+
+	if err := item.SetPrimaryImage("http://example.com/b.jpg"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	primaries := primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != "http://example.com/b.jpg" {
+		t.Errorf("Expected b.jpg to be the only primary, got %v", primaries)
+	}
+
+	if err := item.SetPrimaryImage("http://example.com/missing.jpg"); err != ErrImageNotFound {
+		t.Errorf("Expected ErrImageNotFound, got %v", err)
+	}
+}
+
+func TestItem_RemoveImage_PromotesNext(t *testing.T) {
+	item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg", "http://example.com/c.jpg")
+
+	if err := item.RemoveImage("http://example.com/a.jpg"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(item.Images()) != 2 {
+		t.Fatalf("Expected 2 images, got %d", len(item.Images()))
+	}
+
+	primaries := primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != "http://example.com/b.jpg" {
+		t.Errorf("Expected b.jpg to be promoted, got %v", primaries)
+	}
+}
+
+func TestItem_RemoveImage_LastPrimaryWrapsToFirst(t *testing.T) {
+	item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg")
+	_ = item.SetPrimaryImage("http://example.com/b.jpg")
+
+	if err := item.RemovePrimary(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	primaries := primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != "http://example.com/a.jpg" {
+		t.Errorf("Expected a.jpg to be promoted, got %v", primaries)
+	}
+}
+
+func TestItem_RemoveImage_NonPrimaryKeepsPrimary(t *testing.T) {
+	item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg")
+
+	if err := item.RemoveImage("http://example.com/b.jpg"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	primaries := primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != "http://example.com/a.jpg" {
+		t.Errorf("Expected a.jpg to stay primary, got %v", primaries)
+	}
+}
+
+func TestItem_RemoveImage_LastImage(t *testing.T) {
+	item := newImageTestItem(t, "http://example.com/a.jpg")
+
+	if err := item.RemovePrimary(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(item.Images()) != 0 {
+		t.Errorf("Expected no images, got %d", len(item.Images()))
+	}
+
+	if _, ok := item.PrimaryImage(); ok {
+		t.Error("Item without images should have no primary")
+	}
+
+	if err := item.RemoveImage("http://example.com/a.jpg"); err != ErrImageNotFound {
+		t.Errorf("Expected ErrImageNotFound, got %v", err)
+	}
+}
+
+func TestItem_RemoveImageAt(t *testing.T) {
+	t.Run("removing the first image promotes the next", func(t *testing.T) {
+		item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg", "http://example.com/c.jpg")
+
+		if err := item.RemoveImageAt(0); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if got := imageURLs(item); len(got) != 2 || got[0] != "http://example.com/b.jpg" || got[1] != "http://example.com/c.jpg" {
+			t.Fatalf("Expected b.jpg and c.jpg to remain, got %v", got)
+		}
+		primaries := primaryURLs(item)
+		if len(primaries) != 1 || primaries[0] != "http://example.com/b.jpg" {
+			t.Errorf("Expected b.jpg to be promoted, got %v", primaries)
+		}
+	})
+
+	t.Run("removing a later image keeps the primary", func(t *testing.T) {
+		item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg")
+
+		if err := item.RemoveImageAt(1); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		primaries := primaryURLs(item)
+		if len(primaries) != 1 || primaries[0] != "http://example.com/a.jpg" {
+			t.Errorf("Expected a.jpg to stay primary, got %v", primaries)
+		}
+	})
+
+	for _, index := range []int{-1, 2, 10} {
+		t.Run(fmt.Sprintf("index %d is out of range", index), func(t *testing.T) {
+			item := newImageTestItem(t, "http://example.com/a.jpg", "http://example.com/b.jpg")
+
+			err := item.RemoveImageAt(index)
+
+			if err == nil {
+				t.Fatal("Expected an out-of-range index to be rejected")
+			}
+			if len(item.Images()) != 2 {
+				t.Errorf("Expected the images to be kept, got %d", len(item.Images()))
+			}
+		})
+	}
 }
 
 func TestItem_SetAttribute(t *testing.T) {
@@ -109,6 +292,7 @@ func TestItem_SetAttribute(t *testing.T) {
 	t.Run("valid attribute", func(t *testing.T) {
 		attrs := item.Attributes()
 		attrs.Set("color", "red")
+		item.SetAttributes(attrs)
 
 		value, exists := item.Attributes().Get("color")
 		if !exists {
@@ -118,6 +302,61 @@ func TestItem_SetAttribute(t *testing.T) {
 			t.Errorf("Expected attribute value 'red', got %s", value)
 		}
 	})
+
+	t.Run("changes to the returned attributes are not saved until set", func(t *testing.T) {
+		attrs := item.Attributes()
+		attrs.Set("size", "L")
+
+		if _, exists := item.Attributes().Get("size"); exists {
+			t.Error("Expected the item to keep its attributes until SetAttributes")
+		}
+
+		item.SetAttributes(attrs)
+		attrs.Set("material", "cotton")
+
+		if _, exists := item.Attributes().Get("size"); !exists {
+			t.Error("Expected attribute 'size' to be saved")
+		}
+		if _, exists := item.Attributes().Get("material"); exists {
+			t.Error("Expected changes after SetAttributes to stay out of the item")
+		}
+	})
+}
+
+func TestItem_RemoveAttribute(t *testing.T) {
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+	item, _ := NewItem(sku, "Test Item", "Test Description", price, category)
+
+	attrs := item.Attributes()
+	attrs.Set("color", "red")
+	attrs.Set("size", "L")
+	item.SetAttributes(attrs)
+
+	before := item.UpdatedAt()
+	time.Sleep(time.Millisecond)
+
+	if !item.RemoveAttribute("color") {
+		t.Fatal("Expected removing an existing attribute to report true")
+	}
+	if _, exists := item.Attributes().Get("color"); exists {
+		t.Error("Expected attribute 'color' to be removed")
+	}
+	if all := item.Attributes().All(); len(all) != 1 || all["size"] != "L" {
+		t.Errorf("Expected only 'size' to remain, got %v", all)
+	}
+	if !item.UpdatedAt().After(before) {
+		t.Error("Expected UpdatedAt to advance after removing an attribute")
+	}
+
+	updated := item.UpdatedAt()
+	if item.RemoveAttribute("color") {
+		t.Error("Expected removing a missing attribute to report false")
+	}
+	if !item.UpdatedAt().Equal(updated) {
+		t.Error("Expected removing a missing attribute to leave UpdatedAt alone")
+	}
 }
 
 func TestItem_StatusMethods(t *testing.T) {
@@ -210,3 +449,669 @@ func TestItem_Timestamps(t *testing.T) {
 		t.Error("UpdatedAt should be updated when item is modified")
 	}
 }
+
+func TestReconstitute(t *testing.T) {
+	id := NewItemID()
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+	inventory, _ := NewInventory(42)
+	image, _ := NewImage("http://example.com/image.jpg", "Test Image", true)
+	attributes := NewAttributes()
+	attributes.Set("color", "red")
+	createdAt := time.Now().Add(-48 * time.Hour).UTC()
+	updatedAt := time.Now().Add(-1 * time.Hour).UTC()
+
+	item := Reconstitute(id, sku, "Test Item", "Test Description", price, category,
+		inventory, []Image{image}, attributes, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusActive, createdAt, updatedAt)
+
+	if !item.ID().Equals(id) {
+		t.Errorf("Expected ID %s, got %s", id.String(), item.ID().String())
+	}
+
+	if item.Status() != StatusActive {
+		t.Errorf("Expected status active, got %s", item.Status().String())
+	}
+
+	if item.Inventory().Quantity() != 42 {
+		t.Errorf("Expected inventory 42, got %d", item.Inventory().Quantity())
+	}
+
+	if len(item.Images()) != 1 || !item.Images()[0].IsPrimary() {
+		t.Errorf("Expected one primary image, got %v", item.Images())
+	}
+
+	if value, _ := item.Attributes().Get("color"); value != "red" {
+		t.Errorf("Expected attribute 'color' to be 'red', got %s", value)
+	}
+
+	if !item.CreatedAt().Equal(createdAt) || !item.UpdatedAt().Equal(updatedAt) {
+		t.Error("Timestamps should be preserved on reconstitution")
+	}
+}
+
+func imageURLs(item *Item) []string {
+	urls := make([]string, len(item.Images()))
+	for i, img := range item.Images() {
+		urls[i] = img.URL()
+	}
+	return urls
+}
+
+func TestItem_ReorderImages(t *testing.T) {
+	a, b, c := "http://example.com/a.jpg", "http://example.com/b.jpg", "http://example.com/c.jpg"
+	item := newImageTestItem(t, a, b, c)
+
+	if err := item.ReorderImages([]string{c, a, b}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got := imageURLs(item)
+	want := []string{c, a, b}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected order %v, got %v", want, got)
+		}
+	}
+
+	primaries := primaryURLs(item)
+	if len(primaries) != 1 || primaries[0] != a {
+		t.Errorf("Reordering should keep %s primary, got %v", a, primaries)
+	}
+}
+
+func TestItem_ReorderImages_Rejected(t *testing.T) {
+	a, b := "http://example.com/a.jpg", "http://example.com/b.jpg"
+
+	tests := []struct {
+		name    string
+		urls    []string
+		wantErr error
+	}{
+		{"missing URL", []string{a}, ErrImageOrderMismatch},
+		{"unknown URL", []string{a, "http://example.com/x.jpg"}, ErrImageNotFound},
+		{"duplicate URL", []string{a, a}, ErrImageOrderMismatch},
+		{"extra URL", []string{a, b, "http://example.com/x.jpg"}, ErrImageOrderMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := newImageTestItem(t, a, b)
+
+			if err := item.ReorderImages(tt.urls); err != tt.wantErr {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+
+			got := imageURLs(item)
+			if got[0] != a || got[1] != b {
+				t.Errorf("Rejected reorder should leave images untouched, got %v", got)
+			}
+		})
+	}
+}
+
+func TestItem_SetImages(t *testing.T) {
+	a, b, c := "http://example.com/a.jpg", "http://example.com/b.jpg", "http://example.com/c.jpg"
+
+	t.Run("no primary promotes the first image", func(t *testing.T) {
+		item := newImageTestItem(t, a)
+		imgB, _ := NewImage(b, "B", false)
+		imgC, _ := NewImage(c, "C", false)
+
+		if err := item.SetImages([]Image{imgB, imgC}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got := imageURLs(item)
+		if len(got) != 2 || got[0] != b || got[1] != c {
+			t.Fatalf("Expected images [%s %s], got %v", b, c, got)
+		}
+		if primaries := primaryURLs(item); len(primaries) != 1 || primaries[0] != b {
+			t.Errorf("Expected %s to be promoted to primary, got %v", b, primaries)
+		}
+	})
+
+	t.Run("keeps the given primary", func(t *testing.T) {
+		item := newImageTestItem(t)
+		imgA, _ := NewImage(a, "", false)
+		imgB, _ := NewImage(b, "", true)
+
+		if err := item.SetImages([]Image{imgA, imgB}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if primaries := primaryURLs(item); len(primaries) != 1 || primaries[0] != b {
+			t.Errorf("Expected %s to stay primary, got %v", b, primaries)
+		}
+	})
+
+	t.Run("empty set clears the images", func(t *testing.T) {
+		item := newImageTestItem(t, a, b)
+
+		if err := item.SetImages(nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(item.Images()) != 0 {
+			t.Errorf("Expected no images, got %v", imageURLs(item))
+		}
+	})
+}
+
+func TestItem_SetImages_Rejected(t *testing.T) {
+	a, b := "http://example.com/a.jpg", "http://example.com/b.jpg"
+	primaryA, _ := NewImage(a, "", true)
+	primaryB, _ := NewImage(b, "", true)
+	plainA, _ := NewImage(a, "", false)
+
+	tests := []struct {
+		name    string
+		images  []Image
+		wantErr error
+	}{
+		{"two primaries", []Image{primaryA, primaryB}, ErrMultiplePrimaryImages},
+		{"duplicate URL", []Image{primaryA, plainA}, ErrDuplicateImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := newImageTestItem(t, "http://example.com/old.jpg")
+
+			if err := item.SetImages(tt.images); err != tt.wantErr {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+
+			got := imageURLs(item)
+			if len(got) != 1 || got[0] != "http://example.com/old.jpg" {
+				t.Errorf("Rejected set should leave images untouched, got %v", got)
+			}
+		})
+	}
+
+	t.Run("invalid image", func(t *testing.T) {
+		item := newImageTestItem(t)
+		if err := item.SetImages([]Image{{}}); err == nil {
+			t.Error("Expected an image without a URL to be rejected")
+		}
+	})
+}
+
+func TestItem_TransitionTo(t *testing.T) {
+	statuses := []Status{StatusDraft, StatusActive, StatusInactive, StatusArchived}
+
+	allowed := map[Status]map[Status]bool{
+		StatusDraft:    {StatusDraft: true, StatusActive: true, StatusArchived: true},
+		StatusActive:   {StatusActive: true, StatusInactive: true, StatusArchived: true},
+		StatusInactive: {StatusInactive: true, StatusActive: true, StatusArchived: true},
+		StatusArchived: {StatusArchived: true, StatusDraft: true},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(from.String()+"->"+to.String(), func(t *testing.T) {
+				sku, _ := NewSKU("TEST-001")
+				price, _ := NewPrice(99.99, "USD")
+				category, _ := NewCategory("Electronics")
+				item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+					Inventory{}, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, from, time.Now(), time.Now())
+
+				err := item.TransitionTo(to)
+
+				if allowed[from][to] {
+					if err != nil {
+						t.Fatalf("Expected %s -> %s to be allowed, got %v", from, to, err)
+					}
+					if item.Status() != to {
+						t.Errorf("Expected status %s, got %s", to, item.Status())
+					}
+					return
+				}
+
+				transitionErr, ok := err.(*StatusTransitionError)
+				if !ok {
+					t.Fatalf("Expected StatusTransitionError for %s -> %s, got %v", from, to, err)
+				}
+				if transitionErr.From != from || transitionErr.To != to {
+					t.Errorf("Expected error for %s -> %s, got %s -> %s", from, to, transitionErr.From, transitionErr.To)
+				}
+				if item.Status() != from {
+					t.Errorf("Illegal transition should leave status %s, got %s", from, item.Status())
+				}
+			})
+		}
+	}
+}
+
+func TestItem_Unarchive(t *testing.T) {
+	newItem := func(status Status, quantity int) *Item {
+		sku, _ := NewSKU("TEST-001")
+		price, _ := NewPrice(99.99, "USD")
+		category, _ := NewCategory("Electronics")
+		inventory, _ := NewInventory(quantity)
+		return Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+			inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, status, time.Now(), time.Now())
+	}
+
+	t.Run("a zero-inventory item lands in draft and cannot be activated until restocked", func(t *testing.T) {
+		item := newItem(StatusArchived, 0)
+
+		if err := item.Unarchive(); err != nil {
+			t.Fatalf("Expected unarchiving to be allowed, got %v", err)
+		}
+		if item.Status() != StatusDraft {
+			t.Fatalf("Expected status draft, got %s", item.Status())
+		}
+
+		err := item.Activate()
+		if !errors.Is(err, ErrOutOfStock) {
+			t.Fatalf("Expected ErrOutOfStock, got %v", err)
+		}
+		if item.Status() != StatusDraft {
+			t.Errorf("Refused activation should leave status draft, got %s", item.Status())
+		}
+
+		restocked, _ := NewInventory(5)
+		item.SetInventory(restocked)
+		if err := item.Activate(); err != nil {
+			t.Fatalf("Expected a restocked item to be activated, got %v", err)
+		}
+		if item.Status() != StatusActive {
+			t.Errorf("Expected status active, got %s", item.Status())
+		}
+	})
+
+	t.Run("records the status change", func(t *testing.T) {
+		item := newItem(StatusArchived, 3)
+
+		if err := item.Unarchive(); err != nil {
+			t.Fatalf("Expected unarchiving to be allowed, got %v", err)
+		}
+
+		events := item.PullEvents()
+		if len(events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(events))
+		}
+		changed, ok := events[0].(*ItemStatusChangedEvent)
+		if !ok {
+			t.Fatalf("Expected ItemStatusChangedEvent, got %T", events[0])
+		}
+		if changed.OldStatus != StatusArchived || changed.NewStatus != StatusDraft {
+			t.Errorf("Expected archived -> draft, got %s -> %s", changed.OldStatus, changed.NewStatus)
+		}
+	})
+
+	for _, status := range []Status{StatusDraft, StatusActive, StatusInactive} {
+		t.Run("refuses "+status.String()+" items", func(t *testing.T) {
+			item := newItem(status, 3)
+
+			err := item.Unarchive()
+
+			var transitionErr *StatusTransitionError
+			if !errors.As(err, &transitionErr) {
+				t.Fatalf("Expected StatusTransitionError, got %v", err)
+			}
+			if item.Status() != status {
+				t.Errorf("Expected status %s to be kept, got %s", status, item.Status())
+			}
+		})
+	}
+}
+
+func TestItem_ArchiveIfSoldOut(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     Status
+		quantity   int
+		wantStatus Status
+	}{
+		{name: "sold-out active item is archived", status: StatusActive, quantity: 0, wantStatus: StatusArchived},
+		{name: "active item with stock is kept", status: StatusActive, quantity: 3, wantStatus: StatusActive},
+		{name: "sold-out draft is kept", status: StatusDraft, quantity: 0, wantStatus: StatusDraft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sku, _ := NewSKU("TEST-001")
+			price, _ := NewPrice(99.99, "USD")
+			category, _ := NewCategory("Electronics")
+			inventory, _ := NewInventory(tt.quantity)
+			item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+				inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, tt.status, time.Now(), time.Now())
+
+			archived := item.ArchiveIfSoldOut()
+
+			if archived != (tt.wantStatus != tt.status) {
+				t.Errorf("Expected archived to be %v, got %v", tt.wantStatus != tt.status, archived)
+			}
+			if item.Status() != tt.wantStatus {
+				t.Errorf("Expected status %s, got %s", tt.wantStatus, item.Status())
+			}
+			if got := len(item.Events()); archived && got != 1 {
+				t.Errorf("Expected one status change event, got %d", got)
+			}
+		})
+	}
+}
+
+func TestItem_Activate(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     Status
+		quantity   int
+		wantStatus Status
+		wantErr    error
+	}{
+		{"draft with stock", StatusDraft, 5, StatusActive, nil},
+		{"inactive with stock", StatusInactive, 5, StatusActive, nil},
+		{"draft without stock", StatusDraft, 0, StatusDraft, ErrOutOfStock},
+		{"inactive without stock", StatusInactive, 0, StatusInactive, ErrOutOfStock},
+		{"already active without stock", StatusActive, 0, StatusActive, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sku, _ := NewSKU("TEST-001")
+			price, _ := NewPrice(99.99, "USD")
+			category, _ := NewCategory("Electronics")
+			inventory, _ := NewInventory(tt.quantity)
+			item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+				inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, tt.status, time.Now(), time.Now())
+
+			err := item.Activate()
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if item.Status() != tt.wantStatus {
+				t.Errorf("Expected status %s, got %s", tt.wantStatus, item.Status())
+			}
+		})
+	}
+
+	t.Run("archived items must be unarchived first", func(t *testing.T) {
+		sku, _ := NewSKU("TEST-001")
+		price, _ := NewPrice(99.99, "USD")
+		category, _ := NewCategory("Electronics")
+		inventory, _ := NewInventory(5)
+		item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+			inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusArchived, time.Now(), time.Now())
+
+		var transitionErr *StatusTransitionError
+		if err := item.Activate(); !errors.As(err, &transitionErr) {
+			t.Fatalf("Expected StatusTransitionError, got %v", err)
+		}
+	})
+}
+
+func TestItem_CanBeDeleted(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    Status
+		quantity  int
+		wantError bool
+	}{
+		{"active with stock", StatusActive, 5, true},
+		{"active without stock", StatusActive, 0, false},
+		{"draft with stock", StatusDraft, 5, false},
+		{"inactive with stock", StatusInactive, 5, false},
+		{"archived with stock", StatusArchived, 5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sku, _ := NewSKU("TEST-001")
+			price, _ := NewPrice(99.99, "USD")
+			category, _ := NewCategory("Electronics")
+			inventory, _ := NewInventory(tt.quantity)
+			item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+				inventory, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, tt.status, time.Now(), time.Now())
+
+			err := item.CanBeDeleted()
+
+			if tt.wantError && err == nil {
+				t.Fatal("Expected deletion to be refused")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected deletion to be allowed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestItem_ChangeCategory(t *testing.T) {
+	t.Run("a valid change updates name and slug and records the change", func(t *testing.T) {
+		itm := newImageTestItem(t)
+		itm.PullEvents()
+		garden, _ := NewCategory("Home & Garden")
+
+		if err := itm.ChangeCategory(garden); err != nil {
+			t.Fatalf("Expected the change to be allowed, got %v", err)
+		}
+
+		if itm.Category().Name() != "Home & Garden" || itm.Category().Slug() != "home-garden" {
+			t.Errorf("Expected Home & Garden (home-garden), got %s (%s)", itm.Category().Name(), itm.Category().Slug())
+		}
+		events := itm.PullEvents()
+		if len(events) != 1 {
+			t.Fatalf("Expected one event, got %d", len(events))
+		}
+		changed, ok := events[0].(*ItemCategoryChangedEvent)
+		if !ok {
+			t.Fatalf("Expected an ItemCategoryChangedEvent, got %T", events[0])
+		}
+		if changed.OldCategory.Slug() != "electronics" || changed.NewCategory.Slug() != "home-garden" {
+			t.Errorf("Expected electronics to home-garden, got %s to %s", changed.OldCategory.Slug(), changed.NewCategory.Slug())
+		}
+	})
+
+	t.Run("the restricted category is rejected", func(t *testing.T) {
+		itm := newImageTestItem(t)
+		itm.PullEvents()
+		restricted, _ := NewCategory("Restricted")
+
+		err := itm.ChangeCategory(restricted)
+
+		if !errors.Is(err, ErrRestrictedCategory) {
+			t.Fatalf("Expected ErrRestrictedCategory, got %v", err)
+		}
+		if itm.Category().Slug() != "electronics" {
+			t.Errorf("Expected the category to be unchanged, got %s", itm.Category().Slug())
+		}
+		if len(itm.Events()) != 0 {
+			t.Errorf("Expected no events, got %d", len(itm.Events()))
+		}
+	})
+
+	t.Run("the zero category is rejected", func(t *testing.T) {
+		itm := newImageTestItem(t)
+
+		if err := itm.ChangeCategory(Category{}); err == nil {
+			t.Fatal("Expected an empty category to be rejected")
+		}
+	})
+
+	t.Run("the current category is a no-op", func(t *testing.T) {
+		itm := newImageTestItem(t)
+		itm.PullEvents()
+
+		if err := itm.ChangeCategory(itm.Category()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(itm.Events()) != 0 {
+			t.Errorf("Expected no events, got %d", len(itm.Events()))
+		}
+	})
+}
+
+func TestItem_Summary(t *testing.T) {
+	itm := newImageTestItem(t, "https://example.com/a.jpg")
+
+	if itm.Summary().InStock {
+		t.Error("Expected an item without stock not to be in stock")
+	}
+
+	// Reserved units are still on hand
+	inventory, _ := NewInventoryWithReserved(2, 2)
+	itm.SetInventory(inventory)
+
+	summary := itm.Summary()
+	if !summary.InStock {
+		t.Error("Expected an item with units on hand to be in stock")
+	}
+	if summary.ID != itm.ID() || summary.SKU != itm.SKU() || summary.Name != itm.Name() {
+		t.Errorf("Expected the summary to carry the item's identity, got %+v", summary)
+	}
+	if !summary.Price.Equals(itm.Price()) || summary.Category.Slug() != itm.Category().Slug() || summary.Status != itm.Status() {
+		t.Errorf("Expected the summary to carry the item's price, category and status, got %+v", summary)
+	}
+}
+
+func TestItem_Clone(t *testing.T) {
+	source := newImageTestItem(t, "https://example.com/a.jpg", "https://example.com/b.jpg")
+	attrs := source.Attributes()
+	if err := attrs.Set("color", "red"); err != nil {
+		t.Fatal(err)
+	}
+	source.SetAttributes(attrs)
+	inventory, _ := NewInventory(40)
+	source.SetInventory(inventory)
+	barcode, _ := NewBarcode("4006381333931")
+	source.SetBarcode(barcode)
+	brand, _ := NewBrand("Acme")
+	source.SetBrand(brand)
+	if err := source.TransitionTo(StatusActive); err != nil {
+		t.Fatal(err)
+	}
+
+	sku, _ := NewSKU("TEST-002")
+	clone, err := source.Clone(sku)
+	if err != nil {
+		t.Fatalf("Expected clone to succeed, got %v", err)
+	}
+
+	if clone.ID() == source.ID() {
+		t.Error("Expected the clone to get a new ID")
+	}
+	if clone.SKU() != sku {
+		t.Errorf("Expected SKU %s, got %s", sku, clone.SKU())
+	}
+	if clone.Name() != source.Name() || clone.Description() != source.Description() || clone.Price() != source.Price() || clone.Category() != source.Category() {
+		t.Error("Expected name, description, price and category to be copied")
+	}
+	if color, _ := clone.Attributes().Get("color"); color != "red" {
+		t.Errorf("Expected attribute color=red, got %q", color)
+	}
+	if got := imageURLs(clone); len(got) != 2 || got[0] != "https://example.com/a.jpg" {
+		t.Errorf("Expected images to be copied, got %v", got)
+	}
+	if clone.Brand() != brand {
+		t.Errorf("Expected brand %v, got %v", brand, clone.Brand())
+	}
+	if clone.Status() != StatusDraft {
+		t.Errorf("Expected a draft, got %s", clone.Status())
+	}
+	if clone.Inventory().Quantity() != 0 {
+		t.Errorf("Expected no stock, got %d", clone.Inventory().Quantity())
+	}
+	if !clone.Barcode().IsZero() {
+		t.Errorf("Expected no barcode, got %s", clone.Barcode())
+	}
+
+	// Later changes to the clone leave the source alone
+	cloneAttrs := clone.Attributes()
+	if err := cloneAttrs.Set("color", "blue"); err != nil {
+		t.Fatal(err)
+	}
+	clone.SetAttributes(cloneAttrs)
+	if color, _ := source.Attributes().Get("color"); color != "red" {
+		t.Errorf("Expected the source attribute to stay red, got %q", color)
+	}
+}
+
+func TestItem_Clone_Archived(t *testing.T) {
+	source := newImageTestItem(t)
+	if err := source.TransitionTo(StatusArchived); err != nil {
+		t.Fatal(err)
+	}
+
+	sku, _ := NewSKU("TEST-002")
+	if _, err := source.Clone(sku); err == nil {
+		t.Error("Expected cloning an archived item to fail")
+	}
+}
+
+func TestItem_SetPriceRecordsEvent(t *testing.T) {
+	item := newImageTestItem(t)
+	item.PullEvents() // discard ItemCreated
+
+	oldPrice := item.Price()
+	newPrice, _ := NewPrice(149.99, "USD")
+
+	item.SetPrice(newPrice)
+
+	events := item.PullEvents()
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	changed, ok := events[0].(*ItemPriceChangedEvent)
+	if !ok {
+		t.Fatalf("Expected ItemPriceChangedEvent, got %T", events[0])
+	}
+	if changed.OldPrice != oldPrice || changed.NewPrice != newPrice {
+		t.Errorf("Expected %s -> %s, got %s -> %s", oldPrice, newPrice, changed.OldPrice, changed.NewPrice)
+	}
+	if changed.AggregateID() != item.ID().String() {
+		t.Errorf("Expected aggregate ID %s, got %s", item.ID(), changed.AggregateID())
+	}
+
+	if remaining := item.PullEvents(); len(remaining) != 0 {
+		t.Errorf("Expected events to be cleared, got %d", len(remaining))
+	}
+
+	item.SetPrice(newPrice)
+	if events := item.PullEvents(); len(events) != 0 {
+		t.Errorf("Setting the same price should not raise an event, got %d", len(events))
+	}
+}
+
+func TestItem_RaisesLifecycleEvents(t *testing.T) {
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+
+	item, err := NewItem(sku, "Test Item", "", price, category)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	inventory, _ := NewInventory(10)
+	item.SetInventory(inventory)
+	if err := item.TransitionTo(StatusActive); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	reserved, _ := inventory.Reserve(2)
+	item.SetInventory(reserved) // reservations alone do not change stock
+
+	events := item.PullEvents()
+	want := []string{"ItemCreated", "ItemInventoryUpdated", "ItemStatusChanged"}
+	if len(events) != len(want) {
+		t.Fatalf("Expected %d events, got %d", len(want), len(events))
+	}
+	for idx, event := range events {
+		if event.EventType() != want[idx] {
+			t.Errorf("Event %d: expected %s, got %s", idx, want[idx], event.EventType())
+		}
+	}
+}
+
+func TestReconstitute_RaisesNoEvents(t *testing.T) {
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+
+	item := Reconstitute(NewItemID(), sku, "Test Item", "", price, category,
+		Inventory{}, nil, Attributes{}, Dimensions{}, Weight{}, Barcode{}, Brand{}, StatusActive, time.Now(), time.Now())
+
+	if events := item.PullEvents(); len(events) != 0 {
+		t.Errorf("Expected no events, got %d", len(events))
+	}
+}
@@ -2,44 +2,137 @@ package item
 
 import "fmt"
 
+// ErrorCode identifies the kind of failure a DomainError reports
+// Codes are stable, so callers branch on them instead of on messages
+type ErrorCode string
+
+const (
+	// CodeInvalidValue is the code of errors made with NewDomainError: a value that breaks a rule
+	CodeInvalidValue           ErrorCode = "invalid_value"
+	CodeInvalidItemID          ErrorCode = "invalid_item_id"
+	CodeItemNotFound           ErrorCode = "item_not_found"
+	CodeItemAlreadyExists      ErrorCode = "item_already_exists"
+	CodeInvalidSKU             ErrorCode = "invalid_sku"
+	CodeInvalidPrice           ErrorCode = "invalid_price"
+	CodeCurrencyMismatch       ErrorCode = "currency_mismatch"
+	CodeInsufficientStock      ErrorCode = "insufficient_stock"
+	CodeReleaseExceedsReserved ErrorCode = "release_exceeds_reserved"
+	CodeItemInUse              ErrorCode = "item_in_use"
+	CodeItemArchived           ErrorCode = "item_archived"
+	CodeImageNotFound          ErrorCode = "image_not_found"
+	CodeImageOrderMismatch     ErrorCode = "image_order_mismatch"
+	CodeDuplicateImage         ErrorCode = "duplicate_image"
+	CodeMultiplePrimaryImages  ErrorCode = "multiple_primary_images"
+	CodeRestrictedCategory     ErrorCode = "restricted_category"
+	CodeOutOfStock             ErrorCode = "out_of_stock"
+	CodeCategoryNotFound       ErrorCode = "category_not_found"
+)
+
 // DomainError represents an error in the item domain
 type DomainError struct {
+	Code    ErrorCode
 	message string
 }
 
+// NewDomainError creates an error with CodeInvalidValue
 func NewDomainError(message string) *DomainError {
-	return &DomainError{message: message}
+	return NewDomainErrorWithCode(CodeInvalidValue, message)
+}
+
+// NewDomainErrorWithCode creates an error reporting a specific kind of failure
+func NewDomainErrorWithCode(code ErrorCode, message string) *DomainError {
+	return &DomainError{Code: code, message: message}
 }
 
 func (e *DomainError) Error() string {
 	return e.message
 }
 
+// Is matches another DomainError with the same code, so errors.Is(ItemNotFoundError(id), ErrItemNotFound)
+// holds while an invalid price never matches a missing item
 func (e *DomainError) Is(target error) bool {
-	_, ok := target.(*DomainError)
-	return ok
+	t, ok := target.(*DomainError)
+	return ok && t.Code == e.Code
 }
 
-// Specific domain errors
+// Specific domain errors; errors.Is matches each against every error with its code
 var (
-	ErrItemNotFound     = &DomainError{message: "item not found"}
-	ErrItemAlreadyExists = &DomainError{message: "item already exists"}
-	ErrInvalidSKU       = &DomainError{message: "invalid SKU format"}
-	ErrInvalidPrice     = &DomainError{message: "invalid price"}
-	ErrInsufficientStock = &DomainError{message: "insufficient stock"}
+	ErrInvalidItemID          = &DomainError{Code: CodeInvalidItemID, message: "invalid item ID format"}
+	ErrItemNotFound           = &DomainError{Code: CodeItemNotFound, message: "item not found"}
+	ErrItemAlreadyExists      = &DomainError{Code: CodeItemAlreadyExists, message: "item already exists"}
+	ErrInvalidSKU             = &DomainError{Code: CodeInvalidSKU, message: "invalid SKU format"}
+	ErrInvalidPrice           = &DomainError{Code: CodeInvalidPrice, message: "invalid price"}
+	ErrCurrencyMismatch       = &DomainError{Code: CodeCurrencyMismatch, message: "currency mismatch"}
+	ErrInsufficientStock      = &DomainError{Code: CodeInsufficientStock, message: "insufficient stock"}
+	ErrReleaseExceedsReserved = &DomainError{Code: CodeReleaseExceedsReserved, message: "cannot release more than reserved"}
+	ErrItemInUse              = &DomainError{Code: CodeItemInUse, message: "item in use"}
+	ErrItemArchived           = &DomainError{Code: CodeItemArchived, message: "item archived"}
+	ErrImageNotFound          = &DomainError{Code: CodeImageNotFound, message: "image not found"}
+	ErrImageOrderMismatch     = &DomainError{Code: CodeImageOrderMismatch, message: "image order must list every image exactly once"}
+	ErrDuplicateImage         = &DomainError{Code: CodeDuplicateImage, message: "image URL is listed more than once"}
+	ErrMultiplePrimaryImages  = &DomainError{Code: CodeMultiplePrimaryImages, message: "only one image can be primary"}
+	ErrRestrictedCategory     = &DomainError{Code: CodeRestrictedCategory, message: "restricted category not allowed"}
+	ErrOutOfStock             = &DomainError{Code: CodeOutOfStock, message: "item out of stock"}
+	ErrCategoryNotFound       = &DomainError{Code: CodeCategoryNotFound, message: "category not found"}
 )
 
 // ItemNotFoundError creates a specific error for item not found by ID
 func ItemNotFoundError(id ItemID) error {
-	return &DomainError{message: fmt.Sprintf("item with ID %s not found", id.String())}
+	return &DomainError{Code: CodeItemNotFound, message: fmt.Sprintf("item with ID %s not found", id.String())}
 }
 
 // ItemNotFoundBySKUError creates a specific error for item not found by SKU
 func ItemNotFoundBySKUError(sku SKU) error {
-	return &DomainError{message: fmt.Sprintf("item with SKU %s not found", sku.String())}
+	return &DomainError{Code: CodeItemNotFound, message: fmt.Sprintf("item with SKU %s not found", sku.String())}
+}
+
+// ItemNotFoundByBarcodeError creates a specific error for item not found by barcode
+func ItemNotFoundByBarcodeError(barcode Barcode) error {
+	return &DomainError{Code: CodeItemNotFound, message: fmt.Sprintf("item with barcode %s not found", barcode.String())}
 }
 
 // DuplicateSKUError creates a specific error for duplicate SKU
 func DuplicateSKUError(sku SKU) error {
-	return &DomainError{message: fmt.Sprintf("item with SKU %s already exists", sku.String())}
-} 
\ No newline at end of file
+	return &DomainError{Code: CodeItemAlreadyExists, message: fmt.Sprintf("item with SKU %s already exists", sku.String())}
+} 
+
+// ItemInUseError reports an item that cannot be deleted while it is active and in stock
+func ItemInUseError(id ItemID, inventory Inventory) error {
+	return &DomainError{Code: CodeItemInUse, message: fmt.Sprintf("item with ID %s is active with %d units in stock, %d of them reserved",
+		id.String(), inventory.Quantity(), inventory.Reserved())}
+}
+
+// ItemArchivedError reports an archived item used where only catalog items are allowed
+func ItemArchivedError(id ItemID) error {
+	return &DomainError{Code: CodeItemArchived, message: fmt.Sprintf("item with ID %s is archived", id.String())}
+}
+
+// OutOfStockError reports an item that cannot be put on sale because it has no stock
+func OutOfStockError(id ItemID) error {
+	return &DomainError{Code: CodeOutOfStock, message: fmt.Sprintf("item with ID %s has no stock; restock it before activating", id.String())}
+}
+
+// CategoryNotFoundError reports a category no item or category hierarchy entry uses
+func CategoryNotFoundError(category Category) error {
+	return &DomainError{Code: CodeCategoryNotFound, message: fmt.Sprintf("category %q not found", category.Name())}
+}
+
+// RestrictedCategoryError reports an item placed in a category items may not be sold in
+func RestrictedCategoryError(category Category) error {
+	return &DomainError{Code: CodeRestrictedCategory, message: fmt.Sprintf("category %q is restricted", category.Name())}
+}
+
+// CurrencyMismatchError reports arithmetic or comparison between prices in different currencies
+func CurrencyMismatchError(a, b string) error {
+	return &DomainError{Code: CodeCurrencyMismatch, message: fmt.Sprintf("currency mismatch: %s and %s", a, b)}
+}
+
+// StatusTransitionError reports an illegal status change
+type StatusTransitionError struct {
+	From Status
+	To   Status
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("cannot change item status from %s to %s", e.From.String(), e.To.String())
+}
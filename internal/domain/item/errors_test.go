@@ -2,6 +2,7 @@ package item
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,4 +75,36 @@ func TestDuplicateSKUError(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), sku.String())
 	assert.Contains(t, err.Error(), "already exists")
-} 
\ No newline at end of file
+} 
+
+func TestDomainError_IsComparesCodes(t *testing.T) {
+	id := NewItemID()
+	sku, _ := NewSKU("TEST-001")
+	_, priceErr := NewPrice(-1, "USD")
+
+	assert.True(t, errors.Is(ItemNotFoundError(id), ErrItemNotFound))
+	assert.True(t, errors.Is(ItemNotFoundBySKUError(sku), ErrItemNotFound))
+	assert.True(t, errors.Is(fmt.Errorf("failed to find item: %w", ItemNotFoundError(id)), ErrItemNotFound))
+	assert.True(t, errors.Is(DuplicateSKUError(sku), ErrItemAlreadyExists))
+
+	assert.True(t, errors.Is(priceErr, ErrInvalidPrice))
+	assert.False(t, errors.Is(priceErr, ErrItemNotFound))
+	assert.False(t, errors.Is(ItemNotFoundError(id), ErrInvalidPrice))
+	assert.False(t, errors.Is(NewDomainError("name too short"), ErrItemNotFound))
+
+	_, idErr := NewItemIDFromString("not-a-uuid")
+	assert.True(t, errors.Is(idErr, ErrInvalidItemID))
+	assert.False(t, errors.Is(idErr, ErrItemNotFound))
+}
+
+func TestDomainError_Code(t *testing.T) {
+	var domainErr *DomainError
+
+	inventory, _ := NewInventory(2)
+	_, err := inventory.Reserve(5)
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, CodeInsufficientStock, domainErr.Code)
+
+	assert.Equal(t, CodeInvalidValue, NewDomainError("anything").Code)
+	assert.Equal(t, CodeItemArchived, NewDomainErrorWithCode(CodeItemArchived, "archived").Code)
+}
@@ -140,6 +140,31 @@ func (e *ItemStatusChangedEvent) EventData() interface{} {
 	}
 }
 
+// ItemCategoryChangedEvent is raised when an item moves to another category
+type ItemCategoryChangedEvent struct {
+	BaseDomainEvent
+	ItemID      ItemID
+	OldCategory Category
+	NewCategory Category
+}
+
+func NewItemCategoryChangedEvent(itemID ItemID, oldCategory, newCategory Category) *ItemCategoryChangedEvent {
+	return &ItemCategoryChangedEvent{
+		BaseDomainEvent: NewBaseDomainEvent("ItemCategoryChanged", itemID.String()),
+		ItemID:          itemID,
+		OldCategory:     oldCategory,
+		NewCategory:     newCategory,
+	}
+}
+
+func (e *ItemCategoryChangedEvent) EventData() interface{} {
+	return map[string]interface{}{
+		"itemId":      e.ItemID.String(),
+		"oldCategory": e.OldCategory.Slug(),
+		"newCategory": e.NewCategory.Slug(),
+	}
+}
+
 // ItemDeletedEvent is raised when an item is deleted
 type ItemDeletedEvent struct {
 	BaseDomainEvent
@@ -0,0 +1,108 @@
+package item
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// LengthUnit is the unit item dimensions are measured in
+type LengthUnit string
+
+const (
+	LengthUnitMillimeter LengthUnit = "mm"
+	LengthUnitCentimeter LengthUnit = "cm"
+	LengthUnitMeter      LengthUnit = "m"
+	LengthUnitInch       LengthUnit = "in"
+)
+
+// WeightUnit is the unit an item's weight is measured in
+type WeightUnit string
+
+const (
+	WeightUnitGram     WeightUnit = "g"
+	WeightUnitKilogram WeightUnit = "kg"
+	WeightUnitOunce    WeightUnit = "oz"
+	WeightUnitPound    WeightUnit = "lb"
+)
+
+// Dimensions is a value object for the size of an item's package
+// The zero value means the dimensions are unknown
+type Dimensions struct {
+	length float64
+	width  float64
+	height float64
+	unit   LengthUnit
+}
+
+// NewDimensions validates that every side is a positive, finite number in a known unit
+func NewDimensions(length, width, height float64, unit string) (Dimensions, error) {
+	for _, side := range []struct {
+		name  string
+		value float64
+	}{{"length", length}, {"width", width}, {"height", height}} {
+		if err := validateMeasure(side.name, side.value); err != nil {
+			return Dimensions{}, err
+		}
+	}
+
+	lengthUnit := LengthUnit(strings.ToLower(strings.TrimSpace(unit)))
+	switch lengthUnit {
+	case LengthUnitMillimeter, LengthUnitCentimeter, LengthUnitMeter, LengthUnitInch:
+	default:
+		return Dimensions{}, NewDomainError(fmt.Sprintf("unsupported length unit %q", unit))
+	}
+
+	return Dimensions{length: length, width: width, height: height, unit: lengthUnit}, nil
+}
+
+func (d Dimensions) Length() float64  { return d.length }
+func (d Dimensions) Width() float64   { return d.width }
+func (d Dimensions) Height() float64  { return d.height }
+func (d Dimensions) Unit() LengthUnit { return d.unit }
+
+// IsZero reports whether no dimensions have been set
+func (d Dimensions) IsZero() bool {
+	return d == Dimensions{}
+}
+
+// Weight is a value object for how heavy an item is
+// The zero value means the weight is unknown
+type Weight struct {
+	value float64
+	unit  WeightUnit
+}
+
+// NewWeight validates that value is a positive, finite number in a known unit
+func NewWeight(value float64, unit string) (Weight, error) {
+	if err := validateMeasure("weight", value); err != nil {
+		return Weight{}, err
+	}
+
+	weightUnit := WeightUnit(strings.ToLower(strings.TrimSpace(unit)))
+	switch weightUnit {
+	case WeightUnitGram, WeightUnitKilogram, WeightUnitOunce, WeightUnitPound:
+	default:
+		return Weight{}, NewDomainError(fmt.Sprintf("unsupported weight unit %q", unit))
+	}
+
+	return Weight{value: value, unit: weightUnit}, nil
+}
+
+func (w Weight) Value() float64   { return w.value }
+func (w Weight) Unit() WeightUnit { return w.unit }
+
+// IsZero reports whether no weight has been set
+func (w Weight) IsZero() bool {
+	return w == Weight{}
+}
+
+func validateMeasure(name string, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return NewDomainError(fmt.Sprintf("%s must be a finite number", name))
+	}
+	if value <= 0 {
+		return NewDomainError(fmt.Sprintf("%s must be positive", name))
+	}
+	return nil
+}
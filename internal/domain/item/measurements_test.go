@@ -0,0 +1,86 @@
+package item
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDimensions(t *testing.T) {
+	dims, err := NewDimensions(30, 20, 10.5, " CM ")
+	require.NoError(t, err)
+	assert.Equal(t, 30.0, dims.Length())
+	assert.Equal(t, 20.0, dims.Width())
+	assert.Equal(t, 10.5, dims.Height())
+	assert.Equal(t, LengthUnitCentimeter, dims.Unit())
+	assert.False(t, dims.IsZero())
+
+	tests := []struct {
+		name                  string
+		length, width, height float64
+		unit                  string
+		wantErr               string
+	}{
+		{"negative length", -1, 20, 10, "cm", "length must be positive"},
+		{"negative height", 30, 20, -0.5, "cm", "height must be positive"},
+		{"zero width", 30, 0, 10, "cm", "width must be positive"},
+		{"NaN", math.NaN(), 20, 10, "cm", "length must be a finite number"},
+		{"infinite", 30, math.Inf(1), 10, "cm", "width must be a finite number"},
+		{"unknown unit", 30, 20, 10, "furlong", `unsupported length unit "furlong"`},
+		{"missing unit", 30, 20, 10, "", `unsupported length unit ""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dims, err := NewDimensions(tt.length, tt.width, tt.height, tt.unit)
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.True(t, dims.IsZero())
+		})
+	}
+}
+
+func TestNewWeight(t *testing.T) {
+	weight, err := NewWeight(1.2, "kg")
+	require.NoError(t, err)
+	assert.Equal(t, 1.2, weight.Value())
+	assert.Equal(t, WeightUnitKilogram, weight.Unit())
+	assert.False(t, weight.IsZero())
+
+	_, err = NewWeight(-3, "kg")
+	assert.EqualError(t, err, "weight must be positive")
+
+	_, err = NewWeight(0, "g")
+	assert.EqualError(t, err, "weight must be positive")
+
+	_, err = NewWeight(math.NaN(), "lb")
+	assert.EqualError(t, err, "weight must be a finite number")
+
+	_, err = NewWeight(5, "stone")
+	assert.EqualError(t, err, `unsupported weight unit "stone"`)
+}
+
+func TestItem_SetMeasurements(t *testing.T) {
+	sku, _ := NewSKU("TEST-001")
+	price, _ := NewPrice(99.99, "USD")
+	category, _ := NewCategory("Electronics")
+	itm, err := NewItem(sku, "Test Item", "Test Description", price, category)
+	require.NoError(t, err)
+
+	dims, err := NewDimensions(30, 20, 10, "cm")
+	require.NoError(t, err)
+	weight, err := NewWeight(500, "g")
+	require.NoError(t, err)
+
+	itm.SetDimensions(dims)
+	itm.SetWeight(weight)
+	assert.Equal(t, dims, itm.Dimensions())
+	assert.Equal(t, weight, itm.Weight())
+
+	itm.SetDimensions(Dimensions{})
+	itm.SetWeight(Weight{})
+	assert.True(t, itm.Dimensions().IsZero())
+	assert.True(t, itm.Weight().IsZero())
+}
@@ -0,0 +1,93 @@
+package item
+
+import (
+	"strconv"
+	"strings"
+)
+
+// currencyFormat is how amounts in one currency are shown
+type currencyFormat struct {
+	symbol string
+	// decimals is the number of minor-unit digits shown; prices are still stored in hundredths
+	decimals int
+}
+
+// currencyFormats covers the supported currencies; any other currency is shown by its code with two decimals
+var currencyFormats = map[string]currencyFormat{
+	"USD": {symbol: "$", decimals: 2},
+	"EUR": {symbol: "€", decimals: 2},
+	"GBP": {symbol: "£", decimals: 2},
+	"JPY": {symbol: "¥", decimals: 0},
+}
+
+// localeFormat is how numbers and currency symbols are laid out in one language
+type localeFormat struct {
+	group       string
+	decimal     string
+	symbolAfter bool
+}
+
+// localeFormats is keyed by language; English is used for any language not listed
+var localeFormats = map[string]localeFormat{
+	"en": {group: ",", decimal: "."},
+	"ja": {group: ",", decimal: "."},
+	"de": {group: ".", decimal: ",", symbolAfter: true},
+	"es": {group: ".", decimal: ",", symbolAfter: true},
+	"it": {group: ".", decimal: ",", symbolAfter: true},
+	"nl": {group: ".", decimal: ",", symbolAfter: true},
+	"fr": {group: " ", decimal: ",", symbolAfter: true},
+}
+
+// Format renders the price for display in locale, a language tag such as "en-US" or "de_DE"
+// Only the language part is used, and unknown or empty locales fall back to English:
+// 1234.5 USD is "$1,234.50" in en and "1.234,50 $" in de, and 1500 JPY is "¥1,500"
+// Currencies without minor units are rounded to whole units. String stays the plain debug form
+func (p Price) Format(locale string) string {
+	currency, known := currencyFormats[p.currency]
+	if !known {
+		currency = currencyFormat{symbol: p.currency, decimals: 2}
+	}
+
+	language := strings.ToLower(locale)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	layout, ok := localeFormats[language]
+	if !ok {
+		layout = localeFormats["en"]
+	}
+
+	number := formatAmount(p.amount, currency.decimals, layout)
+	switch {
+	case layout.symbolAfter:
+		return number + " " + currency.symbol
+	case !known:
+		// A currency code needs a space to stay readable before the digits
+		return currency.symbol + " " + number
+	default:
+		return currency.symbol + number
+	}
+}
+
+// formatAmount writes hundredths as a grouped number with decimals digits after the separator
+func formatAmount(hundredths int64, decimals int, layout localeFormat) string {
+	units, fraction := hundredths/100, hundredths%100
+	if decimals == 0 {
+		units, fraction = (hundredths+50)/100, 0
+	}
+
+	digits := strconv.FormatInt(units, 10)
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteString(layout.group)
+		}
+		b.WriteRune(d)
+	}
+
+	if decimals > 0 {
+		b.WriteString(layout.decimal)
+		b.WriteString(strconv.FormatInt(fraction+100, 10)[1:])
+	}
+	return b.String()
+}
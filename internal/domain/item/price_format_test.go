@@ -0,0 +1,48 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		locale   string
+		want     string
+	}{
+		{name: "USD in English", amount: 1234.5, currency: "USD", locale: "en-US", want: "$1,234.50"},
+		{name: "USD below a thousand", amount: 99.99, currency: "USD", locale: "en", want: "$99.99"},
+		{name: "USD zero", amount: 0, currency: "USD", locale: "en", want: "$0.00"},
+		{name: "USD in German", amount: 1234.5, currency: "USD", locale: "de-DE", want: "1.234,50 $"},
+		{name: "EUR in German", amount: 1234567.89, currency: "EUR", locale: "de_DE", want: "1.234.567,89 €"},
+		{name: "EUR in French", amount: 19.9, currency: "EUR", locale: "fr-FR", want: "19,90 €"},
+		{name: "EUR in English", amount: 5.05, currency: "EUR", locale: "en-IE", want: "€5.05"},
+		{name: "JPY has no minor units", amount: 1500, currency: "JPY", locale: "ja-JP", want: "¥1,500"},
+		{name: "JPY rounds to whole yen", amount: 1499.5, currency: "JPY", locale: "en", want: "¥1,500"},
+		{name: "JPY in German", amount: 1234567, currency: "JPY", locale: "de", want: "1.234.567 ¥"},
+		{name: "unknown currency shows its code", amount: 12.5, currency: "CHF", locale: "en", want: "CHF 12.50"},
+		{name: "unknown locale falls back to English", amount: 1234.5, currency: "GBP", locale: "xx-YY", want: "£1,234.50"},
+		{name: "empty locale falls back to English", amount: 1234.5, currency: "GBP", locale: "", want: "£1,234.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := NewPrice(tt.amount, tt.currency)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, price.Format(tt.locale))
+		})
+	}
+}
+
+func TestPrice_FormatKeepsString(t *testing.T) {
+	price, err := NewPrice(1500, "JPY")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1500.00 JPY", price.String())
+}
@@ -0,0 +1,116 @@
+package item
+
+import "fmt"
+
+// DefaultCategoryDiscounts are the price factors applied per category slug when none are configured
+var DefaultCategoryDiscounts = map[string]float64{
+	"electronics": 0.95,
+	"books":       0.90,
+	"clothing":    0.85,
+}
+
+// DiscountPolicy decides the price an item sells at from its category and base price
+// Categories without a configured factor sell at the base price
+type DiscountPolicy struct {
+	factors map[string]float64
+}
+
+// NewDiscountPolicy creates a policy from factors keyed by category name or slug
+// Every factor must be greater than 0 and at most 1; a factor of 0.95 is a 5% discount
+func NewDiscountPolicy(factors map[string]float64) (DiscountPolicy, error) {
+	policy := DiscountPolicy{factors: make(map[string]float64, len(factors))}
+	for name, factor := range factors {
+		category, err := NewCategory(name)
+		if err != nil {
+			return DiscountPolicy{}, err
+		}
+		if factor <= 0 || factor > 1 {
+			return DiscountPolicy{}, NewDomainError(fmt.Sprintf("discount factor for %s must be in (0, 1], got %v", category.Slug(), factor))
+		}
+		policy.factors[category.Slug()] = factor
+	}
+	return policy, nil
+}
+
+// DefaultDiscountPolicy returns the policy built from DefaultCategoryDiscounts
+func DefaultDiscountPolicy() DiscountPolicy {
+	policy, _ := NewDiscountPolicy(DefaultCategoryDiscounts) // the defaults are valid
+	return policy
+}
+
+// Factor returns the multiplier for category, 1 when it has no discount
+func (p DiscountPolicy) Factor(category Category) float64 {
+	if factor, ok := p.factors[category.Slug()]; ok {
+		return factor
+	}
+	return 1
+}
+
+// Apply returns base discounted for category, rounded to the nearest cent
+func (p DiscountPolicy) Apply(category Category, base Price) (Price, error) {
+	factor := p.Factor(category)
+	if factor == 1 {
+		return base, nil
+	}
+	return base.MultiplyByFactor(factor)
+}
+
+// DefaultMaxPriceIncrease is the largest share an active item's price may rise by in one change
+const DefaultMaxPriceIncrease = 0.5
+
+// PriceChangePolicy limits how far the price of an item on sale may rise in a single change
+// Items that are not active can be repriced freely unless the policy is strict, and price cuts are always allowed
+type PriceChangePolicy struct {
+	maxIncrease float64
+	strict      bool
+}
+
+// NewPriceChangePolicy creates a policy allowing active items to rise by at most maxIncrease
+// of their current price; 0.5 allows a 50% increase
+func NewPriceChangePolicy(maxIncrease float64) (PriceChangePolicy, error) {
+	if maxIncrease <= 0 {
+		return PriceChangePolicy{}, NewDomainError(fmt.Sprintf("maximum price increase must be positive, got %v", maxIncrease))
+	}
+	return PriceChangePolicy{maxIncrease: maxIncrease}, nil
+}
+
+// DefaultPriceChangePolicy returns the policy allowing DefaultMaxPriceIncrease
+func DefaultPriceChangePolicy() PriceChangePolicy {
+	policy, _ := NewPriceChangePolicy(DefaultMaxPriceIncrease) // the default is valid
+	return policy
+}
+
+// Strict returns a copy of the policy that limits price rises of items in any status
+func (p PriceChangePolicy) Strict() PriceChangePolicy {
+	p.strict = true
+	return p
+}
+
+// Applies reports whether price rises of an item in status are limited
+func (p PriceChangePolicy) Applies(status Status) bool {
+	return status == StatusActive || p.strict
+}
+
+// Validate checks that an item in status may move from current to proposed
+// Where the limit applies, proposed must be given in current's currency; callers changing
+// the currency convert it first, so a new currency cannot hide a rise
+func (p PriceChangePolicy) Validate(current, proposed Price, status Status) error {
+	if !p.Applies(status) {
+		return nil
+	}
+	if current.Currency() != proposed.Currency() {
+		return NewDomainError(fmt.Sprintf("price change must be compared in %s, got %s", current.Currency(), proposed.Currency()))
+	}
+
+	increase := proposed.Cents() - current.Cents()
+	maxIncrease := float64(current.Cents()) * p.maxIncrease
+	if float64(increase) > maxIncrease {
+		subject := "active items"
+		if p.strict {
+			subject = "items"
+		}
+		return NewDomainError(fmt.Sprintf("price increase %.2f exceeds maximum allowed %.2f for %s",
+			float64(increase)/100, maxIncrease/100, subject))
+	}
+	return nil
+}
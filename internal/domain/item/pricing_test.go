@@ -0,0 +1,132 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscountPolicy_Apply(t *testing.T) {
+	policy := DefaultDiscountPolicy()
+	base, err := NewPrice(100, "USD")
+	require.NoError(t, err)
+
+	tests := []struct {
+		category string
+		want     int64
+	}{
+		{"electronics", 9500},
+		{"Books", 9000},
+		{"clothing", 8500},
+		{"toys", 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			category, err := NewCategory(tt.category)
+			require.NoError(t, err)
+
+			price, err := policy.Apply(category, base)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, price.Cents())
+			assert.Equal(t, "USD", price.Currency())
+		})
+	}
+}
+
+func TestNewDiscountPolicy(t *testing.T) {
+	t.Run("configured factors replace the defaults", func(t *testing.T) {
+		policy, err := NewDiscountPolicy(map[string]float64{"Home Garden": 0.8})
+		require.NoError(t, err)
+
+		garden, _ := NewCategory("home garden")
+		electronics, _ := NewCategory("electronics")
+		assert.Equal(t, 0.8, policy.Factor(garden))
+		assert.Equal(t, 1.0, policy.Factor(electronics))
+	})
+
+	t.Run("factors outside (0, 1] are rejected", func(t *testing.T) {
+		for _, factor := range []float64{0, -0.5, 1.2} {
+			_, err := NewDiscountPolicy(map[string]float64{"books": factor})
+			assert.Error(t, err, factor)
+		}
+	})
+
+	t.Run("zero policy discounts nothing", func(t *testing.T) {
+		var policy DiscountPolicy
+		books, _ := NewCategory("books")
+		base, _ := NewPrice(10, "EUR")
+
+		price, err := policy.Apply(books, base)
+
+		require.NoError(t, err)
+		assert.True(t, price.Equals(base))
+	})
+}
+
+func TestPriceChangePolicy_Validate(t *testing.T) {
+	policy := DefaultPriceChangePolicy()
+	price := func(amount float64, currency string) Price {
+		p, err := NewPrice(amount, currency)
+		require.NoError(t, err)
+		return p
+	}
+	current := price(100, "USD")
+
+	tests := []struct {
+		name     string
+		proposed Price
+		status   Status
+		wantErr  string
+	}{
+		{"active item rising by more than half", price(150.01, "USD"), StatusActive, "exceeds maximum allowed 50.00"},
+		{"active item rising by exactly half", price(150, "USD"), StatusActive, ""},
+		{"active item price cut", price(10, "USD"), StatusActive, ""},
+		{"draft item rising by more than half", price(300, "USD"), StatusDraft, ""},
+		{"inactive item rising by more than half", price(300, "USD"), StatusInactive, ""},
+		{"active item priced in another currency", price(15000, "JPY"), StatusActive, "must be compared in USD, got JPY"},
+		{"inactive item priced in another currency", price(15000, "JPY"), StatusInactive, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(current, tt.proposed, tt.status)
+
+			if tt.wantErr != "" {
+				var domainErr *DomainError
+				assert.ErrorAs(t, err, &domainErr)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPriceChangePolicy_Strict(t *testing.T) {
+	current, _ := NewPrice(100, "USD")
+	proposed, _ := NewPrice(300, "USD")
+	strict := DefaultPriceChangePolicy().Strict()
+
+	for _, status := range []Status{StatusDraft, StatusInactive, StatusActive} {
+		err := strict.Validate(current, proposed, status)
+		assert.ErrorContains(t, err, "exceeds maximum allowed 50.00 for items", status.String())
+	}
+
+	cut, _ := NewPrice(10, "USD")
+	assert.NoError(t, strict.Validate(current, cut, StatusDraft))
+	assert.NoError(t, DefaultPriceChangePolicy().Validate(current, proposed, StatusDraft), "the default policy is unchanged")
+}
+
+func TestNewPriceChangePolicy(t *testing.T) {
+	_, err := NewPriceChangePolicy(0)
+	assert.Error(t, err)
+
+	policy, err := NewPriceChangePolicy(0.1)
+	require.NoError(t, err)
+	current, _ := NewPrice(100, "USD")
+	proposed, _ := NewPrice(111, "USD")
+	assert.Error(t, policy.Validate(current, proposed, StatusActive))
+}
@@ -2,45 +2,259 @@ package item
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
+// SortField names a field list queries can be ordered by
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+	SortByPrice     SortField = "price"
+	SortByName      SortField = "name"
+)
+
+// SortOrder is the direction of a sort
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Sort orders the results of a list query
+type Sort struct {
+	Field SortField
+	Order SortOrder
+}
+
+// DefaultSort returns the newest-first ordering used when none is requested
+func DefaultSort() Sort {
+	return Sort{Field: SortByCreatedAt, Order: SortDesc}
+}
+
+// NewSort validates a field and order; empty values fall back to DefaultSort
+func NewSort(field, order string) (Sort, error) {
+	sort := DefaultSort()
+
+	switch f := SortField(field); f {
+	case "":
+	case SortByCreatedAt, SortByUpdatedAt, SortByPrice, SortByName:
+		sort.Field = f
+	default:
+		return Sort{}, NewDomainError(fmt.Sprintf("unsupported sort field %q", field))
+	}
+
+	switch o := SortOrder(order); o {
+	case "":
+	case SortAsc, SortDesc:
+		sort.Order = o
+	default:
+		return Sort{}, NewDomainError(fmt.Sprintf("unsupported sort order %q", order))
+	}
+
+	return sort, nil
+}
+
+// StreamFilter narrows a Stream; nil fields match every item
+type StreamFilter struct {
+	Category *Category
+	Status   *Status
+}
+
+// ListFilter narrows FindByFilter and CountByFilter; nil or empty fields match every item
+type ListFilter struct {
+	Category  *Category
+	Status    *Status
+	BrandSlug string
+}
+
+// AttributeRange bounds a numeric attribute; -Inf or +Inf leaves that side open
+// Items whose attribute is missing or not a number never match
+type AttributeRange struct {
+	Key string
+	Min float64
+	Max float64
+}
+
+// PriceChange is one recorded change of an item's price
+type PriceChange struct {
+	ItemID    ItemID
+	OldPrice  Price
+	NewPrice  Price
+	ChangedAt time.Time
+}
+
+// CategoryCount is the number of items in one category
+type CategoryCount struct {
+	Category Category
+	Count    int
+}
+
+// ItemWithStats is an item together with how often it was viewed and how it was rated
+type ItemWithStats struct {
+	Item      *Item
+	ViewCount int
+	// AverageRating is 0 when RatingCount is 0
+	AverageRating float64
+	RatingCount   int
+}
+
+// ItemViewCount is an item together with how often it was viewed in some window
+type ItemViewCount struct {
+	Item      *Item
+	ViewCount int
+}
+
+// ItemSummary holds the few item fields list views show, read without loading the whole aggregate
+type ItemSummary struct {
+	ID       ItemID
+	SKU      SKU
+	Name     string
+	Price    Price
+	Category Category
+	Status   Status
+	// InStock reports whether any units are on hand, reserved or not
+	InStock bool
+}
+
 // Repository defines the interface for item persistence
 type Repository interface {
 	// Basic CRUD operations
+	// Save reports a SKU another item already has with DuplicateSKUError
 	Save(ctx context.Context, item *Item) error
+	// SaveAll inserts items in one transaction and returns one error slot per item
+	// A non-nil error means nothing was committed; with allOrNothing any item failure causes that
+	SaveAll(ctx context.Context, items []*Item, allOrNothing bool) ([]error, error)
 	FindByID(ctx context.Context, id ItemID) (*Item, error)
 	FindBySKU(ctx context.Context, sku SKU) (*Item, error)
+	FindByBarcode(ctx context.Context, barcode Barcode) (*Item, error)
+	FindByIDs(ctx context.Context, ids []ItemID) ([]*Item, error)
+	// GetItemsWithRelatedData loads items with their view and rating figures in one query
+	// IDs that do not exist are simply absent from the result
+	GetItemsWithRelatedData(ctx context.Context, ids []ItemID) ([]ItemWithStats, error)
 	Update(ctx context.Context, item *Item) error
+	// UpdateAll updates items in one transaction and returns one error slot per item
+	// A failed item does not stop the others; a non-nil error means nothing was committed
+	UpdateAll(ctx context.Context, items []*Item) ([]error, error)
 	Delete(ctx context.Context, id ItemID) error
+	// DeleteAll deletes items in one transaction and returns the IDs that did not exist
+	// A non-nil error means nothing was deleted
+	DeleteAll(ctx context.Context, ids []ItemID) ([]ItemID, error)
 	
 	// Query operations
-	FindByCategory(ctx context.Context, category Category, limit, offset int) ([]*Item, error)
-	FindByStatus(ctx context.Context, status Status, limit, offset int) ([]*Item, error)
-	Search(ctx context.Context, query string, limit, offset int) ([]*Item, error)
+	FindByCategory(ctx context.Context, category Category, sort Sort, limit, offset int) ([]*Item, error)
+	// FindByCategoryTree is FindByCategory widened to every descendant category in the hierarchy
+	FindByCategoryTree(ctx context.Context, category Category, sort Sort, limit, offset int) ([]*Item, error)
+	// FindByBrand returns items whose brand has the given slug, newest first
+	FindByBrand(ctx context.Context, brandSlug string, limit, offset int) ([]*Item, error)
+	FindByStatus(ctx context.Context, status Status, sort Sort, limit, offset int) ([]*Item, error)
+	// FindByFilter returns items matching every field set in filter
+	FindByFilter(ctx context.Context, filter ListFilter, sort Sort, limit, offset int) ([]*Item, error)
+	// FindSummariesByFilter returns the same page as FindByFilter, reading only the summary columns
+	FindSummariesByFilter(ctx context.Context, filter ListFilter, sort Sort, limit, offset int) ([]ItemSummary, error)
+	Search(ctx context.Context, query string, sort Sort, limit, offset int) ([]*Item, error)
+	// SearchRanked matches query against name, SKU and description with full-text search,
+	// best match first; name and SKU matches rank above description matches
+	SearchRanked(ctx context.Context, query string, limit, offset int) ([]*Item, error)
+	// FindByPriceRange filters on price in the given currency; a max of +Inf means no upper bound
+	FindByPriceRange(ctx context.Context, min, max float64, currency string, sort Sort, limit, offset int) ([]*Item, error)
+	FindByCategoryAndPriceRange(ctx context.Context, category Category, min, max float64, currency string, sort Sort, limit, offset int) ([]*Item, error)
+	// FindByAttributes returns items having every key/value pair in filters
+	FindByAttributes(ctx context.Context, filters map[string]string, sort Sort, limit, offset int) ([]*Item, error)
+	// FindByAttributeRanges returns items whose numeric attributes fall within every range
+	FindByAttributeRanges(ctx context.Context, ranges []AttributeRange, sort Sort, limit, offset int) ([]*Item, error)
+	// Stream calls fn for each matching item, oldest first, reading rows as fn consumes them
+	// An error from fn stops the stream and is returned unwrapped
+	Stream(ctx context.Context, filter StreamFilter, fn func(*Item) error) error
+
+	// Price history, oldest change first; Update records a change whenever the price differs
+	FindPriceHistory(ctx context.Context, id ItemID, limit, offset int) ([]PriceChange, error)
+	CountPriceHistory(ctx context.Context, id ItemID) (int, error)
 	
 	// Business-specific queries
-	FindAvailableItems(ctx context.Context, limit, offset int) ([]*Item, error)
+	FindAvailableItems(ctx context.Context, sort Sort, limit, offset int) ([]*Item, error)
 	FindItemsWithLowStock(ctx context.Context, threshold int) ([]*Item, error)
+	// FindRelated returns up to limit other active, in-stock items in source's category,
+	// those sharing the most attribute values with source first
+	FindRelated(ctx context.Context, source *Item, limit int) ([]*Item, error)
+	// FindByNamePrefix returns up to limit active items whose name starts with prefix, ignoring case
+	// Shorter names come first, so the closest completions lead
+	FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*Item, error)
+	// FindMostViewed returns up to limit active items viewed at or after since, most viewed first
+	// Items with no views in the window are left out
+	FindMostViewed(ctx context.Context, since time.Time, limit int) ([]ItemViewCount, error)
+
+	// Engagement
+	// RecordView stores one view of the item at the current time
+	RecordView(ctx context.Context, id ItemID) error
 	
 	// Aggregations
 	CountByCategory(ctx context.Context, category Category) (int, error)
+	CountByCategoryTree(ctx context.Context, category Category) (int, error)
+	CountByBrand(ctx context.Context, brandSlug string) (int, error)
 	CountByStatus(ctx context.Context, status Status) (int, error)
+	// CountByFilter counts the items FindByFilter matches
+	CountByFilter(ctx context.Context, filter ListFilter) (int, error)
+	// CountTopCategories returns the limit categories with the most items, largest first
+	CountTopCategories(ctx context.Context, limit int) ([]CategoryCount, error)
+	CountBySearch(ctx context.Context, query string) (int, error)
+	// CountBySearchRanked counts the items SearchRanked matches
+	CountBySearchRanked(ctx context.Context, query string) (int, error)
+	CountByPriceRange(ctx context.Context, min, max float64, currency string) (int, error)
+	CountByCategoryAndPriceRange(ctx context.Context, category Category, min, max float64, currency string) (int, error)
+	CountByAttributes(ctx context.Context, filters map[string]string) (int, error)
+	CountByAttributeRanges(ctx context.Context, ranges []AttributeRange) (int, error)
+	CountAvailable(ctx context.Context) (int, error)
 	
 	// Existence checks
 	ExistsBySKU(ctx context.Context, sku SKU) (bool, error)
 	ExistsByID(ctx context.Context, id ItemID) (bool, error)
+	// ExistsCategory reports whether category is in the category hierarchy or used by any item
+	ExistsCategory(ctx context.Context, category Category) (bool, error)
 }
 
 // ReadOnlyRepository defines a read-only interface for queries
 type ReadOnlyRepository interface {
 	FindByID(ctx context.Context, id ItemID) (*Item, error)
+	FindByIDs(ctx context.Context, ids []ItemID) ([]*Item, error)
+	GetItemsWithRelatedData(ctx context.Context, ids []ItemID) ([]ItemWithStats, error)
 	FindBySKU(ctx context.Context, sku SKU) (*Item, error)
-	FindByCategory(ctx context.Context, category Category, limit, offset int) ([]*Item, error)
-	FindByStatus(ctx context.Context, status Status, limit, offset int) ([]*Item, error)
-	Search(ctx context.Context, query string, limit, offset int) ([]*Item, error)
-	FindAvailableItems(ctx context.Context, limit, offset int) ([]*Item, error)
+	FindByBarcode(ctx context.Context, barcode Barcode) (*Item, error)
+	FindByCategory(ctx context.Context, category Category, sort Sort, limit, offset int) ([]*Item, error)
+	FindByCategoryTree(ctx context.Context, category Category, sort Sort, limit, offset int) ([]*Item, error)
+	FindByBrand(ctx context.Context, brandSlug string, limit, offset int) ([]*Item, error)
+	FindByStatus(ctx context.Context, status Status, sort Sort, limit, offset int) ([]*Item, error)
+	FindByFilter(ctx context.Context, filter ListFilter, sort Sort, limit, offset int) ([]*Item, error)
+	FindSummariesByFilter(ctx context.Context, filter ListFilter, sort Sort, limit, offset int) ([]ItemSummary, error)
+	Search(ctx context.Context, query string, sort Sort, limit, offset int) ([]*Item, error)
+	SearchRanked(ctx context.Context, query string, limit, offset int) ([]*Item, error)
+	FindByPriceRange(ctx context.Context, min, max float64, currency string, sort Sort, limit, offset int) ([]*Item, error)
+	FindByCategoryAndPriceRange(ctx context.Context, category Category, min, max float64, currency string, sort Sort, limit, offset int) ([]*Item, error)
+	FindByAttributes(ctx context.Context, filters map[string]string, sort Sort, limit, offset int) ([]*Item, error)
+	FindByAttributeRanges(ctx context.Context, ranges []AttributeRange, sort Sort, limit, offset int) ([]*Item, error)
+	FindAvailableItems(ctx context.Context, sort Sort, limit, offset int) ([]*Item, error)
+	FindRelated(ctx context.Context, source *Item, limit int) ([]*Item, error)
+	FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*Item, error)
+	FindMostViewed(ctx context.Context, since time.Time, limit int) ([]ItemViewCount, error)
 	CountByCategory(ctx context.Context, category Category) (int, error)
+	CountByCategoryTree(ctx context.Context, category Category) (int, error)
+	CountByBrand(ctx context.Context, brandSlug string) (int, error)
 	CountByStatus(ctx context.Context, status Status) (int, error)
+	CountByFilter(ctx context.Context, filter ListFilter) (int, error)
+	// CountTopCategories returns the limit categories with the most items, largest first
+	CountTopCategories(ctx context.Context, limit int) ([]CategoryCount, error)
+	CountBySearch(ctx context.Context, query string) (int, error)
+	CountBySearchRanked(ctx context.Context, query string) (int, error)
+	CountByPriceRange(ctx context.Context, min, max float64, currency string) (int, error)
+	CountByCategoryAndPriceRange(ctx context.Context, category Category, min, max float64, currency string) (int, error)
+	CountByAttributes(ctx context.Context, filters map[string]string) (int, error)
+	CountByAttributeRanges(ctx context.Context, ranges []AttributeRange) (int, error)
+	CountAvailable(ctx context.Context) (int, error)
 	ExistsBySKU(ctx context.Context, sku SKU) (bool, error)
 	ExistsByID(ctx context.Context, id ItemID) (bool, error)
+	// ExistsCategory reports whether category is in the category hierarchy or used by any item
+	ExistsCategory(ctx context.Context, category Category) (bool, error)
 } 
\ No newline at end of file
@@ -0,0 +1,36 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		order   string
+		want    Sort
+		wantErr bool
+	}{
+		{"defaults", "", "", Sort{Field: SortByCreatedAt, Order: SortDesc}, false},
+		{"price ascending", "price", "asc", Sort{Field: SortByPrice, Order: SortAsc}, false},
+		{"field without order", "name", "", Sort{Field: SortByName, Order: SortDesc}, false},
+		{"order without field", "", "asc", Sort{Field: SortByCreatedAt, Order: SortAsc}, false},
+		{"unknown field", "drop_table", "asc", Sort{}, true},
+		{"unknown order", "price", "sideways", Sort{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSort(tt.field, tt.order)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
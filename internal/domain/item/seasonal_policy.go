@@ -0,0 +1,54 @@
+package item
+
+import (
+	"fmt"
+	"time"
+)
+
+// SeasonalCategorySlug is the category whose items are only on sale in season
+const SeasonalCategorySlug = "seasonal"
+
+// DefaultOffSeasonMonths are the months outside June to September, when seasonal items are taken off sale
+var DefaultOffSeasonMonths = []time.Month{
+	time.January, time.February, time.March, time.April, time.May,
+	time.October, time.November, time.December,
+}
+
+// SeasonalPolicy takes active items in the seasonal category off sale during the off-season
+// A policy without off-season months never deactivates anything
+type SeasonalPolicy struct {
+	offSeason map[time.Month]bool
+}
+
+// NewSeasonalPolicy creates a policy deactivating seasonal items in the given months
+func NewSeasonalPolicy(offSeasonMonths []time.Month) (SeasonalPolicy, error) {
+	offSeason := make(map[time.Month]bool, len(offSeasonMonths))
+	for _, month := range offSeasonMonths {
+		if month < time.January || month > time.December {
+			return SeasonalPolicy{}, NewDomainError(fmt.Sprintf("off-season month must be between 1 and 12, got %d", month))
+		}
+		offSeason[month] = true
+	}
+	return SeasonalPolicy{offSeason: offSeason}, nil
+}
+
+// DefaultSeasonalPolicy returns the policy built from DefaultOffSeasonMonths
+func DefaultSeasonalPolicy() SeasonalPolicy {
+	policy, _ := NewSeasonalPolicy(DefaultOffSeasonMonths) // the defaults are valid
+	return policy
+}
+
+// IsOffSeason reports whether seasonal items are off sale at now, read in now's location
+func (p SeasonalPolicy) IsOffSeason(now time.Time) bool {
+	return p.offSeason[now.Month()]
+}
+
+// Apply deactivates i when it is an active seasonal item and now falls in the off-season,
+// reporting whether it did. The change is a regular transition, so it records an
+// ItemStatusChangedEvent like any other deactivation
+func (p SeasonalPolicy) Apply(i *Item, now time.Time) bool {
+	if i.Category().Slug() != SeasonalCategorySlug || i.Status() != StatusActive || !p.IsOffSeason(now) {
+		return false
+	}
+	return i.TransitionTo(StatusInactive) == nil
+}
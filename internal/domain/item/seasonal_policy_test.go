@@ -0,0 +1,92 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSeasonalItem(t *testing.T, category string, status Status) *Item {
+	t.Helper()
+
+	sku, err := NewSKU("SEAS-001")
+	require.NoError(t, err)
+	price, err := NewPrice(25, "USD")
+	require.NoError(t, err)
+	cat, err := NewCategory(category)
+	require.NoError(t, err)
+
+	itm, err := NewItem(sku, "Beach Umbrella", "Shade for the summer", price, cat)
+	require.NoError(t, err)
+	require.NoError(t, itm.TransitionTo(status))
+	itm.PullEvents()
+	return itm
+}
+
+func TestSeasonalPolicy_Apply(t *testing.T) {
+	policy := DefaultSeasonalPolicy()
+	winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("winter deactivates an active seasonal item", func(t *testing.T) {
+		itm := newSeasonalItem(t, "Seasonal", StatusActive)
+
+		assert.True(t, policy.Apply(itm, winter))
+
+		assert.Equal(t, StatusInactive, itm.Status())
+		events := itm.Events()
+		require.Len(t, events, 1)
+		changed, ok := events[0].(*ItemStatusChangedEvent)
+		require.True(t, ok)
+		assert.Equal(t, StatusActive, changed.OldStatus)
+		assert.Equal(t, StatusInactive, changed.NewStatus)
+	})
+
+	t.Run("summer leaves an active seasonal item on sale", func(t *testing.T) {
+		itm := newSeasonalItem(t, "seasonal", StatusActive)
+
+		assert.False(t, policy.Apply(itm, summer))
+
+		assert.Equal(t, StatusActive, itm.Status())
+		assert.Empty(t, itm.Events())
+	})
+
+	t.Run("other categories and statuses are left alone", func(t *testing.T) {
+		other := newSeasonalItem(t, "Electronics", StatusActive)
+		draft := newSeasonalItem(t, "Seasonal", StatusDraft)
+
+		assert.False(t, policy.Apply(other, winter))
+		assert.False(t, policy.Apply(draft, winter))
+
+		assert.Equal(t, StatusActive, other.Status())
+		assert.Equal(t, StatusDraft, draft.Status())
+	})
+}
+
+func TestNewSeasonalPolicy(t *testing.T) {
+	t.Run("configured months replace the defaults", func(t *testing.T) {
+		policy, err := NewSeasonalPolicy([]time.Month{time.July})
+		require.NoError(t, err)
+
+		assert.True(t, policy.IsOffSeason(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)))
+		assert.False(t, policy.IsOffSeason(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("no months disables the rule", func(t *testing.T) {
+		policy, err := NewSeasonalPolicy(nil)
+		require.NoError(t, err)
+
+		itm := newSeasonalItem(t, "Seasonal", StatusActive)
+		assert.False(t, policy.Apply(itm, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("months outside 1 to 12 are rejected", func(t *testing.T) {
+		_, err := NewSeasonalPolicy([]time.Month{0})
+		assert.Error(t, err)
+
+		_, err = NewSeasonalPolicy([]time.Month{13})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,113 @@
+package item
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxSKULength is the longest SKU any policy may allow; the sku column holds 64 characters
+const MaxSKULength = 64
+
+// SKUCase is how a SKU's letters are folded before it is validated
+type SKUCase string
+
+const (
+	SKUCaseUpper    SKUCase = "upper"
+	SKUCaseLower    SKUCase = "lower"
+	SKUCasePreserve SKUCase = "preserve"
+)
+
+// Default SKU rules: 3 to 20 uppercase letters, digits, hyphens and underscores
+const (
+	DefaultSKUMinLength = 3
+	DefaultSKUMaxLength = 20
+	DefaultSKUPattern   = "^[A-Z0-9-_]+$"
+)
+
+var defaultSKUPolicy = DefaultSKUPolicy()
+
+// SKUPolicy decides which SKUs are accepted and how they are normalized
+type SKUPolicy struct {
+	minLength   int
+	maxLength   int
+	pattern     *regexp.Regexp
+	caseFolding SKUCase
+	charsetRule string
+}
+
+// NewSKUPolicy creates a policy accepting SKUs of minLength to maxLength characters that match
+// pattern once trimmed and folded; an empty caseFolding folds to uppercase
+func NewSKUPolicy(minLength, maxLength int, pattern string, caseFolding SKUCase) (SKUPolicy, error) {
+	if minLength < 1 || maxLength < minLength || maxLength > MaxSKULength {
+		return SKUPolicy{}, NewDomainError(fmt.Sprintf("SKU length bounds must satisfy 1 <= min <= max <= %d, got %d and %d", MaxSKULength, minLength, maxLength))
+	}
+
+	switch caseFolding {
+	case "":
+		caseFolding = SKUCaseUpper
+	case SKUCaseUpper, SKUCaseLower, SKUCasePreserve:
+	default:
+		return SKUPolicy{}, NewDomainError(fmt.Sprintf("unsupported SKU case folding %q", caseFolding))
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return SKUPolicy{}, NewDomainError(fmt.Sprintf("invalid SKU pattern %q: %v", pattern, err))
+	}
+
+	charsetRule := fmt.Sprintf("SKU must match %s", pattern)
+	if pattern == DefaultSKUPattern {
+		charsetRule = "SKU can only contain uppercase letters, numbers, hyphens, and underscores"
+	}
+
+	return SKUPolicy{
+		minLength:   minLength,
+		maxLength:   maxLength,
+		pattern:     re,
+		caseFolding: caseFolding,
+		charsetRule: charsetRule,
+	}, nil
+}
+
+// DefaultSKUPolicy returns the policy NewSKU applies
+func DefaultSKUPolicy() SKUPolicy {
+	policy, _ := NewSKUPolicy(DefaultSKUMinLength, DefaultSKUMaxLength, DefaultSKUPattern, SKUCaseUpper) // the defaults are valid
+	return policy
+}
+
+// NewSKU trims and folds sku, then validates it against the policy
+func (p SKUPolicy) NewSKU(sku string) (SKU, error) {
+	sku = p.Normalize(sku)
+	if err := p.Validate(sku); err != nil {
+		return SKU{}, err
+	}
+	return SKU{value: sku}, nil
+}
+
+// Validate checks an already normalized SKU against the policy
+func (p SKUPolicy) Validate(sku string) error {
+	if sku == "" {
+		return NewDomainErrorWithCode(CodeInvalidSKU, "SKU cannot be empty")
+	}
+	if len(sku) < p.minLength || len(sku) > p.maxLength {
+		return NewDomainErrorWithCode(CodeInvalidSKU, fmt.Sprintf("SKU must be between %d and %d characters", p.minLength, p.maxLength))
+	}
+	if !p.pattern.MatchString(sku) {
+		return NewDomainErrorWithCode(CodeInvalidSKU, p.charsetRule)
+	}
+	return nil
+}
+
+// Normalize trims sku and folds its case the way NewSKU does, without validating it
+func (p SKUPolicy) Normalize(sku string) string {
+	sku = strings.TrimSpace(sku)
+	switch p.caseFolding {
+	case SKUCaseLower:
+		return strings.ToLower(sku)
+	case SKUCasePreserve:
+		return sku
+	default:
+		return strings.ToUpper(sku)
+	}
+}
@@ -0,0 +1,97 @@
+package item
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSKUPolicy_DefaultMatchesNewSKU(t *testing.T) {
+	policy := DefaultSKUPolicy()
+
+	for _, raw := range []string{"abc-123", " ABC_123 ", "AB", strings.Repeat("A", 21), "ABC@123", ""} {
+		want, wantErr := NewSKU(raw)
+		got, err := policy.NewSKU(raw)
+
+		assert.Equal(t, want, got, raw)
+		if wantErr != nil {
+			assert.EqualError(t, err, wantErr.Error(), raw)
+		} else {
+			assert.NoError(t, err, raw)
+		}
+	}
+}
+
+func TestSKUPolicy_LongerSKUs(t *testing.T) {
+	policy, err := NewSKUPolicy(3, 30, DefaultSKUPattern, SKUCaseUpper)
+	require.NoError(t, err)
+
+	sku, err := policy.NewSKU(strings.Repeat("a", 30))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("A", 30), sku.String())
+
+	_, err = NewSKU(strings.Repeat("A", 30))
+	assert.Error(t, err, "the default policy still stops at 20")
+
+	_, err = policy.NewSKU(strings.Repeat("A", 31))
+	assert.EqualError(t, err, "SKU must be between 3 and 30 characters")
+}
+
+func TestSKUPolicy_NoUnderscores(t *testing.T) {
+	policy, err := NewSKUPolicy(3, 20, "^[A-Z0-9-]+$", SKUCaseUpper)
+	require.NoError(t, err)
+
+	_, err = policy.NewSKU("ABC-123")
+	assert.NoError(t, err)
+
+	_, err = policy.NewSKU("ABC_123")
+	assert.EqualError(t, err, "SKU must match ^[A-Z0-9-]+$")
+}
+
+func TestSKUPolicy_CaseFolding(t *testing.T) {
+	tests := []struct {
+		caseFolding SKUCase
+		pattern     string
+		want        string
+	}{
+		{SKUCaseUpper, DefaultSKUPattern, "ABC-XYZ"},
+		{"", DefaultSKUPattern, "ABC-XYZ"},
+		{SKUCaseLower, "^[a-z-]+$", "abc-xyz"},
+		{SKUCasePreserve, "^[A-Za-z-]+$", "Abc-xYz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.caseFolding), func(t *testing.T) {
+			policy, err := NewSKUPolicy(3, 20, tt.pattern, tt.caseFolding)
+			require.NoError(t, err)
+
+			sku, err := policy.NewSKU(" Abc-xYz ")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sku.String())
+		})
+	}
+}
+
+func TestNewSKUPolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		min, max    int
+		pattern     string
+		caseFolding SKUCase
+	}{
+		{"zero minimum", 0, 20, DefaultSKUPattern, SKUCaseUpper},
+		{"max below min", 10, 5, DefaultSKUPattern, SKUCaseUpper},
+		{"max beyond storage", 3, MaxSKULength + 1, DefaultSKUPattern, SKUCaseUpper},
+		{"bad pattern", 3, 20, "^[A-Z", SKUCaseUpper},
+		{"unknown case", 3, 20, DefaultSKUPattern, "title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSKUPolicy(tt.min, tt.max, tt.pattern, tt.caseFolding)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package item
 
 import (
 	"fmt"
-	"regexp"
+	"math"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/google/uuid"
 )
@@ -19,7 +22,7 @@ func NewItemID() ItemID {
 
 func NewItemIDFromString(id string) (ItemID, error) {
 	if _, err := uuid.Parse(id); err != nil {
-		return ItemID{}, NewDomainError("invalid item ID format")
+		return ItemID{}, NewDomainErrorWithCode(CodeInvalidItemID, "invalid item ID format")
 	}
 	return ItemID{value: id}, nil
 }
@@ -37,53 +40,86 @@ type SKU struct {
 	value string
 }
 
+// NewSKU creates a SKU under the default policy
 func NewSKU(sku string) (SKU, error) {
-	sku = strings.TrimSpace(strings.ToUpper(sku))
-	if err := validateSKU(sku); err != nil {
-		return SKU{}, err
-	}
-	return SKU{value: sku}, nil
+	return defaultSKUPolicy.NewSKU(sku)
+}
+
+// ReconstituteSKU rebuilds a stored SKU as-is; it was validated by the policy in force when
+// it was written, which may differ from the default
+func ReconstituteSKU(sku string) SKU {
+	return SKU{value: sku}
 }
 
 func (s SKU) String() string {
 	return s.value
 }
 
+// Validate checks the SKU against the default policy
 func (s SKU) Validate() error {
 	return validateSKU(s.value)
 }
 
 func validateSKU(sku string) error {
-	if sku == "" {
-		return NewDomainError("SKU cannot be empty")
+	return defaultSKUPolicy.Validate(sku)
+}
+
+// skuPrefixLength is how many characters of the category slug start a generated SKU
+const skuPrefixLength = 4
+
+// GenerateSKU builds the SKU for the seq-th item of a category: the first ASCII letters and
+// digits of its slug, uppercased, then seq padded to six digits, so Electronics and 123 give
+// ELEC-000123. Categories without any fall back to the ITEM prefix
+func GenerateSKU(category Category, seq int) (SKU, error) {
+	if seq < 0 {
+		return SKU{}, NewDomainError("SKU sequence cannot be negative")
 	}
-	if len(sku) < 3 || len(sku) > 20 {
-		return NewDomainError("SKU must be between 3 and 20 characters")
+
+	var prefix strings.Builder
+	for _, r := range category.Slug() {
+		if prefix.Len() == skuPrefixLength {
+			break
+		}
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			prefix.WriteRune(unicode.ToUpper(r))
+		}
 	}
-	matched, _ := regexp.MatchString("^[A-Z0-9-_]+$", sku)
-	if !matched {
-		return NewDomainError("SKU can only contain uppercase letters, numbers, hyphens, and underscores")
+	if prefix.Len() == 0 {
+		prefix.WriteString("ITEM")
 	}
-	return nil
+
+	return NewSKU(fmt.Sprintf("%s-%06d", prefix.String(), seq))
 }
 
+// MaxPriceAmount is the largest amount a Price can hold
+// Every cent up to it is exact in a float64, so conversions never lose precision
+const MaxPriceAmount = 1e13
+
 // Price is a value object representing monetary value
 type Price struct {
 	amount   int64 // stored in cents to avoid floating point issues
 	currency string
 }
 
+// NewPrice creates a price, rounding amount to the nearest cent
+// NaN, infinite, negative and amounts above MaxPriceAmount are rejected
 func NewPrice(amount float64, currency string) (Price, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, "price must be a finite number")
+	}
 	if amount < 0 {
-		return Price{}, NewDomainError("price cannot be negative")
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, "price cannot be negative")
+	}
+	if amount > MaxPriceAmount {
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, fmt.Sprintf("price cannot exceed %.0f", float64(MaxPriceAmount)))
 	}
 	if currency == "" {
-		currency = "USD"
+		currency = DefaultCurrency
 	}
 	currency = strings.ToUpper(currency)
 	
-	// Convert to cents
-	amountInCents := int64(amount * 100)
+	// Convert to cents, rounding so values like 19.99 don't truncate to 1998
+	amountInCents := int64(math.Round(amount * 100))
 	
 	return Price{
 		amount:   amountInCents,
@@ -91,32 +127,101 @@ func NewPrice(amount float64, currency string) (Price, error) {
 	}, nil
 }
 
+// NewPriceFromCents creates a price from an amount already expressed in cents
+func NewPriceFromCents(cents int64, currency string) (Price, error) {
+	if cents < 0 {
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, "price cannot be negative")
+	}
+	if cents > MaxPriceAmount*100 {
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, fmt.Sprintf("price cannot exceed %.0f", float64(MaxPriceAmount)))
+	}
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	return Price{
+		amount:   cents,
+		currency: strings.ToUpper(currency),
+	}, nil
+}
+
 func (p Price) Amount() float64 {
 	return float64(p.amount) / 100
 }
 
+// Cents returns the amount in the smallest currency unit, as persisted
+func (p Price) Cents() int64 {
+	return p.amount
+}
+
 func (p Price) Currency() string {
 	return p.currency
 }
 
+// Add returns the sum of two prices in the same currency
+func (p Price) Add(other Price) (Price, error) {
+	if p.currency != other.currency {
+		return Price{}, CurrencyMismatchError(p.currency, other.currency)
+	}
+	return Price{amount: p.amount + other.amount, currency: p.currency}, nil
+}
+
+// Subtract returns p minus other; the result may not be negative
+func (p Price) Subtract(other Price) (Price, error) {
+	if p.currency != other.currency {
+		return Price{}, CurrencyMismatchError(p.currency, other.currency)
+	}
+	if other.amount > p.amount {
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, "price cannot be negative")
+	}
+	return Price{amount: p.amount - other.amount, currency: p.currency}, nil
+}
+
+// MultiplyByFactor scales the price, rounding to the nearest cent
+// Discounts use it so that 100.00 * 0.95 is exactly 95.00
+func (p Price) MultiplyByFactor(factor float64) (Price, error) {
+	if factor < 0 || math.IsNaN(factor) || math.IsInf(factor, 0) {
+		return Price{}, NewDomainErrorWithCode(CodeInvalidPrice, fmt.Sprintf("invalid price factor %v", factor))
+	}
+	return Price{amount: int64(math.Round(float64(p.amount) * factor)), currency: p.currency}, nil
+}
+
+// Equals reports whether both prices have the same amount and currency
+func (p Price) Equals(other Price) bool {
+	return p.amount == other.amount && p.currency == other.currency
+}
+
+// GreaterThan reports whether p is more than other; prices in different currencies cannot be compared
+func (p Price) GreaterThan(other Price) (bool, error) {
+	if p.currency != other.currency {
+		return false, CurrencyMismatchError(p.currency, other.currency)
+	}
+	return p.amount > other.amount, nil
+}
+
 func (p Price) String() string {
 	return fmt.Sprintf("%.2f %s", p.Amount(), p.currency)
 }
 
 func (p Price) Validate() error {
 	if p.amount < 0 {
-		return NewDomainError("price cannot be negative")
+		return NewDomainErrorWithCode(CodeInvalidPrice, "price cannot be negative")
 	}
 	if p.currency == "" {
-		return NewDomainError("currency cannot be empty")
+		return NewDomainErrorWithCode(CodeInvalidPrice, "currency cannot be empty")
 	}
 	return nil
 }
 
+// RestrictedCategorySlug is the category no item may be placed in
+const RestrictedCategorySlug = "restricted"
+
 // Category is a value object representing item category
+// A category may sit under a parent, identified by the parent's slug
 type Category struct {
-	name string
-	slug string
+	name   string
+	slug   string
+	parent string
 }
 
 func NewCategory(name string) (Category, error) {
@@ -125,7 +230,10 @@ func NewCategory(name string) (Category, error) {
 		return Category{}, NewDomainError("category name cannot be empty")
 	}
 	
-	slug := strings.ToLower(strings.ReplaceAll(name, " ", "-"))
+	slug := slugify(name)
+	if slug == "" {
+		return Category{}, NewDomainError("category name must contain a letter or digit")
+	}
 	
 	return Category{
 		name: name,
@@ -133,6 +241,41 @@ func NewCategory(name string) (Category, error) {
 	}, nil
 }
 
+// NewSubcategory creates a category nested under the category with slug parentSlug
+func NewSubcategory(name, parentSlug string) (Category, error) {
+	category, err := NewCategory(name)
+	if err != nil {
+		return Category{}, err
+	}
+
+	parentSlug = slugify(parentSlug)
+	if parentSlug == category.slug {
+		return Category{}, NewDomainError("category cannot be its own parent")
+	}
+	category.parent = parentSlug
+	return category, nil
+}
+
+// slugify lowercases s and turns every run of characters other than letters and digits
+// into a single hyphen, with none left at either end: "Home & Garden" becomes "home-garden"
+// Letters outside ASCII are kept, so "Café" becomes "café"
+func slugify(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 func (c Category) Name() string {
 	return c.name
 }
@@ -141,6 +284,16 @@ func (c Category) Slug() string {
 	return c.slug
 }
 
+// ParentSlug returns the parent category's slug, or "" for a top-level category
+func (c Category) ParentSlug() string {
+	return c.parent
+}
+
+// IsRestricted reports whether this is the restricted category
+func (c Category) IsRestricted() bool {
+	return c.slug == RestrictedCategorySlug
+}
+
 func (c Category) Validate() error {
 	if c.name == "" {
 		return NewDomainError("category name cannot be empty")
@@ -149,27 +302,76 @@ func (c Category) Validate() error {
 }
 
 // Inventory is a value object representing stock quantity
+// Reserved units are held for pending orders and are not available for sale
 type Inventory struct {
 	quantity int
+	reserved int
 }
 
 func NewInventory(quantity int) (Inventory, error) {
+	return NewInventoryWithReserved(quantity, 0)
+}
+
+// NewInventoryWithReserved creates an inventory that already holds reservations
+func NewInventoryWithReserved(quantity, reserved int) (Inventory, error) {
 	if quantity < 0 {
 		return Inventory{}, NewDomainError("inventory quantity cannot be negative")
 	}
-	return Inventory{quantity: quantity}, nil
+	if reserved < 0 {
+		return Inventory{}, NewDomainError("reserved quantity cannot be negative")
+	}
+	if reserved > quantity {
+		return Inventory{}, NewDomainError("reserved quantity cannot exceed inventory quantity")
+	}
+	return Inventory{quantity: quantity, reserved: reserved}, nil
 }
 
 func (i Inventory) Quantity() int {
 	return i.quantity
 }
 
+func (i Inventory) Reserved() int {
+	return i.reserved
+}
+
+// Available returns the units that can still be sold or reserved
+func (i Inventory) Available() int {
+	return i.quantity - i.reserved
+}
+
 func (i Inventory) IsAvailable() bool {
-	return i.quantity > 0
+	return i.Available() > 0
 }
 
 func (i Inventory) CanReserve(quantity int) bool {
-	return i.quantity >= quantity
+	return i.Available() >= quantity
+}
+
+// Reserve returns a new inventory holding quantity more units
+func (i Inventory) Reserve(quantity int) (Inventory, error) {
+	if quantity <= 0 {
+		return i, NewDomainError("reserve quantity must be positive")
+	}
+	if !i.CanReserve(quantity) {
+		return i, ErrInsufficientStock
+	}
+	return Inventory{quantity: i.quantity, reserved: i.reserved + quantity}, nil
+}
+
+// Release returns a new inventory holding quantity fewer units
+func (i Inventory) Release(quantity int) (Inventory, error) {
+	if quantity <= 0 {
+		return i, NewDomainError("release quantity must be positive")
+	}
+	if quantity > i.reserved {
+		return i, ErrReleaseExceedsReserved
+	}
+	return Inventory{quantity: i.quantity, reserved: i.reserved - quantity}, nil
+}
+
+// WithQuantity returns a new inventory with the stock level changed and reservations kept
+func (i Inventory) WithQuantity(quantity int) (Inventory, error) {
+	return NewInventoryWithReserved(quantity, i.reserved)
 }
 
 // Image is a value object representing an item image
@@ -209,37 +411,192 @@ func (i Image) Validate() error {
 	return nil
 }
 
+// AttributeKind is the type of an attribute value
+type AttributeKind string
+
+const (
+	AttributeKindString AttributeKind = "string"
+	AttributeKindNumber AttributeKind = "number"
+	AttributeKindBool   AttributeKind = "bool"
+)
+
+// AttributeValue is a value object holding one typed attribute value
+type AttributeValue struct {
+	kind   AttributeKind
+	text   string
+	number float64
+	flag   bool
+}
+
+func StringAttribute(value string) AttributeValue {
+	return AttributeValue{kind: AttributeKindString, text: strings.TrimSpace(value)}
+}
+
+// NumberAttribute creates a numeric attribute; NaN and infinities are rejected
+func NumberAttribute(value float64) (AttributeValue, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return AttributeValue{}, NewDomainError("attribute number must be finite")
+	}
+	return AttributeValue{kind: AttributeKindNumber, number: value}, nil
+}
+
+func BoolAttribute(value bool) AttributeValue {
+	return AttributeValue{kind: AttributeKindBool, flag: value}
+}
+
+func (v AttributeValue) Kind() AttributeKind {
+	return v.kind
+}
+
+// String returns the value as text, e.g. "1.5" or "true" for typed values
+func (v AttributeValue) String() string {
+	switch v.kind {
+	case AttributeKindNumber:
+		return strconv.FormatFloat(v.number, 'f', -1, 64)
+	case AttributeKindBool:
+		return strconv.FormatBool(v.flag)
+	default:
+		return v.text
+	}
+}
+
+// Number returns the value if it is numeric
+func (v AttributeValue) Number() (float64, bool) {
+	return v.number, v.kind == AttributeKindNumber
+}
+
+// Bool returns the value if it is a boolean
+func (v AttributeValue) Bool() (bool, bool) {
+	return v.flag, v.kind == AttributeKindBool
+}
+
+// Interface returns the value as a string, float64 or bool, ready for JSON encoding
+func (v AttributeValue) Interface() interface{} {
+	switch v.kind {
+	case AttributeKindNumber:
+		return v.number
+	case AttributeKindBool:
+		return v.flag
+	default:
+		return v.text
+	}
+}
+
 // Attributes is a value object representing item attributes
+// It is safe for concurrent use: every write copies the map and swaps the copy in under a
+// lock, so a reader never sees the map change while it holds it. Copies of an Attributes
+// share their contents the way a map does; Item.Attributes hands out a detached snapshot
 type Attributes struct {
-	data map[string]string
+	set *attributeSet
+}
+
+type attributeSet struct {
+	mu   sync.RWMutex
+	data map[string]AttributeValue
 }
 
 func NewAttributes() Attributes {
 	return Attributes{
-		data: make(map[string]string),
+		set: &attributeSet{data: make(map[string]AttributeValue)},
 	}
 }
 
+// Set stores a string attribute
 func (a *Attributes) Set(key, value string) error {
+	return a.SetValue(key, StringAttribute(value))
+}
+
+// SetValue stores an attribute of any kind
+func (a *Attributes) SetValue(key string, value AttributeValue) error {
 	key = strings.TrimSpace(key)
-	value = strings.TrimSpace(value)
-	
+
 	if key == "" {
 		return NewDomainError("attribute key cannot be empty")
 	}
-	
-	a.data[key] = value
+	if value.kind == "" {
+		return NewDomainError("attribute value must have a kind")
+	}
+
+	a.update(func(data map[string]AttributeValue) bool {
+		data[key] = value
+		return true
+	})
 	return nil
 }
 
+// Remove deletes an attribute and reports whether it was present
+func (a *Attributes) Remove(key string) bool {
+	key = strings.TrimSpace(key)
+	return a.update(func(data map[string]AttributeValue) bool {
+		if _, exists := data[key]; !exists {
+			return false
+		}
+		delete(data, key)
+		return true
+	})
+}
+
+// update applies change to a copy of the map and publishes the copy if change reports a change
+// The zero Attributes gets its storage here, so only values made by NewAttributes may be shared
+func (a *Attributes) update(change func(map[string]AttributeValue) bool) bool {
+	if a.set == nil {
+		a.set = &attributeSet{}
+	}
+	a.set.mu.Lock()
+	defer a.set.mu.Unlock()
+
+	data := make(map[string]AttributeValue, len(a.set.data)+1)
+	for k, v := range a.set.data {
+		data[k] = v
+	}
+	if !change(data) {
+		return false
+	}
+	a.set.data = data
+	return true
+}
+
+// snapshot returns the current map, which is never written again once published
+func (a Attributes) snapshot() map[string]AttributeValue {
+	if a.set == nil {
+		return nil
+	}
+	a.set.mu.RLock()
+	defer a.set.mu.RUnlock()
+	return a.set.data
+}
+
+// clone returns Attributes with the same values and storage of their own
+func (a Attributes) clone() Attributes {
+	return Attributes{set: &attributeSet{data: a.Values()}}
+}
+
+// Get returns an attribute as text, whatever its kind
 func (a Attributes) Get(key string) (string, bool) {
-	value, exists := a.data[key]
+	value, exists := a.snapshot()[key]
+	return value.String(), exists
+}
+
+// Value returns an attribute with its kind
+func (a Attributes) Value(key string) (AttributeValue, bool) {
+	value, exists := a.snapshot()[key]
 	return value, exists
 }
 
+// All returns every attribute as text
 func (a Attributes) All() map[string]string {
 	result := make(map[string]string)
-	for k, v := range a.data {
+	for k, v := range a.snapshot() {
+		result[k] = v.String()
+	}
+	return result
+}
+
+// Values returns every attribute with its kind
+func (a Attributes) Values() map[string]AttributeValue {
+	data := a.snapshot()
+	result := make(map[string]AttributeValue, len(data))
+	for k, v := range data {
 		result[k] = v
 	}
 	return result
@@ -283,4 +640,32 @@ func StatusFromString(status string) (Status, error) {
 	default:
 		return StatusActive, NewDomainError("invalid status: " + status)
 	}
-} 
\ No newline at end of file
+} 
+
+// AllStatuses returns every item status in declaration order
+func AllStatuses() []Status {
+	return []Status{StatusActive, StatusInactive, StatusDraft, StatusArchived}
+}
+
+// statusTransitions lists the statuses each status may move to
+// Archived items can only be restored to draft, through Unarchive, and must be activated explicitly
+var statusTransitions = map[Status][]Status{
+	StatusDraft:    {StatusActive, StatusArchived},
+	StatusActive:   {StatusInactive, StatusArchived},
+	StatusInactive: {StatusActive, StatusArchived},
+	StatusArchived: {StatusDraft},
+}
+
+// CanTransitionTo reports whether moving to target is allowed
+// Staying in the same status is always allowed
+func (s Status) CanTransitionTo(target Status) bool {
+	if s == target {
+		return true
+	}
+	for _, allowed := range statusTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
@@ -1,10 +1,14 @@
 package item
 
 import (
+	"fmt"
+	"math"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewItemID(t *testing.T) {
@@ -79,6 +83,62 @@ func TestNewSKU(t *testing.T) {
 	}
 }
 
+func TestGenerateSKU(t *testing.T) {
+	tests := []struct {
+		category string
+		seq      int
+		expected string
+	}{
+		{"Electronics", 123, "ELEC-000123"},
+		{"Home & Garden", 1, "HOME-000001"},
+		{"TV", 42, "TV-000042"},
+		{"3D Printers", 7, "3DPR-000007"},
+		{"Café", 9, "CAF-000009"},
+		{"Ωμέγα", 5, "ITEM-000005"},
+		{"Books", 1234567, "BOOK-1234567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			category, err := NewCategory(tt.category)
+			require.NoError(t, err)
+
+			sku, err := GenerateSKU(category, tt.seq)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, sku.String())
+			assert.NoError(t, validateSKU(sku.String()))
+		})
+	}
+
+	t.Run("deterministic", func(t *testing.T) {
+		category, err := NewCategory("Electronics")
+		require.NoError(t, err)
+
+		first, err := GenerateSKU(category, 10)
+		require.NoError(t, err)
+		second, err := GenerateSKU(category, 10)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("negative sequence", func(t *testing.T) {
+		category, err := NewCategory("Electronics")
+		require.NoError(t, err)
+
+		_, err = GenerateSKU(category, -1)
+		assert.Error(t, err)
+	})
+
+	t.Run("sequence too long for a SKU", func(t *testing.T) {
+		category, err := NewCategory("Electronics")
+		require.NoError(t, err)
+
+		_, err = GenerateSKU(category, math.MaxInt64)
+		assert.Error(t, err)
+	})
+}
+
 func TestNewPrice(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -92,6 +152,11 @@ func TestNewPrice(t *testing.T) {
 		{"zero price", 0.0, "USD", false, ""},
 		{"empty currency defaults to USD", 50.00, "", false, ""},
 		{"negative price", -10.0, "USD", true, "price cannot be negative"},
+		{"largest price", MaxPriceAmount, "USD", false, ""},
+		{"above the maximum", MaxPriceAmount + 0.01, "USD", true, "price cannot exceed 10000000000000"},
+		{"positive infinity", math.Inf(1), "USD", true, "price must be a finite number"},
+		{"negative infinity", math.Inf(-1), "USD", true, "price must be a finite number"},
+		{"not a number", math.NaN(), "USD", true, "price must be a finite number"},
 	}
 
 	for _, tt := range tests {
@@ -114,11 +179,126 @@ func TestNewPrice(t *testing.T) {
 	}
 }
 
+func TestPrice_Cents(t *testing.T) {
+	t.Run("amounts are rounded to the nearest cent", func(t *testing.T) {
+		price, err := NewPrice(19.99, "USD")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1999), price.Cents())
+		assert.Equal(t, 19.99, price.Amount())
+	})
+
+	t.Run("floating point error does not shift the cent", func(t *testing.T) {
+		tenth, err := NewPrice(0.1, "USD")
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), tenth.Cents())
+
+		sum, err := NewPrice(0.1+0.2, "USD")
+		require.NoError(t, err)
+		assert.Equal(t, int64(30), sum.Cents())
+
+		// 1.005 is stored as 1.00499999999999989...
+		halfCent, err := NewPrice(1.005, "USD")
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), halfCent.Cents())
+	})
+
+	t.Run("from cents round trips", func(t *testing.T) {
+		price, err := NewPriceFromCents(9999, "usd")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9999), price.Cents())
+		assert.Equal(t, 99.99, price.Amount())
+		assert.Equal(t, "USD", price.Currency())
+	})
+
+	t.Run("negative cents rejected", func(t *testing.T) {
+		_, err := NewPriceFromCents(-1, "USD")
+		assert.Error(t, err)
+	})
+
+	t.Run("cents above the maximum rejected", func(t *testing.T) {
+		_, err := NewPriceFromCents(MaxPriceAmount*100, "USD")
+		assert.NoError(t, err)
+
+		_, err = NewPriceFromCents(MaxPriceAmount*100+1, "USD")
+		assert.ErrorIs(t, err, ErrInvalidPrice)
+	})
+}
+
 func TestPrice_String(t *testing.T) {
 	price, _ := NewPrice(99.99, "USD")
 	assert.Equal(t, "99.99 USD", price.String())
 }
 
+func TestPrice_Arithmetic(t *testing.T) {
+	usd := func(cents int64) Price {
+		price, err := NewPriceFromCents(cents, "USD")
+		require.NoError(t, err)
+		return price
+	}
+	eur, err := NewPriceFromCents(1000, "EUR")
+	require.NoError(t, err)
+
+	t.Run("add", func(t *testing.T) {
+		sum, err := usd(1999).Add(usd(1))
+		require.NoError(t, err)
+		assert.True(t, sum.Equals(usd(2000)))
+	})
+
+	t.Run("add across currencies is rejected", func(t *testing.T) {
+		_, err := usd(1000).Add(eur)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "currency mismatch: USD and EUR")
+	})
+
+	t.Run("subtract", func(t *testing.T) {
+		diff, err := usd(1000).Subtract(usd(1))
+		require.NoError(t, err)
+		assert.Equal(t, int64(999), diff.Cents())
+
+		_, err = usd(1).Subtract(usd(2))
+		assert.Error(t, err)
+
+		_, err = usd(1000).Subtract(eur)
+		assert.Error(t, err)
+	})
+
+	t.Run("multiply by a discount factor is exact", func(t *testing.T) {
+		discounted, err := usd(10000).MultiplyByFactor(0.95)
+		require.NoError(t, err)
+		assert.True(t, discounted.Equals(usd(9500)))
+		assert.Equal(t, 95.0, discounted.Amount())
+		assert.Equal(t, "USD", discounted.Currency())
+	})
+
+	t.Run("multiply rounds to the nearest cent", func(t *testing.T) {
+		discounted, err := usd(9999).MultiplyByFactor(0.9)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8999), discounted.Cents())
+
+		_, err = usd(100).MultiplyByFactor(-1)
+		assert.Error(t, err)
+	})
+
+	t.Run("equals compares amount and currency", func(t *testing.T) {
+		assert.True(t, usd(1000).Equals(usd(1000)))
+		assert.False(t, usd(1000).Equals(usd(1001)))
+		assert.False(t, usd(1000).Equals(eur))
+	})
+
+	t.Run("greater than", func(t *testing.T) {
+		greater, err := usd(1001).GreaterThan(usd(1000))
+		require.NoError(t, err)
+		assert.True(t, greater)
+
+		greater, err = usd(1000).GreaterThan(usd(1000))
+		require.NoError(t, err)
+		assert.False(t, greater)
+
+		_, err = usd(1000).GreaterThan(eur)
+		assert.Error(t, err)
+	})
+}
+
 func TestNewCategory(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -127,9 +307,14 @@ func TestNewCategory(t *testing.T) {
 		expectedSlug string
 	}{
 		{"valid category", "Electronics", false, "electronics"},
-		{"category with spaces", "Home & Garden", false, "home-&-garden"},
+		{"category with spaces", "Home & Garden", false, "home-garden"},
 		{"category with trimming", "  Books  ", false, "books"},
+		{"punctuation runs collapse", "--Kids' Toys!! (New)--", false, "kids-toys-new"},
+		{"repeated separators", "Sports__and   Outdoors", false, "sports-and-outdoors"},
+		{"unicode letters are kept", "Électronique & Café", false, "électronique-café"},
+		{"digits are kept", "3D Printers", false, "3d-printers"},
 		{"empty category", "", true, ""},
+		{"punctuation only", "&&& !!!", true, ""},
 	}
 
 	for _, tt := range tests {
@@ -147,6 +332,27 @@ func TestNewCategory(t *testing.T) {
 	}
 }
 
+func TestNewSubcategory(t *testing.T) {
+	phones, err := NewSubcategory("Mobile Phones", " Electronics ")
+	require.NoError(t, err)
+	assert.Equal(t, "mobile-phones", phones.Slug())
+	assert.Equal(t, "electronics", phones.ParentSlug())
+
+	topLevel, err := NewCategory("Electronics")
+	require.NoError(t, err)
+	assert.Empty(t, topLevel.ParentSlug())
+
+	furniture, err := NewSubcategory("Furniture", "Home & Garden")
+	require.NoError(t, err)
+	assert.Equal(t, "home-garden", furniture.ParentSlug())
+
+	_, err = NewSubcategory("Phones", "phones")
+	assert.Error(t, err)
+
+	_, err = NewSubcategory("", "electronics")
+	assert.Error(t, err)
+}
+
 func TestNewInventory(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -182,6 +388,100 @@ func TestInventory_CanReserve(t *testing.T) {
 	assert.False(t, inventory.CanReserve(15))
 }
 
+func TestNewInventoryWithReserved(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int
+		reserved int
+		wantErr  bool
+	}{
+		{"no reservations", 10, 0, false},
+		{"partially reserved", 10, 4, false},
+		{"fully reserved", 10, 10, false},
+		{"negative reserved", 10, -1, true},
+		{"reserved exceeds quantity", 10, 11, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inventory, err := NewInventoryWithReserved(tt.quantity, tt.reserved)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.reserved, inventory.Reserved())
+				assert.Equal(t, tt.quantity-tt.reserved, inventory.Available())
+				assert.Equal(t, tt.quantity > tt.reserved, inventory.IsAvailable())
+			}
+		})
+	}
+}
+
+func TestInventory_ReserveAndRelease(t *testing.T) {
+	inventory, _ := NewInventory(10)
+
+	reserved, err := inventory.Reserve(4)
+	require.NoError(t, err)
+	assert.Equal(t, 10, reserved.Quantity())
+	assert.Equal(t, 4, reserved.Reserved())
+	assert.Equal(t, 6, reserved.Available())
+	assert.Equal(t, 0, inventory.Reserved(), "original value must not change")
+
+	assert.True(t, reserved.CanReserve(6))
+	assert.False(t, reserved.CanReserve(7))
+
+	released, err := reserved.Release(3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, released.Reserved())
+	assert.Equal(t, 9, released.Available())
+}
+
+func TestInventory_ReserveBeyondAvailable(t *testing.T) {
+	inventory, _ := NewInventoryWithReserved(10, 8)
+
+	result, err := inventory.Reserve(3)
+
+	assert.ErrorIs(t, err, ErrInsufficientStock)
+	assert.Equal(t, inventory, result)
+
+	fullyReserved, err := inventory.Reserve(2)
+	require.NoError(t, err)
+	assert.False(t, fullyReserved.IsAvailable())
+}
+
+func TestInventory_ReleaseBeyondReserved(t *testing.T) {
+	inventory, _ := NewInventoryWithReserved(10, 2)
+
+	result, err := inventory.Release(3)
+
+	assert.Error(t, err)
+	assert.Equal(t, ErrReleaseExceedsReserved.Error(), err.Error())
+	assert.Equal(t, inventory, result)
+}
+
+func TestInventory_NonPositiveAmounts(t *testing.T) {
+	inventory, _ := NewInventoryWithReserved(10, 2)
+
+	_, err := inventory.Reserve(0)
+	assert.Error(t, err)
+
+	_, err = inventory.Release(-1)
+	assert.Error(t, err)
+}
+
+func TestInventory_WithQuantity(t *testing.T) {
+	inventory, _ := NewInventoryWithReserved(10, 4)
+
+	restocked, err := inventory.WithQuantity(20)
+	require.NoError(t, err)
+	assert.Equal(t, 20, restocked.Quantity())
+	assert.Equal(t, 4, restocked.Reserved())
+
+	_, err = inventory.WithQuantity(3)
+	assert.Error(t, err, "quantity cannot drop below reserved units")
+}
+
 func TestNewImage(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -240,6 +540,89 @@ func TestAttributes(t *testing.T) {
 	assert.Equal(t, "large", all["size"])
 }
 
+func TestAttributes_Typed(t *testing.T) {
+	attributes := NewAttributes()
+
+	weight, err := NumberAttribute(2.5)
+	require.NoError(t, err)
+	require.NoError(t, attributes.SetValue("weight", weight))
+	require.NoError(t, attributes.SetValue("waterproof", BoolAttribute(true)))
+	require.NoError(t, attributes.Set("color", "red"))
+
+	value, ok := attributes.Value("weight")
+	require.True(t, ok)
+	assert.Equal(t, AttributeKindNumber, value.Kind())
+	number, isNumber := value.Number()
+	assert.True(t, isNumber)
+	assert.Equal(t, 2.5, number)
+	_, isBool := value.Bool()
+	assert.False(t, isBool)
+
+	// String accessors keep working for every kind
+	text, ok := attributes.Get("weight")
+	assert.True(t, ok)
+	assert.Equal(t, "2.5", text)
+	assert.Equal(t, map[string]string{"weight": "2.5", "waterproof": "true", "color": "red"}, attributes.All())
+
+	color, _ := attributes.Value("color")
+	assert.Equal(t, AttributeKindString, color.Kind())
+	assert.Equal(t, "red", color.Interface())
+
+	_, err = NumberAttribute(math.NaN())
+	assert.Error(t, err)
+	assert.Error(t, attributes.SetValue("empty", AttributeValue{}))
+}
+
+// Run with -race: Set and Get on one Attributes from many goroutines must not race
+func TestAttributes_Concurrent(t *testing.T) {
+	attributes := NewAttributes()
+	require.NoError(t, attributes.Set("color", "red"))
+
+	const goroutines, writes = 8, 200
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				_ = attributes.Set(fmt.Sprintf("key-%d", g), fmt.Sprint(i))
+				attributes.Remove("scratch")
+				_ = attributes.Set("scratch", "x")
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				color, ok := attributes.Get("color")
+				assert.True(t, ok)
+				assert.Equal(t, "red", color)
+				for range attributes.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	all := attributes.All()
+	assert.Len(t, all, goroutines+2)
+	for g := 0; g < goroutines; g++ {
+		assert.Equal(t, fmt.Sprint(writes-1), all[fmt.Sprintf("key-%d", g)])
+	}
+}
+
+func TestAttributes_ZeroValue(t *testing.T) {
+	var attributes Attributes
+
+	_, ok := attributes.Get("color")
+	assert.False(t, ok)
+	assert.Empty(t, attributes.Values())
+
+	require.NoError(t, attributes.Set("color", "red"))
+	color, ok := attributes.Get("color")
+	assert.True(t, ok)
+	assert.Equal(t, "red", color)
+}
+
 func TestStatus(t *testing.T) {
 	tests := []struct {
 		status   Status
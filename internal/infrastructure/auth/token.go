@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// sessionIDBytes is the number of random bytes in a session identifier
+const sessionIDBytes = 32
+
+// ErrInvalidToken is returned when a token fails signature or claim validation
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the JWT claims carried by an access token
+type Claims struct {
+	jwt.RegisteredClaims
+}
+
+// IssuedToken is a freshly signed access token
+type IssuedToken struct {
+	AccessToken string
+	SessionID   string
+	ExpiresIn   time.Duration
+}
+
+// TokenService issues and verifies HS256-signed access tokens
+type TokenService struct {
+	secret []byte
+	issuer string
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewTokenService creates a token service from the auth configuration
+func NewTokenService(cfg *config.Config) (*TokenService, error) {
+	if cfg.Secrets.JWTSecret == "" {
+		return nil, errors.New("JWT_SECRET must be configured")
+	}
+
+	ttl := cfg.Auth.TokenTTL
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+
+	return &TokenService{
+		secret: []byte(cfg.Secrets.JWTSecret),
+		issuer: cfg.Auth.Issuer,
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+// Issue signs a new access token bound to a random session identifier
+func (s *TokenService) Issue() (*IssuedToken, error) {
+	sessionID, err := generateSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
+			Subject:   sessionID,
+			Issuer:    s.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.ttl)),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return &IssuedToken{
+		AccessToken: signed,
+		SessionID:   sessionID,
+		ExpiresIn:   s.ttl,
+	}, nil
+}
+
+// Verify checks the token signature, algorithm, issuer and expiry
+func (s *TokenService) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			return s.secret, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	return claims, nil
+}
+
+// generateSessionID returns a base64url-encoded identifier from crypto/rand
+func generateSessionID() (string, error) {
+	buf := make([]byte, sessionIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate session ID: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTokenService(t *testing.T) *TokenService {
+	t.Helper()
+
+	cfg := &config.Config{
+		Auth: config.AuthConfig{
+			Issuer:   "item-pdp-service",
+			TokenTTL: time.Hour,
+		},
+		Secrets: config.SecretsConfig{
+			JWTSecret: "test-secret",
+		},
+	}
+
+	service, err := NewTokenService(cfg)
+	require.NoError(t, err)
+	return service
+}
+
+func TestNewTokenService(t *testing.T) {
+	t.Run("missing secret", func(t *testing.T) {
+		service, err := NewTokenService(&config.Config{})
+
+		assert.Error(t, err)
+		assert.Nil(t, service)
+	})
+}
+
+func TestTokenService_Issue(t *testing.T) {
+	service := newTestTokenService(t)
+
+	first, err := service.Issue()
+	require.NoError(t, err)
+	second, err := service.Issue()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.AccessToken, second.AccessToken)
+	assert.NotEqual(t, first.SessionID, second.SessionID)
+	assert.Len(t, first.SessionID, 43) // 32 bytes, unpadded base64url
+	assert.Equal(t, time.Hour, first.ExpiresIn)
+}
+
+func TestTokenService_Verify(t *testing.T) {
+	service := newTestTokenService(t)
+
+	t.Run("valid token", func(t *testing.T) {
+		token, err := service.Issue()
+		require.NoError(t, err)
+
+		claims, err := service.Verify(token.AccessToken)
+
+		assert.NoError(t, err)
+		assert.Equal(t, token.SessionID, claims.Subject)
+		assert.Equal(t, "item-pdp-service", claims.Issuer)
+	})
+
+	t.Run("tampered token", func(t *testing.T) {
+		token, err := service.Issue()
+		require.NoError(t, err)
+
+		parts := strings.Split(token.AccessToken, ".")
+		require.Len(t, parts, 3)
+		other, err := service.Issue()
+		require.NoError(t, err)
+		// Swap in another token's payload while keeping the original signature
+		parts[1] = strings.Split(other.AccessToken, ".")[1]
+		tampered := strings.Join(parts, ".")
+
+		claims, err := service.Verify(tampered)
+
+		assert.Nil(t, claims)
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+
+	t.Run("token signed with another key", func(t *testing.T) {
+		other := newTestTokenService(t)
+		other.secret = []byte("another-secret")
+		token, err := other.Issue()
+		require.NoError(t, err)
+
+		_, err = service.Verify(token.AccessToken)
+
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		expired := newTestTokenService(t)
+		expired.now = func() time.Time { return time.Now().Add(-2 * time.Hour) }
+		token, err := expired.Issue()
+		require.NoError(t, err)
+
+		_, err = service.Verify(token.AccessToken)
+
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		_, err := service.Verify("not-a-jwt")
+
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+}
@@ -0,0 +1,20 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrCacheMiss is returned by Get when the key is not cached or has expired
+var ErrCacheMiss = errors.New("cache miss")
+
+// Cache stores opaque values by key with a time-to-live
+type Cache interface {
+	// Get returns the cached value or ErrCacheMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set stores value under key; a non-positive ttl keeps it until evicted
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete evicts key; deleting a missing key is not an error
+	Delete(ctx context.Context, key string) error
+}
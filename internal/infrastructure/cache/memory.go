@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultMemoryMaxEntries bounds a MemoryCache created without a positive size
+const DefaultMemoryMaxEntries = 10000
+
+// MemoryCache is a size-bounded Cache local to one process
+// Once full, adding an entry evicts the least recently used one
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // front is most recently used
+	entries    map[string]*list.Element
+	now        func() time.Time
+}
+
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time // zero means no expiry
+}
+
+// NewMemoryCache creates an LRU cache holding at most maxEntries values
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return NewMemoryCacheWithClock(maxEntries, time.Now)
+}
+
+// NewMemoryCacheWithClock creates an LRU cache that reads the time from now
+func NewMemoryCacheWithClock(maxEntries int, now func() time.Time) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMemoryMaxEntries
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+		now:        now,
+	}
+}
+
+// Get returns the cached value or ErrCacheMiss; expired entries are dropped on read
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+
+	entry := elem.Value.(*memoryEntry)
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.remove(elem)
+		return nil, ErrCacheMiss
+	}
+
+	c.order.MoveToFront(elem)
+	return append([]byte(nil), entry.value...), nil
+}
+
+// Set stores a copy of value under key, evicting the least recently used entries when full
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &memoryEntry{key: key, value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		entry.expiresAt = c.now().Add(ttl)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return nil
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+// Delete evicts key
+func (c *MemoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	return nil
+}
+
+// Len returns the number of entries, including expired ones not yet dropped
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *MemoryCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*memoryEntry).key)
+}
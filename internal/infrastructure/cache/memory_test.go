@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a settable time source
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), 0))
+	require.NoError(t, c.Set(ctx, "b", []byte("2"), 0))
+
+	// Reading a makes b the least recently used
+	_, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set(ctx, "c", []byte("3"), 0))
+
+	assert.Equal(t, 2, c.Len())
+	_, err = c.Get(ctx, "b")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+
+	value, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+	value, err = c.Get(ctx, "c")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("3"), value)
+}
+
+func TestMemoryCache_OverwriteDoesNotGrow(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), 0))
+	require.NoError(t, c.Set(ctx, "a", []byte("2"), 0))
+
+	assert.Equal(t, 1, c.Len())
+	value, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+}
+
+func TestMemoryCache_TTL(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewMemoryCacheWithClock(10, clock.Now)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+
+	clock.Advance(59 * time.Second)
+	_, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+
+	clock.Advance(time.Second)
+	_, err = c.Get(ctx, "a")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestMemoryCache_DeleteAndCopies(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache(0)
+
+	value := []byte("1")
+	require.NoError(t, c.Set(ctx, "a", value, 0))
+	value[0] = 'x'
+
+	cached, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), cached)
+
+	require.NoError(t, c.Delete(ctx, "a"))
+	require.NoError(t, c.Delete(ctx, "missing"))
+	_, err = c.Get(ctx, "a")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+}
+
+func TestMemoryCache_ConcurrentUse(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache(50)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				key := fmt.Sprintf("k%d", (g*200+i)%100)
+				_ = c.Set(ctx, key, []byte(key), time.Minute)
+				_, _ = c.Get(ctx, key)
+				if i%10 == 0 {
+					_ = c.Delete(ctx, key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, c.Len(), 50)
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache is a Cache backed by Redis, shared by every service instance
+type RedisCache struct {
+	client *redis.Client
+}
+
+// NewRedisCache creates a cache for the configured Redis server
+func NewRedisCache(cfg *config.Config) *RedisCache {
+	return NewRedisCacheWithClient(redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	}))
+}
+
+// NewRedisCacheWithClient creates a cache on top of an existing client
+func NewRedisCacheWithClient(client *redis.Client) *RedisCache {
+	return &RedisCache{client: client}
+}
+
+// Get returns the cached value or ErrCacheMiss
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from redis: %w", key, err)
+	}
+	return value, nil
+}
+
+// Set stores value under key with the given ttl
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < 0 {
+		ttl = 0
+	}
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to write %s to redis: %w", key, err)
+	}
+	return nil
+}
+
+// Delete evicts key
+func (c *RedisCache) Delete(ctx context.Context, key string) error {
+	if err := c.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to delete %s from redis: %w", key, err)
+	}
+	return nil
+}
+
+// Close releases the underlying connection pool
+func (c *RedisCache) Close() error {
+	return c.client.Close()
+}
@@ -1,26 +1,44 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
-// Production environment constants
-const (
-	ProductionDBPassword = "P@ssw0rd123!SecretDB"
-	JWTSecretKey         = "myapp-jwt-secret-key-2024"
-	APIKey               = "api-key-abcd1234efgh5678"
-	EncryptionKey        = "32-char-encryption-key-for-prod"
-)
-
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Log      LogConfig      `mapstructure:"log"`
-	App      AppConfig      `mapstructure:"app"`
+	Server        ServerConfig        `mapstructure:"server"`
+	GRPC          GRPCConfig          `mapstructure:"grpc"`
+	Database      DatabaseConfig      `mapstructure:"database"`
+	Log           LogConfig           `mapstructure:"log"`
+	App           AppConfig           `mapstructure:"app"`
+	Auth          AuthConfig          `mapstructure:"auth"`
+	Batch         BatchConfig         `mapstructure:"batch"`
+	Bulk          BulkConfig          `mapstructure:"bulk"`
+	Search        SearchConfig        `mapstructure:"search"`
+	Idempotency   IdempotencyConfig   `mapstructure:"idempotency"`
+	RateLimit     RateLimitConfig     `mapstructure:"rate_limit"`
+	Compression   CompressionConfig   `mapstructure:"compression"`
+	Kafka         KafkaConfig         `mapstructure:"kafka"`
+	Outbox        OutboxConfig        `mapstructure:"outbox"`
+	Webhooks      WebhooksConfig      `mapstructure:"webhooks"`
+	Cache         CacheConfig         `mapstructure:"cache"`
+	Redis         RedisConfig         `mapstructure:"redis"`
+	Tracing       TracingConfig       `mapstructure:"tracing"`
+	Storage       StorageConfig       `mapstructure:"storage"`
+	Currency      CurrencyConfig      `mapstructure:"currency"`
+	Pricing       PricingConfig       `mapstructure:"pricing"`
+	BusinessRules BusinessRulesConfig `mapstructure:"business_rules"`
+	SKU           SKUConfig           `mapstructure:"sku"`
+	Secrets       SecretsConfig       `mapstructure:"secrets"`
+	// Features switches optional behaviours on by name; see FeatureEnabled
+	Features map[string]bool `mapstructure:"features"`
 }
 
 // ServerConfig holds server configuration
@@ -30,6 +48,16 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
 	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	// MaxBodySize caps request bodies in bytes; it must leave room for image uploads and CSV imports
+	MaxBodySize int64 `mapstructure:"max_body_size"`
+	// RequestTimeout bounds how long a handler may take before the client gets 503
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+}
+
+// GRPCConfig holds the gRPC server configuration; it listens on Server.Host
+type GRPCConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	Port    int  `mapstructure:"port"`
 }
 
 // DatabaseConfig holds database configuration
@@ -44,6 +72,10 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
 	MigrationsPath  string        `mapstructure:"migrations_path"`
+	// QueryTimeout bounds each repository call so a hung query releases its connection
+	QueryTimeout time.Duration `mapstructure:"query_timeout"`
+	// LenientReads makes list reads skip rows that cannot be converted to items instead of failing
+	LenientReads bool `mapstructure:"lenient_reads"`
 }
 
 // LogConfig holds logging configuration
@@ -59,6 +91,177 @@ type AppConfig struct {
 	Environment string `mapstructure:"environment"`
 }
 
+// AuthConfig holds token issuing configuration
+type AuthConfig struct {
+	Issuer   string        `mapstructure:"issuer"`
+	TokenTTL time.Duration `mapstructure:"token_ttl"`
+}
+
+// BatchConfig holds batch processing limits
+type BatchConfig struct {
+	Concurrency int           `mapstructure:"concurrency"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+}
+
+// BulkConfig holds bulk item creation settings
+type BulkConfig struct {
+	AllOrNothing bool `mapstructure:"all_or_nothing"`
+}
+
+// SearchConfig holds how item search matches queries
+type SearchConfig struct {
+	// Substring switches back to ILIKE matching instead of ranked full-text search
+	Substring bool `mapstructure:"substring"`
+}
+
+// IdempotencyConfig holds how long Idempotency-Key values are remembered
+type IdempotencyConfig struct {
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// RateLimitConfig holds the per client IP request limits
+type RateLimitConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	RPS     int  `mapstructure:"rps"`
+	Burst   int  `mapstructure:"burst"`
+}
+
+// CompressionConfig holds gzip response compression settings
+type CompressionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MinSize is the smallest response body in bytes that is compressed
+	MinSize int `mapstructure:"min_size"`
+	// Level is a gzip level from 1 (fastest) to 9 (smallest)
+	Level int `mapstructure:"level"`
+}
+
+// KafkaConfig holds event publishing configuration
+type KafkaConfig struct {
+	Brokers      []string      `mapstructure:"brokers"`
+	Topic        string        `mapstructure:"topic"`
+	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+}
+
+// OutboxConfig holds event outbox relay settings
+type OutboxConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+}
+
+// WebhooksConfig holds webhook delivery settings
+// A failed delivery is retried after InitialBackoff, doubling per attempt up to MaxBackoff
+type WebhooksConfig struct {
+	PollInterval   time.Duration `mapstructure:"poll_interval"`
+	BatchSize      int           `mapstructure:"batch_size"`
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	// Timeout bounds each POST to a webhook
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// CacheConfig holds item read cache settings
+// Backend is "redis" (shared) or "memory" (per instance, bounded by MaxEntries)
+type CacheConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	Backend    string        `mapstructure:"backend"`
+	TTL        time.Duration `mapstructure:"ttl"`
+	MaxEntries int           `mapstructure:"max_entries"`
+}
+
+// RedisConfig holds Redis connection settings
+type RedisConfig struct {
+	Addr     string `mapstructure:"addr"`
+	Password string `mapstructure:"password"`
+	DB       int    `mapstructure:"db"`
+}
+
+// TracingConfig holds OpenTelemetry trace export settings
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// StorageConfig holds uploaded image storage settings
+// Backend is "local" (files under LocalDir, served at BaseURL) or "s3"
+type StorageConfig struct {
+	Backend       string `mapstructure:"backend"`
+	LocalDir      string `mapstructure:"local_dir"`
+	BaseURL       string `mapstructure:"base_url"`
+	MaxUploadSize int64  `mapstructure:"max_upload_size"`
+	S3Bucket      string `mapstructure:"s3_bucket"`
+	S3Region      string `mapstructure:"s3_region"`
+	S3Endpoint    string `mapstructure:"s3_endpoint"`
+	S3PublicURL   string `mapstructure:"s3_public_url"`
+}
+
+// CurrencyConfig holds the exchange rates used to convert prices
+// Each rate is the units of that currency worth one unit of Base
+type CurrencyConfig struct {
+	Base  string             `mapstructure:"base"`
+	Rates map[string]float64 `mapstructure:"rates"`
+}
+
+// PricingConfig holds the category discount factors, keyed by category slug
+// An empty map keeps the built-in item.DefaultCategoryDiscounts
+type PricingConfig struct {
+	CategoryDiscounts map[string]float64 `mapstructure:"category_discounts"`
+}
+
+// BusinessRulesConfig holds the thresholds items are validated and corrected against
+// Category discounts live in PricingConfig
+type BusinessRulesConfig struct {
+	MaxPriceThreshold float64 `mapstructure:"max_price_threshold"`
+	// MinInventoryLevel is the stock auto-correction raises new items to when they have some but less; 0 disables it
+	MinInventoryLevel int    `mapstructure:"min_inventory_level"`
+	DefaultCurrency   string `mapstructure:"default_currency"`
+	// AllowedCurrencies are the currencies items may be priced in; DefaultCurrency must be one of them
+	AllowedCurrencies []string `mapstructure:"allowed_currencies"`
+	// AutoCorrect corrects new items to the rules and reports each change as a warning,
+	// unless a request says otherwise
+	AutoCorrect bool `mapstructure:"auto_correct"`
+	// OffSeasonMonths are the months (1 to 12) active items in the seasonal category are
+	// deactivated when updated; an empty list disables it
+	OffSeasonMonths []int `mapstructure:"off_season_months"`
+}
+
+// SKUConfig holds the rules SKUs must follow
+// Case is how letters are folded before validation: upper, lower or preserve
+type SKUConfig struct {
+	MinLength int    `mapstructure:"min_length"`
+	MaxLength int    `mapstructure:"max_length"`
+	Pattern   string `mapstructure:"pattern"`
+	Case      string `mapstructure:"case"`
+}
+
+// SecretsConfig holds credentials that are only ever read from the environment
+type SecretsConfig struct {
+	DBPassword    string `mapstructure:"db_password"`
+	JWTSecret     string `mapstructure:"jwt_secret"`
+	APIKey        string `mapstructure:"api_key"`
+	EncryptionKey string `mapstructure:"encryption_key"`
+}
+
+// featureEnvPrefix starts the environment variables that set feature flags,
+// e.g. FEATURES_STRICT_PRICING=true sets strict_pricing
+const featureEnvPrefix = "FEATURES_"
+
+// FeatureEnabled reports whether the named feature is switched on
+// Names are matched case-insensitively, and features that are not configured are off
+func (c *Config) FeatureEnabled(name string) bool {
+	return c.Features[strings.ToLower(name)]
+}
+
+// secretEnvVars maps secret config keys to their environment variables
+var secretEnvVars = map[string]string{
+	"secrets.db_password":    "DB_PASSWORD",
+	"secrets.jwt_secret":     "JWT_SECRET",
+	"secrets.api_key":        "API_KEY",
+	"secrets.encryption_key": "ENCRYPTION_KEY",
+}
+
 // Load reads configuration from file and environment variables
 func Load(configPath string) (*Config, error) {
 	viper.SetConfigName("config")
@@ -70,9 +273,14 @@ func Load(configPath string) (*Config, error) {
 	// Set default values
 	setDefaults()
 
-	// Enable automatic env var binding
+	// Enable automatic env var binding (server.port -> SERVER_PORT)
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
 
+	if err := bindSecrets(); err != nil {
+		return nil, err
+	}
+
 	// Try to read config file
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -86,9 +294,80 @@ func Load(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if config.Secrets.DBPassword != "" {
+		config.Database.Password = config.Secrets.DBPassword
+	}
+
+	if err := config.applyFeatureEnv(os.Environ()); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &config, nil
 }
 
+// applyFeatureEnv sets the feature flags named by FEATURES_* variables in env, over the config file
+// Viper only binds environment variables to keys it already knows, so flags are read here
+func (c *Config) applyFeatureEnv(env []string) error {
+	for _, kv := range env {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(key, featureEnvPrefix) || len(key) == len(featureEnvPrefix) {
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q is not a boolean", key, value)
+		}
+		if c.Features == nil {
+			c.Features = make(map[string]bool)
+		}
+		c.Features[strings.ToLower(strings.TrimPrefix(key, featureEnvPrefix))] = enabled
+	}
+	return nil
+}
+
+// bindSecrets binds each secret to its environment variable
+func bindSecrets() error {
+	for key, env := range secretEnvVars {
+		if err := viper.BindEnv(key, env); err != nil {
+			return fmt.Errorf("failed to bind %s: %w", env, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the configuration is usable for the current environment
+// The connection pool must not keep more idle connections than it may open,
+// and production must receive DB_PASSWORD and JWT_SECRET from the environment
+func (c *Config) Validate() error {
+	// Zero max_open_conns means unlimited, so any idle limit fits
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		return fmt.Errorf("database max_idle_conns (%d) must not exceed max_open_conns (%d)",
+			c.Database.MaxIdleConns, c.Database.MaxOpenConns)
+	}
+
+	if !c.IsProduction() {
+		return nil
+	}
+
+	var missing []string
+	if c.Secrets.DBPassword == "" {
+		missing = append(missing, "DB_PASSWORD")
+	}
+	if c.Secrets.JWTSecret == "" {
+		missing = append(missing, "JWT_SECRET")
+	}
+	if len(missing) > 0 {
+		return errors.New("missing required secrets: " + strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
 // setDefaults sets default configuration values
 func setDefaults() {
 	// Server defaults
@@ -97,6 +376,12 @@ func setDefaults() {
 	viper.SetDefault("server.read_timeout", "10s")
 	viper.SetDefault("server.write_timeout", "10s")
 	viper.SetDefault("server.idle_timeout", "60s")
+	viper.SetDefault("server.max_body_size", 10<<20)
+	viper.SetDefault("server.request_timeout", "8s")
+
+	// gRPC defaults
+	viper.SetDefault("grpc.enabled", true)
+	viper.SetDefault("grpc.port", 9090)
 
 	// Database defaults
 	viper.SetDefault("database.host", "localhost")
@@ -109,6 +394,8 @@ func setDefaults() {
 	viper.SetDefault("database.max_idle_conns", 25)
 	viper.SetDefault("database.conn_max_lifetime", "5m")
 	viper.SetDefault("database.migrations_path", "file://migrations")
+	viper.SetDefault("database.query_timeout", "5s")
+	viper.SetDefault("database.lenient_reads", false)
 
 	// Log defaults
 	viper.SetDefault("log.level", "info")
@@ -118,6 +405,100 @@ func setDefaults() {
 	viper.SetDefault("app.name", "item-pdp-service")
 	viper.SetDefault("app.version", "1.0.0")
 	viper.SetDefault("app.environment", "development")
+
+	// Auth defaults
+	viper.SetDefault("auth.issuer", "item-pdp-service")
+	viper.SetDefault("auth.token_ttl", "24h")
+
+	// Batch defaults
+	viper.SetDefault("batch.concurrency", 8)
+	viper.SetDefault("batch.timeout", "30s")
+
+	// Bulk defaults
+	viper.SetDefault("bulk.all_or_nothing", false)
+
+	// Search defaults
+	viper.SetDefault("search.substring", false)
+
+	// Idempotency defaults
+	viper.SetDefault("idempotency.ttl", "24h")
+
+	// Rate limit defaults
+	viper.SetDefault("rate_limit.enabled", true)
+	viper.SetDefault("rate_limit.rps", 20)
+	viper.SetDefault("rate_limit.burst", 40)
+
+	// Kafka defaults
+	viper.SetDefault("kafka.brokers", []string{"localhost:9092"})
+	viper.SetDefault("kafka.topic", "item-events")
+	viper.SetDefault("kafka.write_timeout", "5s")
+
+	// Compression defaults
+	viper.SetDefault("compression.enabled", true)
+	viper.SetDefault("compression.min_size", 1024)
+	viper.SetDefault("compression.level", 6)
+
+	// Outbox defaults
+	viper.SetDefault("outbox.poll_interval", "1s")
+	viper.SetDefault("outbox.batch_size", 100)
+
+	// Webhook defaults
+	viper.SetDefault("webhooks.poll_interval", "1s")
+	viper.SetDefault("webhooks.batch_size", 50)
+	viper.SetDefault("webhooks.max_attempts", 8)
+	viper.SetDefault("webhooks.initial_backoff", "30s")
+	viper.SetDefault("webhooks.max_backoff", "1h")
+	viper.SetDefault("webhooks.timeout", "10s")
+
+	// Cache defaults
+	viper.SetDefault("cache.enabled", false)
+	viper.SetDefault("cache.backend", "redis")
+	viper.SetDefault("cache.ttl", "5m")
+	viper.SetDefault("cache.max_entries", 10000)
+
+	// Redis defaults
+	viper.SetDefault("redis.addr", "localhost:6379")
+	viper.SetDefault("redis.password", "")
+	viper.SetDefault("redis.db", 0)
+
+	// Tracing defaults
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.endpoint", "localhost:4318")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+
+	// Storage defaults
+	viper.SetDefault("storage.backend", "local")
+	viper.SetDefault("storage.local_dir", "./uploads")
+	viper.SetDefault("storage.base_url", "http://localhost:8080/uploads")
+	viper.SetDefault("storage.max_upload_size", 5<<20)
+	viper.SetDefault("storage.s3_bucket", "")
+	viper.SetDefault("storage.s3_region", "us-east-1")
+	viper.SetDefault("storage.s3_endpoint", "")
+	viper.SetDefault("storage.s3_public_url", "")
+
+	// Business rule defaults
+	viper.SetDefault("business_rules.max_price_threshold", 999999)
+	viper.SetDefault("business_rules.min_inventory_level", 5)
+	viper.SetDefault("business_rules.default_currency", "USD")
+	viper.SetDefault("business_rules.allowed_currencies", []string{"USD", "EUR", "GBP", "JPY"})
+	viper.SetDefault("business_rules.auto_correct", false)
+	viper.SetDefault("business_rules.off_season_months", []int{1, 2, 3, 4, 5, 10, 11, 12})
+
+	// SKU defaults
+	viper.SetDefault("sku.min_length", 3)
+	viper.SetDefault("sku.max_length", 20)
+	viper.SetDefault("sku.pattern", "^[A-Z0-9-_]+$")
+	viper.SetDefault("sku.case", "upper")
+
+	// Currency defaults
+	viper.SetDefault("currency.base", "USD")
+	viper.SetDefault("currency.rates", map[string]float64{
+		"USD": 1,
+		"EUR": 0.92,
+		"GBP": 0.79,
+		"JPY": 150,
+	})
 }
 
 // GetDSN returns database connection string
@@ -137,6 +518,11 @@ func (c *Config) GetServerAddress() string {
 	return fmt.Sprintf("%s:%d", c.Server.Host, c.Server.Port)
 }
 
+// GetGRPCAddress returns the gRPC server address
+func (c *Config) GetGRPCAddress() string {
+	return fmt.Sprintf("%s:%d", c.Server.Host, c.GRPC.Port)
+}
+
 // IsDevelopment returns true if environment is development
 func (c *Config) IsDevelopment() bool {
 	return c.App.Environment == "development"
@@ -146,3 +532,8 @@ func (c *Config) IsDevelopment() bool {
 func (c *Config) IsProduction() bool {
 	return c.App.Environment == "production"
 }
+
+// IsTest returns true if environment is test
+func (c *Config) IsTest() bool {
+	return c.App.Environment == "test"
+}
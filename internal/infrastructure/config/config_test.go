@@ -0,0 +1,337 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadIsolated loads config with a fresh viper instance so only defaults and env apply
+func loadIsolated(t *testing.T) (*Config, error) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	return Load(t.TempDir())
+}
+
+func TestLoad_ProductionRequiresJWTSecret(t *testing.T) {
+	t.Setenv("APP_ENVIRONMENT", "production")
+	t.Setenv("DB_PASSWORD", "db-secret")
+	t.Setenv("JWT_SECRET", "")
+
+	cfg, err := loadIsolated(t)
+
+	assert.Nil(t, cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "JWT_SECRET")
+	assert.NotContains(t, err.Error(), "DB_PASSWORD")
+}
+
+func TestLoad_EnvOverridesDefaults(t *testing.T) {
+	t.Setenv("APP_ENVIRONMENT", "production")
+	t.Setenv("SERVER_PORT", "9090")
+	t.Setenv("DB_PASSWORD", "db-secret")
+	t.Setenv("JWT_SECRET", "jwt-secret")
+	t.Setenv("API_KEY", "api-key")
+	t.Setenv("ENCRYPTION_KEY", "encryption-key")
+
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.IsProduction())
+	assert.Equal(t, 9090, cfg.Server.Port)
+	assert.Equal(t, "db-secret", cfg.Database.Password)
+	assert.Equal(t, "jwt-secret", cfg.Secrets.JWTSecret)
+	assert.Equal(t, "api-key", cfg.Secrets.APIKey)
+	assert.Equal(t, "encryption-key", cfg.Secrets.EncryptionKey)
+	assert.Contains(t, cfg.GetDSN(), "password=db-secret")
+}
+
+func TestLoad_DevelopmentDefaults(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.IsDevelopment())
+	assert.Equal(t, 8080, cfg.Server.Port)
+	assert.Equal(t, "password", cfg.Database.Password)
+	assert.Empty(t, cfg.Secrets.JWTSecret)
+}
+
+func TestLoad_KafkaBrokersFromEnv(t *testing.T) {
+	t.Setenv("KAFKA_BROKERS", "kafka-1:9092,kafka-2:9092")
+	t.Setenv("KAFKA_TOPIC", "catalog-events")
+
+	cfg, err := loadIsolated(t)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kafka-1:9092", "kafka-2:9092"}, cfg.Kafka.Brokers)
+	assert.Equal(t, "catalog-events", cfg.Kafka.Topic)
+}
+
+func TestLoad_CacheDefaults(t *testing.T) {
+	cfg, err := loadIsolated(t)
+
+	require.NoError(t, err)
+	assert.False(t, cfg.Cache.Enabled)
+	assert.Equal(t, "redis", cfg.Cache.Backend)
+	assert.Equal(t, 5*time.Minute, cfg.Cache.TTL)
+	assert.Equal(t, 10000, cfg.Cache.MaxEntries)
+	assert.Equal(t, "localhost:6379", cfg.Redis.Addr)
+}
+
+func TestLoad_CurrencyDefaults(t *testing.T) {
+	t.Setenv("CURRENCY_BASE", "EUR")
+
+	cfg, err := loadIsolated(t)
+
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", cfg.Currency.Base)
+	assert.Len(t, cfg.Currency.Rates, 4)
+}
+
+func TestLoad_IdempotencyTTL(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, cfg.Idempotency.TTL)
+
+	t.Setenv("IDEMPOTENCY_TTL", "1h")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, cfg.Idempotency.TTL)
+}
+
+func TestLoad_RejectsIdlePoolLargerThanOpen(t *testing.T) {
+	t.Setenv("DATABASE_MAX_OPEN_CONNS", "5")
+
+	_, err := loadIsolated(t)
+
+	assert.ErrorContains(t, err, "max_idle_conns (25) must not exceed max_open_conns (5)")
+}
+
+func TestLoad_QueryTimeout(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, cfg.Database.QueryTimeout)
+
+	t.Setenv("DATABASE_QUERY_TIMEOUT", "250ms")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, cfg.Database.QueryTimeout)
+}
+
+func TestLoad_RequestLimits(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10<<20), cfg.Server.MaxBodySize)
+	assert.Equal(t, 8*time.Second, cfg.Server.RequestTimeout)
+
+	t.Setenv("SERVER_REQUEST_TIMEOUT", "2s")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, cfg.Server.RequestTimeout)
+}
+
+func TestLoad_RateLimit(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.True(t, cfg.RateLimit.Enabled)
+	assert.Equal(t, 20, cfg.RateLimit.RPS)
+	assert.Equal(t, 40, cfg.RateLimit.Burst)
+
+	t.Setenv("RATE_LIMIT_RPS", "5")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.RateLimit.RPS)
+}
+
+func TestLoad_Compression(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, CompressionConfig{Enabled: true, MinSize: 1024, Level: 6}, cfg.Compression)
+
+	t.Setenv("COMPRESSION_ENABLED", "false")
+	t.Setenv("COMPRESSION_MIN_SIZE", "512")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.False(t, cfg.Compression.Enabled)
+	assert.Equal(t, 512, cfg.Compression.MinSize)
+}
+
+func TestLoad_GRPC(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, GRPCConfig{Enabled: true, Port: 9090}, cfg.GRPC)
+	assert.Equal(t, "0.0.0.0:9090", cfg.GetGRPCAddress())
+
+	t.Setenv("GRPC_ENABLED", "false")
+	t.Setenv("GRPC_PORT", "50051")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.False(t, cfg.GRPC.Enabled)
+	assert.Equal(t, 50051, cfg.GRPC.Port)
+}
+
+func TestLoad_SKU(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, SKUConfig{MinLength: 3, MaxLength: 20, Pattern: "^[A-Z0-9-_]+$", Case: "upper"}, cfg.SKU)
+
+	t.Setenv("SKU_MAX_LENGTH", "30")
+	t.Setenv("SKU_CASE", "preserve")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 30, cfg.SKU.MaxLength)
+	assert.Equal(t, "preserve", cfg.SKU.Case)
+}
+
+func TestLoad_BusinessRules(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, BusinessRulesConfig{
+		MaxPriceThreshold: 999999,
+		MinInventoryLevel: 5,
+		DefaultCurrency:   "USD",
+		AllowedCurrencies: []string{"USD", "EUR", "GBP", "JPY"},
+		OffSeasonMonths:   []int{1, 2, 3, 4, 5, 10, 11, 12},
+	}, cfg.BusinessRules)
+
+	t.Setenv("BUSINESS_RULES_MAX_PRICE_THRESHOLD", "500")
+	t.Setenv("BUSINESS_RULES_DEFAULT_CURRENCY", "EUR")
+	t.Setenv("BUSINESS_RULES_ALLOWED_CURRENCIES", "EUR,CAD")
+	t.Setenv("BUSINESS_RULES_OFF_SEASON_MONTHS", "11,12,1")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 500.0, cfg.BusinessRules.MaxPriceThreshold)
+	assert.Equal(t, "EUR", cfg.BusinessRules.DefaultCurrency)
+	assert.Equal(t, []string{"EUR", "CAD"}, cfg.BusinessRules.AllowedCurrencies)
+	assert.Equal(t, []int{11, 12, 1}, cfg.BusinessRules.OffSeasonMonths)
+}
+
+func TestLoad_Webhooks(t *testing.T) {
+	cfg, err := loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, WebhooksConfig{
+		PollInterval:   time.Second,
+		BatchSize:      50,
+		MaxAttempts:    8,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     time.Hour,
+		Timeout:        10 * time.Second,
+	}, cfg.Webhooks)
+
+	t.Setenv("WEBHOOKS_MAX_ATTEMPTS", "3")
+	t.Setenv("WEBHOOKS_INITIAL_BACKOFF", "5s")
+	cfg, err = loadIsolated(t)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.Webhooks.MaxAttempts)
+	assert.Equal(t, 5*time.Second, cfg.Webhooks.InitialBackoff)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{
+			name:   "development without secrets",
+			config: Config{App: AppConfig{Environment: "development"}},
+		},
+		{
+			name: "production with secrets",
+			config: Config{
+				App:     AppConfig{Environment: "production"},
+				Secrets: SecretsConfig{DBPassword: "db", JWTSecret: "jwt"},
+			},
+		},
+		{
+			name:    "production without secrets",
+			config:  Config{App: AppConfig{Environment: "production"}},
+			wantErr: "missing required secrets: DB_PASSWORD, JWT_SECRET",
+		},
+		{
+			name: "production without db password",
+			config: Config{
+				App:     AppConfig{Environment: "production"},
+				Secrets: SecretsConfig{JWTSecret: "jwt"},
+			},
+			wantErr: "missing required secrets: DB_PASSWORD",
+		},
+		{
+			name: "idle pool within the open limit",
+			config: Config{
+				App:      AppConfig{Environment: "development"},
+				Database: DatabaseConfig{MaxOpenConns: 25, MaxIdleConns: 10},
+			},
+		},
+		{
+			name: "idle pool with unlimited open connections",
+			config: Config{
+				App:      AppConfig{Environment: "development"},
+				Database: DatabaseConfig{MaxIdleConns: 10},
+			},
+		},
+		{
+			name: "idle pool larger than the open limit",
+			config: Config{
+				App:      AppConfig{Environment: "development"},
+				Database: DatabaseConfig{MaxOpenConns: 10, MaxIdleConns: 25},
+			},
+			wantErr: "database max_idle_conns (25) must not exceed max_open_conns (10)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLoad_FeatureFlags(t *testing.T) {
+	t.Run("set from the environment", func(t *testing.T) {
+		t.Setenv("FEATURES_STRICT_PRICING", "true")
+		t.Setenv("FEATURES_CACHING", "false")
+
+		cfg, err := loadIsolated(t)
+
+		require.NoError(t, err)
+		assert.True(t, cfg.FeatureEnabled("strict_pricing"))
+		assert.True(t, cfg.FeatureEnabled("STRICT_PRICING"))
+		assert.False(t, cfg.FeatureEnabled("caching"))
+	})
+
+	t.Run("unknown flags are off", func(t *testing.T) {
+		cfg, err := loadIsolated(t)
+
+		require.NoError(t, err)
+		assert.False(t, cfg.FeatureEnabled("no_such_feature"))
+	})
+
+	t.Run("a value that is not a boolean is rejected", func(t *testing.T) {
+		t.Setenv("FEATURES_STRICT_PRICING", "sometimes")
+
+		_, err := loadIsolated(t)
+
+		assert.EqualError(t, err, `invalid FEATURES_STRICT_PRICING: "sometimes" is not a boolean`)
+	})
+}
+
+func TestConfig_FeatureEnabled(t *testing.T) {
+	cfg := &Config{Features: map[string]bool{"strict_pricing": true, "auto_corrections": false}}
+
+	assert.True(t, cfg.FeatureEnabled("strict_pricing"))
+	assert.False(t, cfg.FeatureEnabled("auto_corrections"))
+	assert.False(t, cfg.FeatureEnabled("unknown"))
+	assert.False(t, (&Config{}).FeatureEnabled("strict_pricing"))
+}
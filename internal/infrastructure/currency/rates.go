@@ -0,0 +1,62 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"item-pdp-service/internal/application/usecase"
+)
+
+// RatesConverter converts between currencies with a fixed table of exchange rates
+// Every rate is the number of units of that currency worth one unit of the base currency
+type RatesConverter struct {
+	base  string
+	rates map[string]float64
+}
+
+// NewRatesConverter creates a converter from rates against base; the base itself always has rate 1
+func NewRatesConverter(base string, rates map[string]float64) (*RatesConverter, error) {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	if len(base) != 3 {
+		return nil, fmt.Errorf("invalid base currency %q", base)
+	}
+
+	table := make(map[string]float64, len(rates)+1)
+	for code, rate := range rates {
+		// Config keys arrive lower-cased, so codes are normalised here
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if rate <= 0 {
+			return nil, fmt.Errorf("rate for %s must be positive, got %v", code, rate)
+		}
+		table[code] = rate
+	}
+	if rate, ok := table[base]; ok && rate != 1 {
+		return nil, fmt.Errorf("rate for base currency %s must be 1, got %v", base, rate)
+	}
+	table[base] = 1
+
+	return &RatesConverter{base: base, rates: table}, nil
+}
+
+// Convert converts amount from one currency to another through the base currency
+func (c *RatesConverter) Convert(ctx context.Context, amount float64, from, to string) (float64, error) {
+	fromRate, err := c.rate(from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := c.rate(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return amount / fromRate * toRate, nil
+}
+
+func (c *RatesConverter) rate(code string) (float64, error) {
+	rate, ok := c.rates[strings.ToUpper(code)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", usecase.ErrUnsupportedCurrency, code)
+	}
+	return rate, nil
+}
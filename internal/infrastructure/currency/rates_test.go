@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"context"
+	"testing"
+
+	"item-pdp-service/internal/application/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRatesConverter_Convert(t *testing.T) {
+	converter, err := NewRatesConverter("usd", map[string]float64{"eur": 0.5, "GBP": 0.25})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("from the base currency", func(t *testing.T) {
+		amount, err := converter.Convert(ctx, 10, "USD", "EUR")
+
+		require.NoError(t, err)
+		assert.InDelta(t, 5, amount, 1e-9)
+	})
+
+	t.Run("between two non-base currencies", func(t *testing.T) {
+		amount, err := converter.Convert(ctx, 10, "eur", "gbp")
+
+		require.NoError(t, err)
+		assert.InDelta(t, 5, amount, 1e-9)
+	})
+
+	t.Run("unsupported target currency", func(t *testing.T) {
+		_, err := converter.Convert(ctx, 10, "USD", "CHF")
+
+		assert.ErrorIs(t, err, usecase.ErrUnsupportedCurrency)
+		assert.Contains(t, err.Error(), "CHF")
+	})
+
+	t.Run("unsupported source currency", func(t *testing.T) {
+		_, err := converter.Convert(ctx, 10, "XXX", "USD")
+
+		assert.ErrorIs(t, err, usecase.ErrUnsupportedCurrency)
+	})
+}
+
+func TestNewRatesConverter(t *testing.T) {
+	_, err := NewRatesConverter("USD", map[string]float64{"EUR": 0})
+	assert.Error(t, err)
+
+	_, err = NewRatesConverter("USD", map[string]float64{"USD": 2})
+	assert.Error(t, err)
+
+	_, err = NewRatesConverter("dollars", nil)
+	assert.Error(t, err)
+}
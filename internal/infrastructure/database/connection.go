@@ -15,6 +15,9 @@ import (
 // DB wraps sql.DB to provide additional functionality
 type DB struct {
 	*sql.DB
+
+	// QueryTimeout bounds each repository call; zero leaves calls bound only by their context
+	QueryTimeout time.Duration
 }
 
 // NewConnection creates a new database connection
@@ -40,7 +43,16 @@ func NewConnection(config *config.Config) (*DB, error) {
 		Str("database", config.Database.DBName).
 		Msg("Connected to database")
 
-	return &DB{DB: db}, nil
+	return &DB{DB: db, QueryTimeout: config.Database.QueryTimeout}, nil
+}
+
+// WithQueryTimeout derives a context that is cancelled after QueryTimeout
+// A deadline already on ctx still applies when it is the earlier one
+func (db *DB) WithQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if db.QueryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, db.QueryTimeout)
 }
 
 // Close closes the database connection
@@ -65,8 +77,9 @@ func (db *DB) Health() error {
 }
 
 // WithTransaction executes a function within a database transaction
-func (db *DB) WithTransaction(fn func(*sql.Tx) error) error {
-	tx, err := db.Begin()
+// The transaction is bound to ctx, so it is rolled back if ctx is cancelled before it commits
+func (db *DB) WithTransaction(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratedb "github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+// Migrate applies every pending up migration from source, a migration source URL such as
+// file://migrations, and returns the schema version the database is left at
+// Versions are tracked in schema_migrations, the table the migrate CLI used by the Makefile reads
+func (db *DB) Migrate(ctx context.Context, source string) (uint, error) {
+	// The driver closes what it is given, so it gets its own connection rather than the pool
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get a connection for migrations: %w", err)
+	}
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		conn.Close()
+		return 0, fmt.Errorf("failed to prepare migrations: %w", err)
+	}
+
+	return migrateUp(source, "postgres", driver)
+}
+
+// migrateUp runs the pending migrations from source against driver and closes both
+func migrateUp(source, databaseName string, driver migratedb.Driver) (uint, error) {
+	m, err := migrate.NewWithDatabaseInstance(source, databaseName, driver)
+	if err != nil {
+		driver.Close()
+		return 0, fmt.Errorf("failed to load migrations from %s: %w", source, err)
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return 0, fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	version, _, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	return version, nil
+}
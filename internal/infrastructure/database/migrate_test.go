@@ -0,0 +1,75 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/database/stub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const migrationsDir = "../../../migrations"
+
+func TestMigrateUp(t *testing.T) {
+	ups, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, ups)
+
+	t.Run("applies every migration from an empty schema", func(t *testing.T) {
+		driver, err := stub.WithInstance(nil, &stub.Config{})
+		require.NoError(t, err)
+
+		version, err := migrateUp("file://"+migrationsDir, "stub", driver)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(len(ups)), version)
+		assert.Len(t, driver.(*stub.Stub).MigrationSequence, len(ups))
+	})
+
+	t.Run("only applies migrations newer than the current version", func(t *testing.T) {
+		driver, err := stub.WithInstance(nil, &stub.Config{})
+		require.NoError(t, err)
+		require.NoError(t, driver.SetVersion(len(ups)-2, false))
+
+		version, err := migrateUp("file://"+migrationsDir, "stub", driver)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(len(ups)), version)
+		assert.Len(t, driver.(*stub.Stub).MigrationSequence, 2)
+	})
+
+	t.Run("up to date schema is left alone", func(t *testing.T) {
+		driver, err := stub.WithInstance(nil, &stub.Config{})
+		require.NoError(t, err)
+		require.NoError(t, driver.SetVersion(len(ups), false))
+
+		version, err := migrateUp("file://"+migrationsDir, "stub", driver)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(len(ups)), version)
+		assert.Empty(t, driver.(*stub.Stub).MigrationSequence)
+	})
+
+	t.Run("missing source", func(t *testing.T) {
+		driver, err := stub.WithInstance(nil, &stub.Config{})
+		require.NoError(t, err)
+
+		_, err = migrateUp("file://"+filepath.Join(t.TempDir(), "missing"), "stub", driver)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestMigrationsHaveDownFiles(t *testing.T) {
+	ups, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	require.NoError(t, err)
+
+	for _, up := range ups {
+		down := strings.TrimSuffix(up, ".up.sql") + ".down.sql"
+		_, err := os.Stat(down)
+		assert.NoError(t, err, "%s has no down migration", filepath.Base(up))
+	}
+}
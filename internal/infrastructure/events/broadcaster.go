@@ -0,0 +1,114 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultSubscriberBuffer is how many events a subscriber may fall behind before events are dropped
+const DefaultSubscriberBuffer = 16
+
+// subscription is one live listener for a single aggregate
+type subscription struct {
+	events chan item.DomainEvent
+	types  map[string]bool
+}
+
+// Broadcaster is an in-process Publisher that hands events to live subscribers of their aggregate
+// Delivery never blocks the publisher: a subscriber whose buffer is full misses the event
+type Broadcaster struct {
+	mu     sync.Mutex
+	subs   map[string]map[*subscription]struct{}
+	buffer int
+}
+
+// NewBroadcaster creates a Broadcaster whose subscribers buffer DefaultSubscriberBuffer events
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		subs:   make(map[string]map[*subscription]struct{}),
+		buffer: DefaultSubscriberBuffer,
+	}
+}
+
+// Subscribe listens for events raised by aggregateID; with no types every event type is delivered
+// The returned function unsubscribes and closes the channel, and is safe to call more than once
+func (b *Broadcaster) Subscribe(aggregateID string, types ...string) (<-chan item.DomainEvent, func()) {
+	sub := &subscription{events: make(chan item.DomainEvent, b.buffer)}
+	if len(types) > 0 {
+		sub.types = make(map[string]bool, len(types))
+		for _, eventType := range types {
+			sub.types[eventType] = true
+		}
+	}
+
+	b.mu.Lock()
+	if b.subs[aggregateID] == nil {
+		b.subs[aggregateID] = make(map[*subscription]struct{})
+	}
+	b.subs[aggregateID][sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return sub.events, func() {
+		once.Do(func() { b.unsubscribe(aggregateID, sub) })
+	}
+}
+
+func (b *Broadcaster) unsubscribe(aggregateID string, sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subs[aggregateID], sub)
+	if len(b.subs[aggregateID]) == 0 {
+		delete(b.subs, aggregateID)
+	}
+	close(sub.events)
+}
+
+// Subscribers returns how many listeners aggregateID currently has
+func (b *Broadcaster) Subscribers(aggregateID string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs[aggregateID])
+}
+
+// Publish offers every event to the subscribers of its aggregate; it never fails
+func (b *Broadcaster) Publish(ctx context.Context, events []item.DomainEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range events {
+		for sub := range b.subs[event.AggregateID()] {
+			if sub.types != nil && !sub.types[event.EventType()] {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				log.Warn().
+					Str("event_type", event.EventType()).
+					Str("aggregate_id", event.AggregateID()).
+					Msg("Dropped event for slow subscriber")
+			}
+		}
+	}
+	return nil
+}
+
+// Chain is a Publisher that publishes to each publisher in order and stops at the first failure,
+// so later publishers only see events the earlier ones accepted
+type Chain []Publisher
+
+func (c Chain) Publish(ctx context.Context, events []item.DomainEvent) error {
+	for _, publisher := range c {
+		if err := publisher.Publish(ctx, events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func priceChangedEvent(t *testing.T, itm *item.Item) item.DomainEvent {
+	t.Helper()
+
+	newPrice, err := item.NewPrice(79.99, "USD")
+	require.NoError(t, err)
+	return item.NewItemPriceChangedEvent(itm.ID(), itm.Price(), newPrice)
+}
+
+func TestBroadcaster_DeliversToAggregateSubscribers(t *testing.T) {
+	broadcaster := NewBroadcaster()
+	itm := newTestItem(t)
+	other := newTestItem(t)
+
+	events, unsubscribe := broadcaster.Subscribe(itm.ID().String(), "ItemPriceChanged")
+	defer unsubscribe()
+
+	err := broadcaster.Publish(context.Background(), []item.DomainEvent{
+		item.NewItemCreatedEvent(itm),
+		priceChangedEvent(t, other),
+		priceChangedEvent(t, itm),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	event := <-events
+	assert.Equal(t, "ItemPriceChanged", event.EventType())
+	assert.Equal(t, itm.ID().String(), event.AggregateID())
+}
+
+func TestBroadcaster_UnsubscribeClosesChannel(t *testing.T) {
+	broadcaster := NewBroadcaster()
+	id := newTestItem(t).ID().String()
+
+	events, unsubscribe := broadcaster.Subscribe(id)
+	assert.Equal(t, 1, broadcaster.Subscribers(id))
+
+	unsubscribe()
+	unsubscribe()
+
+	assert.Equal(t, 0, broadcaster.Subscribers(id))
+	_, open := <-events
+	assert.False(t, open)
+}
+
+func TestBroadcaster_DropsEventsForFullSubscribers(t *testing.T) {
+	broadcaster := NewBroadcaster()
+	itm := newTestItem(t)
+
+	events, unsubscribe := broadcaster.Subscribe(itm.ID().String())
+	defer unsubscribe()
+
+	for i := 0; i < DefaultSubscriberBuffer+1; i++ {
+		require.NoError(t, broadcaster.Publish(context.Background(), []item.DomainEvent{priceChangedEvent(t, itm)}))
+	}
+
+	assert.Len(t, events, DefaultSubscriberBuffer)
+}
+
+func TestChain_StopsAtFirstFailure(t *testing.T) {
+	var reached bool
+	failing := NewDispatcher()
+	failing.Subscribe("ItemCreated", HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+		return errors.New("broker down")
+	}))
+	after := NewDispatcher()
+	after.Subscribe("ItemCreated", HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+		reached = true
+		return nil
+	}))
+
+	err := Chain{failing, after}.Publish(context.Background(), newTestItem(t).PullEvents())
+
+	assert.ErrorContains(t, err, "broker down")
+	assert.False(t, reached)
+}
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/config"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// HeaderEventType carries DomainEvent.EventType on every message
+	HeaderEventType = "EventType"
+	// HeaderAggregateID carries DomainEvent.AggregateID on every message
+	HeaderAggregateID = "AggregateID"
+)
+
+// MessageWriter is the subset of kafka.Writer the publisher relies on
+type MessageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// KafkaPublisher produces domain events to a Kafka topic
+// Messages are keyed by AggregateID so events for one item land on one partition in order
+type KafkaPublisher struct {
+	writer MessageWriter
+}
+
+// NewKafkaPublisher creates a publisher for the configured brokers and topic
+func NewKafkaPublisher(cfg *config.Config) *KafkaPublisher {
+	return NewKafkaPublisherWithWriter(&kafka.Writer{
+		Addr:         kafka.TCP(cfg.Kafka.Brokers...),
+		Topic:        cfg.Kafka.Topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		WriteTimeout: cfg.Kafka.WriteTimeout,
+	})
+}
+
+// NewKafkaPublisherWithWriter creates a publisher on top of an existing writer
+func NewKafkaPublisherWithWriter(writer MessageWriter) *KafkaPublisher {
+	return &KafkaPublisher{writer: writer}
+}
+
+// Publish serializes each event's data to JSON and writes the batch in one call
+func (p *KafkaPublisher) Publish(ctx context.Context, events []item.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	msgs := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		msg, err := toMessage(event)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if err := p.writer.WriteMessages(ctx, msgs...); err != nil {
+		return fmt.Errorf("failed to write events to kafka: %w", err)
+	}
+	return nil
+}
+
+// Close flushes pending writes and releases broker connections
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+func toMessage(event item.DomainEvent) (kafka.Message, error) {
+	payload, err := json.Marshal(event.EventData())
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("failed to serialize %s event: %w", event.EventType(), err)
+	}
+
+	return kafka.Message{
+		Key:   []byte(event.AggregateID()),
+		Value: payload,
+		Time:  event.OccurredAt(),
+		Headers: []kafka.Header{
+			{Key: HeaderEventType, Value: []byte(event.EventType())},
+			{Key: HeaderAggregateID, Value: []byte(event.AggregateID())},
+		},
+	}, nil
+}
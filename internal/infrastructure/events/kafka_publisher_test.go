@@ -0,0 +1,93 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockMessageWriter struct {
+	mock.Mock
+}
+
+func (m *MockMessageWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	args := m.Called(ctx, msgs)
+	return args.Error(0)
+}
+
+func (m *MockMessageWriter) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func headerValue(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestKafkaPublisher_Publish(t *testing.T) {
+	t.Run("serializes payload and keys by aggregate", func(t *testing.T) {
+		writer := &MockMessageWriter{}
+		publisher := NewKafkaPublisherWithWriter(writer)
+
+		itm := newTestItem(t)
+		itm.PullEvents()
+		newPrice, _ := item.NewPrice(149.99, "USD")
+		itm.SetPrice(newPrice)
+
+		var written []kafka.Message
+		writer.On("WriteMessages", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { written = args.Get(1).([]kafka.Message) }).
+			Return(nil)
+
+		err := publisher.Publish(context.Background(), itm.PullEvents())
+
+		require.NoError(t, err)
+		require.Len(t, written, 1)
+
+		msg := written[0]
+		assert.Equal(t, itm.ID().String(), string(msg.Key))
+		assert.Equal(t, "ItemPriceChanged", headerValue(msg, HeaderEventType))
+		assert.Equal(t, itm.ID().String(), headerValue(msg, HeaderAggregateID))
+
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(msg.Value, &payload))
+		assert.Equal(t, itm.ID().String(), payload["itemId"])
+		assert.Equal(t, 99.99, payload["oldPrice"])
+		assert.Equal(t, 149.99, payload["newPrice"])
+		assert.Equal(t, "USD", payload["currency"])
+	})
+
+	t.Run("no events skips the writer", func(t *testing.T) {
+		writer := &MockMessageWriter{}
+		publisher := NewKafkaPublisherWithWriter(writer)
+
+		err := publisher.Publish(context.Background(), nil)
+
+		assert.NoError(t, err)
+		writer.AssertNotCalled(t, "WriteMessages", mock.Anything, mock.Anything)
+	})
+
+	t.Run("writer error", func(t *testing.T) {
+		writer := &MockMessageWriter{}
+		publisher := NewKafkaPublisherWithWriter(writer)
+
+		writer.On("WriteMessages", mock.Anything, mock.Anything).Return(errors.New("broker unavailable"))
+
+		err := publisher.Publish(context.Background(), newTestItem(t).PullEvents())
+
+		assert.ErrorContains(t, err, "broker unavailable")
+	})
+}
@@ -0,0 +1,156 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/rs/zerolog/log"
+)
+
+// OutboxMessage is a stored domain event waiting to be published
+// It satisfies item.DomainEvent so it can be handed to any Publisher as-is
+type OutboxMessage struct {
+	ID        string
+	Type      string
+	Aggregate string
+	Payload   json.RawMessage
+	Occurred  time.Time
+}
+
+func (m OutboxMessage) EventID() string        { return m.ID }
+func (m OutboxMessage) EventType() string      { return m.Type }
+func (m OutboxMessage) AggregateID() string    { return m.Aggregate }
+func (m OutboxMessage) OccurredAt() time.Time  { return m.Occurred }
+func (m OutboxMessage) EventData() interface{} { return m.Payload }
+
+// OutboxStore reads and acknowledges outbox rows
+type OutboxStore interface {
+	// FetchUnsent returns up to limit unsent messages, oldest first
+	FetchUnsent(ctx context.Context, limit int) ([]OutboxMessage, error)
+	// MarkSent records a successful publish; already-sent messages are left untouched
+	MarkSent(ctx context.Context, id string) error
+	// MarkFailed records a failed attempt and keeps the message unsent for retry
+	MarkFailed(ctx context.Context, id string, cause error) error
+}
+
+// OutboxOptions tunes how often and how much the relay publishes
+type OutboxOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// DefaultOutboxOptions returns the relay settings used when none are configured
+func DefaultOutboxOptions() OutboxOptions {
+	return OutboxOptions{
+		PollInterval: time.Second,
+		BatchSize:    100,
+	}
+}
+
+// OutboxRelay polls the outbox and hands unsent messages to a Publisher
+// Delivery is at-least-once: a crash between publish and MarkSent republishes the message
+type OutboxRelay struct {
+	store     OutboxStore
+	publisher Publisher
+	opts      OutboxOptions
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewOutboxRelay creates a relay; non-positive options fall back to the defaults
+func NewOutboxRelay(store OutboxStore, publisher Publisher, opts OutboxOptions) *OutboxRelay {
+	defaults := DefaultOutboxOptions()
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaults.PollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+
+	return &OutboxRelay{
+		store:     store,
+		publisher: publisher,
+		opts:      opts,
+	}
+}
+
+// Start begins polling in the background until Stop is called
+func (r *OutboxRelay) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go r.run(ctx, r.done)
+}
+
+// Stop halts polling and waits for an in-flight batch to finish
+func (r *OutboxRelay) Stop() {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (r *OutboxRelay) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.RelayOnce(ctx); err != nil && ctx.Err() == nil {
+				log.Warn().Err(err).Msg("Outbox relay failed; will retry")
+			}
+		}
+	}
+}
+
+// RelayOnce publishes one batch of unsent messages in order and returns how many were sent
+// It stops at the first failure so later events for an item never overtake earlier ones
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	messages, err := r.store.FetchUnsent(ctx, r.opts.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch outbox messages: %w", err)
+	}
+
+	sent := 0
+	for _, msg := range messages {
+		if err := r.publisher.Publish(ctx, []item.DomainEvent{msg}); err != nil {
+			if markErr := r.store.MarkFailed(ctx, msg.ID, err); markErr != nil {
+				log.Error().Err(markErr).Str("message_id", msg.ID).Msg("Failed to record outbox failure")
+			}
+			return sent, fmt.Errorf("failed to publish outbox message %s: %w", msg.ID, err)
+		}
+
+		if err := r.store.MarkSent(ctx, msg.ID); err != nil {
+			return sent, fmt.Errorf("failed to mark outbox message %s sent: %w", msg.ID, err)
+		}
+		sent++
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutbox is an in-memory OutboxStore that counts acknowledgements
+type memoryOutbox struct {
+	mu       sync.Mutex
+	messages []OutboxMessage
+	sent     map[string]int
+	failures map[string]int
+}
+
+func newMemoryOutbox(messages ...OutboxMessage) *memoryOutbox {
+	return &memoryOutbox{
+		messages: messages,
+		sent:     make(map[string]int),
+		failures: make(map[string]int),
+	}
+}
+
+func (o *memoryOutbox) FetchUnsent(ctx context.Context, limit int) ([]OutboxMessage, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var unsent []OutboxMessage
+	for _, msg := range o.messages {
+		if o.sent[msg.ID] == 0 && len(unsent) < limit {
+			unsent = append(unsent, msg)
+		}
+	}
+	return unsent, nil
+}
+
+func (o *memoryOutbox) MarkSent(ctx context.Context, id string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.sent[id]++
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(ctx context.Context, id string, cause error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.failures[id]++
+	return nil
+}
+
+func (o *memoryOutbox) sentCount(id string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.sent[id]
+}
+
+// recordingPublisher remembers published event IDs and fails while err is set
+type recordingPublisher struct {
+	mu        sync.Mutex
+	err       error
+	published []string
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events []item.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+	for _, event := range events {
+		p.published = append(p.published, event.EventID())
+	}
+	return nil
+}
+
+func (p *recordingPublisher) publishedIDs() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]string(nil), p.published...)
+}
+
+func newOutboxMessage(id string) OutboxMessage {
+	return OutboxMessage{
+		ID:        id,
+		Type:      "ItemCreated",
+		Aggregate: "550e8400-e29b-41d4-a716-446655440000",
+		Payload:   json.RawMessage(`{"sku":"TEST-001"}`),
+		Occurred:  time.Now(),
+	}
+}
+
+func TestOutboxRelay_RelayOnce(t *testing.T) {
+	t.Run("failed publish leaves message unsent", func(t *testing.T) {
+		store := newMemoryOutbox(newOutboxMessage("msg-1"))
+		publisher := &recordingPublisher{err: errors.New("broker unavailable")}
+		relay := NewOutboxRelay(store, publisher, OutboxOptions{})
+
+		sent, err := relay.RelayOnce(context.Background())
+
+		assert.ErrorContains(t, err, "broker unavailable")
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, 0, store.sentCount("msg-1"))
+		assert.Equal(t, 1, store.failures["msg-1"])
+
+		// The broker recovers and the next poll delivers the message
+		publisher.err = nil
+		sent, err = relay.RelayOnce(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, []string{"msg-1"}, publisher.publishedIDs())
+	})
+
+	t.Run("successful publish marks sent exactly once", func(t *testing.T) {
+		store := newMemoryOutbox(newOutboxMessage("msg-1"), newOutboxMessage("msg-2"))
+		publisher := &recordingPublisher{}
+		relay := NewOutboxRelay(store, publisher, OutboxOptions{})
+
+		sent, err := relay.RelayOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+
+		sent, err = relay.RelayOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 0, sent)
+
+		assert.Equal(t, 1, store.sentCount("msg-1"))
+		assert.Equal(t, 1, store.sentCount("msg-2"))
+		assert.Equal(t, []string{"msg-1", "msg-2"}, publisher.publishedIDs())
+	})
+
+	t.Run("batch size limits each poll", func(t *testing.T) {
+		store := newMemoryOutbox(newOutboxMessage("msg-1"), newOutboxMessage("msg-2"), newOutboxMessage("msg-3"))
+		relay := NewOutboxRelay(store, &recordingPublisher{}, OutboxOptions{BatchSize: 2})
+
+		sent, err := relay.RelayOnce(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, 0, store.sentCount("msg-3"))
+	})
+}
+
+func TestOutboxRelay_StartStop(t *testing.T) {
+	store := newMemoryOutbox(newOutboxMessage("msg-1"))
+	publisher := &recordingPublisher{}
+	relay := NewOutboxRelay(store, publisher, OutboxOptions{PollInterval: 5 * time.Millisecond})
+
+	relay.Start()
+	defer relay.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for store.sentCount("msg-1") == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	relay.Stop()
+	assert.Equal(t, 1, store.sentCount("msg-1"))
+	assert.Equal(t, []string{"msg-1"}, publisher.publishedIDs())
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"item-pdp-service/internal/domain/item"
+)
+
+// Publisher delivers domain events raised by aggregates
+type Publisher interface {
+	Publish(ctx context.Context, events []item.DomainEvent) error
+}
+
+// Handler reacts to a single domain event
+type Handler interface {
+	Handle(ctx context.Context, event item.DomainEvent) error
+}
+
+// HandlerFunc adapts a plain function to the Handler interface
+type HandlerFunc func(ctx context.Context, event item.DomainEvent) error
+
+func (f HandlerFunc) Handle(ctx context.Context, event item.DomainEvent) error {
+	return f(ctx, event)
+}
+
+// Dispatcher is an in-process Publisher that routes events synchronously
+// to the handlers registered for their EventType
+type Dispatcher struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe registers handler for events of the given type
+func (d *Dispatcher) Subscribe(eventType string, handler Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.handlers[eventType] = append(d.handlers[eventType], handler)
+}
+
+// Publish hands every event to its handlers in order
+// A failing handler does not stop the others; all failures are returned together
+func (d *Dispatcher) Publish(ctx context.Context, events []item.DomainEvent) error {
+	var errs []error
+	for _, event := range events {
+		d.mu.RLock()
+		handlers := d.handlers[event.EventType()]
+		d.mu.RUnlock()
+
+		for _, handler := range handlers {
+			if err := handler.Handle(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("%s handler failed: %w", event.EventType(), err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,71 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestItem(t *testing.T) *item.Item {
+	t.Helper()
+
+	sku, _ := item.NewSKU("TEST-001")
+	price, _ := item.NewPrice(99.99, "USD")
+	category, _ := item.NewCategory("Electronics")
+	itm, err := item.NewItem(sku, "Test Item", "", price, category)
+	require.NoError(t, err)
+	return itm
+}
+
+func TestDispatcher_RoutesByEventType(t *testing.T) {
+	dispatcher := NewDispatcher()
+
+	var created, statusChanged []item.DomainEvent
+	dispatcher.Subscribe("ItemCreated", HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+		created = append(created, event)
+		return nil
+	}))
+	dispatcher.Subscribe("ItemStatusChanged", HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+		statusChanged = append(statusChanged, event)
+		return nil
+	}))
+
+	itm := newTestItem(t)
+	require.NoError(t, itm.TransitionTo(item.StatusActive))
+
+	err := dispatcher.Publish(context.Background(), itm.PullEvents())
+
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, itm.ID().String(), created[0].AggregateID())
+	assert.Len(t, statusChanged, 1)
+}
+
+func TestDispatcher_ContinuesAfterHandlerError(t *testing.T) {
+	dispatcher := NewDispatcher()
+
+	calls := 0
+	dispatcher.Subscribe("ItemCreated", HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+		calls++
+		return errors.New("boom")
+	}))
+	dispatcher.Subscribe("ItemCreated", HandlerFunc(func(ctx context.Context, event item.DomainEvent) error {
+		calls++
+		return nil
+	}))
+
+	err := dispatcher.Publish(context.Background(), newTestItem(t).PullEvents())
+
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, 2, calls)
+}
+
+func TestDispatcher_NoHandlers(t *testing.T) {
+	err := NewDispatcher().Publish(context.Background(), newTestItem(t).PullEvents())
+	assert.NoError(t, err)
+}
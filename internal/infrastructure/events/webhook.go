@@ -0,0 +1,342 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Headers sent with every webhook delivery
+const (
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookDelivery  = "X-Webhook-Delivery"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	// HeaderWebhookSignature carries the hex HMAC-SHA256 of "<timestamp>.<body>" keyed by the webhook secret
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// Webhook is a callback URL registered for one or more event types
+type Webhook struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+}
+
+// WebhookPayload is the JSON body POSTed for an event
+type WebhookPayload struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	ItemID     string          `json:"item_id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// WebhookDelivery is one event waiting to be POSTed to one webhook
+type WebhookDelivery struct {
+	ID        string
+	WebhookID string
+	URL       string
+	Secret    string
+	EventType string
+	Payload   json.RawMessage
+	// Attempts counts the POSTs already made
+	Attempts int
+}
+
+// WebhookAttempt is the outcome of one POST for a delivery
+type WebhookAttempt struct {
+	DeliveryID string
+	// Attempt numbers the POSTs for a delivery from 1
+	Attempt int
+	// StatusCode is zero when no response was received
+	StatusCode int
+	Err        error
+	Delivered  bool
+	// NextAttemptAt schedules a retry; zero when the delivery has succeeded or been given up
+	NextAttemptAt time.Time
+}
+
+// WebhookStore persists webhooks, the deliveries queued for them and every delivery attempt
+type WebhookStore interface {
+	// Create registers a webhook
+	Create(ctx context.Context, webhook *Webhook) error
+	// Enqueue queues payload for every webhook subscribed to eventType and returns how many were queued
+	// Queuing the same event again is a no-op
+	Enqueue(ctx context.Context, eventID, eventType string, payload []byte) (int, error)
+	// ClaimDue returns up to limit pending deliveries that are due and hides them from other
+	// workers for lease, so a worker that dies mid-delivery only delays them
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error)
+	// RecordAttempt stores an attempt and moves its delivery on: delivered, retried or failed
+	RecordAttempt(ctx context.Context, attempt WebhookAttempt) error
+}
+
+// WebhookPublisher is a Publisher that queues each event for the webhooks subscribed to it
+// Deliveries are made by a WebhookWorker, so a slow endpoint never holds up publishing
+type WebhookPublisher struct {
+	store WebhookStore
+}
+
+// NewWebhookPublisher creates a publisher queuing deliveries in store
+func NewWebhookPublisher(store WebhookStore) *WebhookPublisher {
+	return &WebhookPublisher{store: store}
+}
+
+// Publish queues every event; the first failure is returned so the outbox retries it
+func (p *WebhookPublisher) Publish(ctx context.Context, events []item.DomainEvent) error {
+	for _, event := range events {
+		data, err := json.Marshal(event.EventData())
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+		}
+
+		payload, err := json.Marshal(WebhookPayload{
+			ID:         event.EventID(),
+			Type:       event.EventType(),
+			ItemID:     event.AggregateID(),
+			OccurredAt: event.OccurredAt(),
+			Data:       data,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s webhook payload: %w", event.EventType(), err)
+		}
+
+		if _, err := p.store.Enqueue(ctx, event.EventID(), event.EventType(), payload); err != nil {
+			return fmt.Errorf("failed to queue %s webhooks: %w", event.EventType(), err)
+		}
+	}
+	return nil
+}
+
+// SignWebhook returns the signature sent for body at timestamp
+// Receivers recompute it with their secret and compare in constant time
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookOptions tunes how often deliveries are made and how failures are retried
+type WebhookOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxAttempts is how many POSTs a delivery gets before it is marked failed
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles per attempt up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Timeout bounds each POST
+	Timeout time.Duration
+}
+
+// DefaultWebhookOptions returns the worker settings used when none are configured
+func DefaultWebhookOptions() WebhookOptions {
+	return WebhookOptions{
+		PollInterval:   time.Second,
+		BatchSize:      50,
+		MaxAttempts:    8,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     time.Hour,
+		Timeout:        10 * time.Second,
+	}
+}
+
+// WebhookWorker polls for due deliveries and POSTs them to their webhooks
+// Delivery is at-least-once: receivers should use the X-Webhook-Delivery header to drop repeats
+type WebhookWorker struct {
+	store  WebhookStore
+	client *http.Client
+	opts   WebhookOptions
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewWebhookWorker creates a worker; non-positive options fall back to the defaults
+func NewWebhookWorker(store WebhookStore, opts WebhookOptions) *WebhookWorker {
+	defaults := DefaultWebhookOptions()
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaults.PollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaults.MaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaults.InitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaults.MaxBackoff
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = opts.InitialBackoff
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaults.Timeout
+	}
+
+	return &WebhookWorker{
+		store:  store,
+		client: &http.Client{Timeout: opts.Timeout},
+		opts:   opts,
+	}
+}
+
+// Start begins polling in the background until Stop is called
+func (w *WebhookWorker) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go w.run(ctx, w.done)
+}
+
+// Stop halts polling and waits for an in-flight batch to finish
+func (w *WebhookWorker) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel, w.done = nil, nil
+	w.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (w *WebhookWorker) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(w.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.DeliverOnce(ctx); err != nil && ctx.Err() == nil {
+				log.Warn().Err(err).Msg("Webhook delivery failed; will retry")
+			}
+		}
+	}
+}
+
+// DeliverOnce POSTs one batch of due deliveries and returns how many succeeded
+// A failed POST is scheduled for retry and does not stop the rest of the batch
+func (w *WebhookWorker) DeliverOnce(ctx context.Context) (int, error) {
+	// The lease outlasts every POST in the batch, so no other worker picks them up meanwhile
+	lease := time.Duration(w.opts.BatchSize+1) * w.opts.Timeout
+	deliveries, err := w.store.ClaimDue(ctx, w.opts.BatchSize, lease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		attempt := w.deliver(ctx, delivery)
+		if attempt.Delivered {
+			delivered++
+		} else {
+			event := log.Warn().
+				Err(attempt.Err).
+				Str("delivery_id", delivery.ID).
+				Str("webhook_id", delivery.WebhookID).
+				Int("attempt", attempt.Attempt)
+			if attempt.NextAttemptAt.IsZero() {
+				event.Msg("Webhook delivery failed; giving up")
+			} else {
+				event.Time("next_attempt_at", attempt.NextAttemptAt).Msg("Webhook delivery failed; will retry")
+			}
+		}
+
+		if err := w.store.RecordAttempt(ctx, attempt); err != nil {
+			return delivered, fmt.Errorf("failed to record webhook delivery %s: %w", delivery.ID, err)
+		}
+	}
+
+	return delivered, nil
+}
+
+// deliver POSTs delivery once and works out what happens next
+func (w *WebhookWorker) deliver(ctx context.Context, delivery WebhookDelivery) WebhookAttempt {
+	attempt := WebhookAttempt{DeliveryID: delivery.ID, Attempt: delivery.Attempts + 1}
+
+	attempt.StatusCode, attempt.Err = w.post(ctx, delivery)
+	if attempt.Err == nil {
+		attempt.Delivered = true
+		return attempt
+	}
+
+	if attempt.Attempt < w.opts.MaxAttempts {
+		attempt.NextAttemptAt = time.Now().Add(w.backoff(attempt.Attempt))
+	}
+	return attempt
+}
+
+// post sends the signed payload; any response other than 2xx is an error
+func (w *WebhookWorker) post(ctx context.Context, delivery WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("invalid webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookDelivery, delivery.ID)
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the wait after the given failed attempt: InitialBackoff doubled per earlier attempt, capped at MaxBackoff
+func (w *WebhookWorker) backoff(attempt int) time.Duration {
+	wait := w.opts.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if wait >= w.opts.MaxBackoff {
+			return w.opts.MaxBackoff
+		}
+	}
+	return wait
+}
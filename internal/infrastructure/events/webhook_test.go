@@ -0,0 +1,273 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryWebhookStore is an in-memory WebhookStore that keeps every attempt
+type memoryWebhookStore struct {
+	mu         sync.Mutex
+	webhooks   []Webhook
+	deliveries []*memoryDelivery
+	attempts   []WebhookAttempt
+}
+
+type memoryDelivery struct {
+	WebhookDelivery
+	eventID string
+	status  string
+	due     time.Time
+}
+
+func (s *memoryWebhookStore) Create(ctx context.Context, webhook *Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.webhooks = append(s.webhooks, *webhook)
+	return nil
+}
+
+func (s *memoryWebhookStore) Enqueue(ctx context.Context, eventID, eventType string, payload []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queued := 0
+	for _, webhook := range s.webhooks {
+		subscribed := false
+		for _, t := range webhook.EventTypes {
+			subscribed = subscribed || t == eventType
+		}
+		if !subscribed || s.queued(webhook.ID, eventID) {
+			continue
+		}
+		s.deliveries = append(s.deliveries, &memoryDelivery{
+			WebhookDelivery: WebhookDelivery{
+				ID:        webhook.ID + "/" + eventID,
+				WebhookID: webhook.ID,
+				URL:       webhook.URL,
+				Secret:    webhook.Secret,
+				EventType: eventType,
+				Payload:   payload,
+			},
+			eventID: eventID,
+			status:  "pending",
+		})
+		queued++
+	}
+	return queued, nil
+}
+
+func (s *memoryWebhookStore) queued(webhookID, eventID string) bool {
+	for _, delivery := range s.deliveries {
+		if delivery.WebhookID == webhookID && delivery.eventID == eventID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *memoryWebhookStore) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if delivery.status == "pending" && !delivery.due.After(time.Now()) && len(due) < limit {
+			delivery.due = time.Now().Add(lease)
+			due = append(due, delivery.WebhookDelivery)
+		}
+	}
+	return due, nil
+}
+
+func (s *memoryWebhookStore) RecordAttempt(ctx context.Context, attempt WebhookAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts = append(s.attempts, attempt)
+	for _, delivery := range s.deliveries {
+		if delivery.ID != attempt.DeliveryID {
+			continue
+		}
+		delivery.Attempts = attempt.Attempt
+		switch {
+		case attempt.Delivered:
+			delivery.status = "delivered"
+		case attempt.NextAttemptAt.IsZero():
+			delivery.status = "failed"
+		default:
+			delivery.due = attempt.NextAttemptAt
+		}
+	}
+	return nil
+}
+
+// makeDue moves every pending retry forward so the next poll picks it up
+func (s *memoryWebhookStore) makeDue() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, delivery := range s.deliveries {
+		delivery.due = time.Time{}
+	}
+}
+
+func (s *memoryWebhookStore) status(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, delivery := range s.deliveries {
+		if delivery.ID == id {
+			return delivery.status
+		}
+	}
+	return ""
+}
+
+func TestWebhookWorker_DeliversSignedItemCreated(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store := &memoryWebhookStore{}
+	require.NoError(t, store.Create(context.Background(), &Webhook{
+		ID: "wh-1", URL: server.URL, Secret: "s3cret", EventTypes: []string{"ItemCreated"},
+	}))
+	require.NoError(t, store.Create(context.Background(), &Webhook{
+		ID: "wh-2", URL: server.URL, Secret: "other", EventTypes: []string{"ItemDeleted"},
+	}))
+
+	itm := newTestItem(t)
+	require.NoError(t, NewWebhookPublisher(store).Publish(context.Background(), itm.PullEvents()))
+
+	delivered, err := NewWebhookWorker(store, WebhookOptions{}).DeliverOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+
+	req := <-requests
+	assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+	assert.Equal(t, "ItemCreated", req.header.Get(HeaderWebhookEvent))
+	timestamp := req.header.Get(HeaderWebhookTimestamp)
+	assert.Equal(t, SignWebhook("s3cret", timestamp, req.body), req.header.Get(HeaderWebhookSignature))
+
+	var payload WebhookPayload
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+	assert.Equal(t, "ItemCreated", payload.Type)
+	assert.Equal(t, itm.ID().String(), payload.ItemID)
+	assert.Contains(t, string(payload.Data), `"sku":"TEST-001"`)
+
+	require.Len(t, store.attempts, 1)
+	assert.Equal(t, http.StatusNoContent, store.attempts[0].StatusCode)
+	assert.Equal(t, "delivered", store.status(req.header.Get(HeaderWebhookDelivery)))
+}
+
+func TestWebhookWorker_RetriesFailingEndpoint(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &memoryWebhookStore{}
+	require.NoError(t, store.Create(context.Background(), &Webhook{
+		ID: "wh-1", URL: server.URL, Secret: "s3cret", EventTypes: []string{"ItemCreated"},
+	}))
+	require.NoError(t, NewWebhookPublisher(store).Publish(context.Background(), newTestItem(t).PullEvents()))
+
+	worker := NewWebhookWorker(store, WebhookOptions{InitialBackoff: time.Minute, MaxBackoff: 90 * time.Second})
+
+	for i := 0; i < 2; i++ {
+		before := time.Now()
+		delivered, err := worker.DeliverOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 0, delivered)
+
+		// Not due again until the backoff has passed
+		delivered, err = worker.DeliverOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 0, delivered)
+		require.Len(t, store.attempts, i+1)
+
+		attempt := store.attempts[i]
+		assert.Equal(t, i+1, attempt.Attempt)
+		assert.Equal(t, http.StatusInternalServerError, attempt.StatusCode)
+		assert.ErrorContains(t, attempt.Err, "status 500")
+		wantBackoff := []time.Duration{time.Minute, 90 * time.Second}[i]
+		assert.WithinDuration(t, before.Add(wantBackoff), attempt.NextAttemptAt, 5*time.Second)
+
+		store.makeDue()
+	}
+
+	delivered, err := worker.DeliverOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, int32(3), calls.Load())
+	require.Len(t, store.attempts, 3)
+	assert.True(t, store.attempts[2].Delivered)
+}
+
+func TestWebhookWorker_GivesUpAfterMaxAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	store := &memoryWebhookStore{}
+	require.NoError(t, store.Create(context.Background(), &Webhook{
+		ID: "wh-1", URL: server.URL, Secret: "s3cret", EventTypes: []string{"ItemCreated"},
+	}))
+	require.NoError(t, NewWebhookPublisher(store).Publish(context.Background(), newTestItem(t).PullEvents()))
+
+	worker := NewWebhookWorker(store, WebhookOptions{MaxAttempts: 2})
+	for i := 0; i < 3; i++ {
+		_, err := worker.DeliverOnce(context.Background())
+		require.NoError(t, err)
+		store.makeDue()
+	}
+
+	require.Len(t, store.attempts, 2)
+	assert.True(t, store.attempts[1].NextAttemptAt.IsZero())
+	assert.Equal(t, "failed", store.status(store.attempts[1].DeliveryID))
+}
+
+func TestWebhookPublisher_QueuesEachEventOnce(t *testing.T) {
+	store := &memoryWebhookStore{}
+	require.NoError(t, store.Create(context.Background(), &Webhook{
+		ID: "wh-1", URL: "http://example.com", Secret: "s3cret", EventTypes: []string{"ItemCreated", "ItemStatusChanged"},
+	}))
+
+	itm := newTestItem(t)
+	require.NoError(t, itm.TransitionTo(item.StatusActive))
+	events := itm.PullEvents()
+
+	publisher := NewWebhookPublisher(store)
+	require.NoError(t, publisher.Publish(context.Background(), events))
+	require.NoError(t, publisher.Publish(context.Background(), events))
+
+	assert.Len(t, store.deliveries, 2)
+}
@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/cache"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CachingItemRepository decorates an item.Repository with a read-through cache for FindByID
+// Update, UpdateAll, Delete and DeleteAll evict the items they touch; every other call goes straight to the wrapped repository
+// Cache failures are logged and fall back to the wrapped repository
+type CachingItemRepository struct {
+	item.Repository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCachingItemRepository wraps next so FindByID results are cached for ttl
+func NewCachingItemRepository(next item.Repository, c cache.Cache, ttl time.Duration) *CachingItemRepository {
+	return &CachingItemRepository{
+		Repository: next,
+		cache:      c,
+		ttl:        ttl,
+	}
+}
+
+// FindByID returns the cached item when present, otherwise loads and caches it
+func (r *CachingItemRepository) FindByID(ctx context.Context, id item.ItemID) (*item.Item, error) {
+	key := itemCacheKey(id)
+
+	if itm, ok := r.fromCache(ctx, key); ok {
+		return itm, nil
+	}
+
+	itm, err := r.Repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store(ctx, key, itm)
+	return itm, nil
+}
+
+// Update writes through to the wrapped repository and evicts the cached item
+func (r *CachingItemRepository) Update(ctx context.Context, itm *item.Item) error {
+	if err := r.Repository.Update(ctx, itm); err != nil {
+		return err
+	}
+	r.evict(ctx, itm.ID())
+	return nil
+}
+
+// UpdateAll writes through to the wrapped repository and, once the batch is committed, evicts every item in it
+func (r *CachingItemRepository) UpdateAll(ctx context.Context, items []*item.Item) ([]error, error) {
+	itemErrs, err := r.Repository.UpdateAll(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	for _, itm := range items {
+		r.evict(ctx, itm.ID())
+	}
+	return itemErrs, nil
+}
+
+// Delete removes the item from the wrapped repository and evicts it
+func (r *CachingItemRepository) Delete(ctx context.Context, id item.ItemID) error {
+	if err := r.Repository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.evict(ctx, id)
+	return nil
+}
+
+// DeleteAll removes the items from the wrapped repository and evicts each of them
+func (r *CachingItemRepository) DeleteAll(ctx context.Context, ids []item.ItemID) ([]item.ItemID, error) {
+	notFound, err := r.Repository.DeleteAll(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		r.evict(ctx, id)
+	}
+	return notFound, nil
+}
+
+func (r *CachingItemRepository) fromCache(ctx context.Context, key string) (*item.Item, bool) {
+	data, err := r.cache.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Item cache read failed")
+		}
+		return nil, false
+	}
+
+	var row itemRow
+	if err := json.Unmarshal(data, &row); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Discarding undecodable cached item")
+		return nil, false
+	}
+
+	itm, err := rowToItem(&row)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Discarding invalid cached item")
+		return nil, false
+	}
+
+	return itm, true
+}
+
+func (r *CachingItemRepository) store(ctx context.Context, key string, itm *item.Item) {
+	row, err := itemToRow(itm)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Failed to encode item for cache")
+		return
+	}
+
+	data, err := json.Marshal(row)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Failed to encode item for cache")
+		return
+	}
+
+	if err := r.cache.Set(ctx, key, data, r.ttl); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Item cache write failed")
+	}
+}
+
+func (r *CachingItemRepository) evict(ctx context.Context, id item.ItemID) {
+	key := itemCacheKey(id)
+	if err := r.cache.Delete(ctx, key); err != nil {
+		// The entry stays stale until its TTL runs out
+		log.Ctx(ctx).Error().Err(err).Str("key", key).Msg("Failed to evict cached item")
+	}
+}
+
+// itemCacheKey is versioned so a change to the cached row shape never decodes old entries
+func itemCacheKey(id item.ItemID) string {
+	return "item:v1:" + id.String()
+}
@@ -0,0 +1,266 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/cache"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCache is an in-memory cache.Cache that records calls
+type fakeCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	ttls    map[string]time.Duration
+	getErr  error
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{entries: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (c *fakeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	value, ok := c.entries[key]
+	if !ok {
+		return nil, cache.ErrCacheMiss
+	}
+	return value, nil
+}
+
+func (c *fakeCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = value
+	c.ttls[key] = ttl
+	return nil
+}
+
+func (c *fakeCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	return nil
+}
+
+// stubItemRepository serves a fixed set of items and counts reads
+type stubItemRepository struct {
+	item.Repository
+	items   map[item.ItemID]*item.Item
+	reads   int
+	updates int
+}
+
+func (r *stubItemRepository) FindByID(ctx context.Context, id item.ItemID) (*item.Item, error) {
+	r.reads++
+	itm, ok := r.items[id]
+	if !ok {
+		return nil, item.ItemNotFoundError(id)
+	}
+	return itm, nil
+}
+
+func (r *stubItemRepository) Update(ctx context.Context, itm *item.Item) error {
+	r.updates++
+	r.items[itm.ID()] = itm
+	return nil
+}
+
+func (r *stubItemRepository) UpdateAll(ctx context.Context, items []*item.Item) ([]error, error) {
+	for _, itm := range items {
+		r.updates++
+		r.items[itm.ID()] = itm
+	}
+	return make([]error, len(items)), nil
+}
+
+func (r *stubItemRepository) Delete(ctx context.Context, id item.ItemID) error {
+	delete(r.items, id)
+	return nil
+}
+
+func (r *stubItemRepository) DeleteAll(ctx context.Context, ids []item.ItemID) ([]item.ItemID, error) {
+	var notFound []item.ItemID
+	for _, id := range ids {
+		if _, ok := r.items[id]; !ok {
+			notFound = append(notFound, id)
+		}
+		delete(r.items, id)
+	}
+	return notFound, nil
+}
+
+func newCachingFixture(t *testing.T) (*CachingItemRepository, *stubItemRepository, *fakeCache, *item.Item) {
+	t.Helper()
+
+	testItem := createTestItem(t)
+	testItem.PullEvents()
+	attrs := testItem.Attributes()
+	require.NoError(t, attrs.Set("color", "red"))
+	testItem.SetAttributes(attrs)
+
+	stub := &stubItemRepository{items: map[item.ItemID]*item.Item{testItem.ID(): testItem}}
+	fake := newFakeCache()
+	return NewCachingItemRepository(stub, fake, time.Minute), stub, fake, testItem
+}
+
+func TestCachingItemRepository_FindByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("second read is served from cache", func(t *testing.T) {
+		repo, stub, fake, testItem := newCachingFixture(t)
+
+		first, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+		second, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, stub.reads)
+		assert.Equal(t, time.Minute, fake.ttls[itemCacheKey(testItem.ID())])
+		assert.Equal(t, first.ID(), second.ID())
+		assert.Equal(t, testItem.SKU(), second.SKU())
+		assert.Equal(t, testItem.Price(), second.Price())
+		assert.Equal(t, testItem.Status(), second.Status())
+		color, _ := second.Attributes().Get("color")
+		assert.Equal(t, "red", color)
+	})
+
+	t.Run("not found is not cached", func(t *testing.T) {
+		repo, stub, fake, _ := newCachingFixture(t)
+		missing := item.NewItemID()
+
+		_, err := repo.FindByID(ctx, missing)
+		assert.Error(t, err)
+		_, err = repo.FindByID(ctx, missing)
+		assert.Error(t, err)
+
+		assert.Equal(t, 2, stub.reads)
+		assert.NotContains(t, fake.entries, itemCacheKey(missing))
+	})
+
+	t.Run("cache errors fall back to the repository", func(t *testing.T) {
+		repo, stub, fake, testItem := newCachingFixture(t)
+		fake.getErr = errors.New("connection refused")
+
+		result, err := repo.FindByID(ctx, testItem.ID())
+
+		require.NoError(t, err)
+		assert.Equal(t, testItem.ID(), result.ID())
+		assert.Equal(t, 1, stub.reads)
+	})
+}
+
+func TestCachingItemRepository_Invalidation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update evicts the entry", func(t *testing.T) {
+		repo, stub, fake, testItem := newCachingFixture(t)
+
+		_, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+		require.Contains(t, fake.entries, itemCacheKey(testItem.ID()))
+
+		testItem.SetName("Renamed Item")
+		require.NoError(t, repo.Update(ctx, testItem))
+		assert.NotContains(t, fake.entries, itemCacheKey(testItem.ID()))
+
+		result, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed Item", result.Name())
+		assert.Equal(t, 2, stub.reads)
+		assert.Equal(t, 1, stub.updates)
+	})
+
+	t.Run("bulk update evicts every entry", func(t *testing.T) {
+		repo, stub, fake, testItem := newCachingFixture(t)
+
+		other := createTestItem(t)
+		stub.items[other.ID()] = other
+		for _, id := range []item.ItemID{testItem.ID(), other.ID()} {
+			_, err := repo.FindByID(ctx, id)
+			require.NoError(t, err)
+			require.Contains(t, fake.entries, itemCacheKey(id))
+		}
+
+		testItem.SetName("Renamed Item")
+		other.SetName("Other Renamed Item")
+		itemErrs, err := repo.UpdateAll(ctx, []*item.Item{testItem, other})
+		require.NoError(t, err)
+		assert.Equal(t, []error{nil, nil}, itemErrs)
+
+		assert.NotContains(t, fake.entries, itemCacheKey(testItem.ID()))
+		assert.NotContains(t, fake.entries, itemCacheKey(other.ID()))
+		result, err := repo.FindByID(ctx, other.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Other Renamed Item", result.Name())
+		assert.Equal(t, 2, stub.updates)
+	})
+
+	t.Run("delete evicts the entry", func(t *testing.T) {
+		repo, _, fake, testItem := newCachingFixture(t)
+
+		_, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Delete(ctx, testItem.ID()))
+
+		assert.NotContains(t, fake.entries, itemCacheKey(testItem.ID()))
+		_, err = repo.FindByID(ctx, testItem.ID())
+		assert.Error(t, err)
+	})
+
+	t.Run("bulk delete evicts every entry", func(t *testing.T) {
+		repo, _, fake, testItem := newCachingFixture(t)
+
+		_, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+
+		unknown := item.NewItemID()
+		notFound, err := repo.DeleteAll(ctx, []item.ItemID{testItem.ID(), unknown})
+		require.NoError(t, err)
+
+		assert.Equal(t, []item.ItemID{unknown}, notFound)
+		assert.NotContains(t, fake.entries, itemCacheKey(testItem.ID()))
+		_, err = repo.FindByID(ctx, testItem.ID())
+		assert.Error(t, err)
+	})
+}
+
+func TestCachingItemRepository_MemoryBackend(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("expired entry is reloaded from the repository", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		memory := cache.NewMemoryCacheWithClock(10, func() time.Time { return now })
+
+		testItem := createTestItem(t)
+		stub := &stubItemRepository{items: map[item.ItemID]*item.Item{testItem.ID(): testItem}}
+		repo := NewCachingItemRepository(stub, memory, time.Minute)
+
+		_, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+		_, err = repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+		assert.Equal(t, 1, stub.reads)
+
+		// The row changes underneath the cache; expiry must surface the new value
+		testItem.SetName("Changed Elsewhere")
+		now = now.Add(time.Minute)
+
+		result, err := repo.FindByID(ctx, testItem.ID())
+		require.NoError(t, err)
+		assert.Equal(t, 2, stub.reads)
+		assert.Equal(t, "Changed Elsewhere", result.Name())
+	})
+}
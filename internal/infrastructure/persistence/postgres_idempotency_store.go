@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/application/usecase"
+	"item-pdp-service/internal/infrastructure/database"
+)
+
+// postgresIdempotencyStore implements usecase.IdempotencyStore using PostgreSQL
+type postgresIdempotencyStore struct {
+	db *database.DB
+}
+
+// NewPostgresIdempotencyStore creates a new PostgreSQL idempotency key store
+func NewPostgresIdempotencyStore(db *database.DB) usecase.IdempotencyStore {
+	return &postgresIdempotencyStore{db: db}
+}
+
+// Reserve inserts the key, taking over a row whose window has expired
+// When the key is still live the stored record is returned instead
+func (s *postgresIdempotencyStore) Reserve(ctx context.Context, key, requestHash string, ttl time.Duration) (*usecase.IdempotencyRecord, error) {
+	ctx, cancel := s.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO idempotency_keys (key, request_hash, expires_at)
+		VALUES ($1, $2, NOW() + $3 * INTERVAL '1 second')
+		ON CONFLICT (key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			item_id = NULL,
+			response = NULL,
+			created_at = NOW(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+		RETURNING key`
+
+	var reserved string
+	err := s.db.QueryRowContext(ctx, query, key, requestHash, ttl.Seconds()).Scan(&reserved)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", queryError(ctx, err))
+	}
+
+	var (
+		record   usecase.IdempotencyRecord
+		itemID   sql.NullString
+		response []byte
+	)
+	err = s.db.QueryRowContext(ctx,
+		`SELECT request_hash, item_id, response FROM idempotency_keys WHERE key = $1`, key,
+	).Scan(&record.RequestHash, &itemID, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find idempotency key: %w", queryError(ctx, err))
+	}
+	record.ItemID = itemID.String
+	record.Response = response
+
+	return &record, nil
+}
+
+// Complete stores the item and response produced for a reserved key
+func (s *postgresIdempotencyStore) Complete(ctx context.Context, key, itemID string, response []byte) error {
+	ctx, cancel := s.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE idempotency_keys SET item_id = $2, response = $3 WHERE key = $1`
+	if _, err := s.db.ExecContext(ctx, query, key, itemID, response); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", queryError(ctx, err))
+	}
+	return nil
+}
+
+// Release deletes a key whose request did not produce an item
+func (s *postgresIdempotencyStore) Release(ctx context.Context, key string) error {
+	ctx, cancel := s.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `DELETE FROM idempotency_keys WHERE key = $1 AND response IS NULL`
+	if _, err := s.db.ExecContext(ctx, query, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", queryError(ctx, err))
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresIdempotencyStore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPostgresIdempotencyStore(&database.DB{DB: db})
+	ctx := context.Background()
+
+	t.Run("reserve new key", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO idempotency_keys").
+			WithArgs("key-1", "hash", float64(3600)).
+			WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("key-1"))
+
+		record, err := store.Reserve(ctx, "key-1", "hash", time.Hour)
+
+		require.NoError(t, err)
+		assert.Nil(t, record)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reserve live key returns the stored record", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO idempotency_keys").
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT request_hash, item_id, response FROM idempotency_keys").
+			WithArgs("key-1").
+			WillReturnRows(sqlmock.NewRows([]string{"request_hash", "item_id", "response"}).
+				AddRow("hash", "6f1c5f8e-2a6b-4d35-9a7e-1c1f0f2b9d11", []byte(`{"id":"6f1c5f8e-2a6b-4d35-9a7e-1c1f0f2b9d11"}`)))
+
+		record, err := store.Reserve(ctx, "key-1", "hash", time.Hour)
+
+		require.NoError(t, err)
+		require.NotNil(t, record)
+		assert.Equal(t, "hash", record.RequestHash)
+		assert.Equal(t, "6f1c5f8e-2a6b-4d35-9a7e-1c1f0f2b9d11", record.ItemID)
+		assert.JSONEq(t, `{"id":"6f1c5f8e-2a6b-4d35-9a7e-1c1f0f2b9d11"}`, string(record.Response))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reserve key still in progress", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO idempotency_keys").
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT request_hash, item_id, response FROM idempotency_keys").
+			WillReturnRows(sqlmock.NewRows([]string{"request_hash", "item_id", "response"}).
+				AddRow("hash", nil, nil))
+
+		record, err := store.Reserve(ctx, "key-1", "hash", time.Hour)
+
+		require.NoError(t, err)
+		require.NotNil(t, record)
+		assert.Empty(t, record.ItemID)
+		assert.Empty(t, record.Response)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		mock.ExpectExec("UPDATE idempotency_keys SET item_id").
+			WithArgs("key-1", "item-1", []byte(`{}`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, store.Complete(ctx, "key-1", "item-1", []byte(`{}`)))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("release", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM idempotency_keys WHERE key = \\$1 AND response IS NULL").
+			WithArgs("key-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, store.Release(ctx, "key-1"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO idempotency_keys").
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := store.Reserve(ctx, "key-1", "hash", time.Hour)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -6,13 +6,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	"item-pdp-service/internal/domain/item"
 	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/tracing"
 
+	"github.com/lib/pq"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // postgresItemRepository implements item.Repository using PostgreSQL
@@ -20,302 +24,417 @@ import (
 type postgresItemRepository struct {
 	db *database.DB
 
-	// Business rules configuration in infrastructure - anti-pattern
-	maxPriceThreshold float64
-	minInventoryLevel int
-	defaultCurrency   string
-	autoDiscountRules map[string]float64
+	// lenientReads makes list reads skip rows that cannot be converted instead of failing
+	lenientReads bool
+
+	// Business rules applied in infrastructure - anti-pattern
+	rules item.BusinessRules
+}
+
+// RepositoryOption configures the PostgreSQL item repository
+type RepositoryOption func(*postgresItemRepository)
+
+// WithLenientReads makes list reads log and skip rows that cannot be converted to items,
+// such as legacy rows with a malformed SKU, so one bad record does not fail a whole page
+// Single-item lookups and writes stay strict
+func WithLenientReads() RepositoryOption {
+	return func(r *postgresItemRepository) {
+		r.lenientReads = true
+	}
+}
+
+// WithBusinessRules replaces the default rules items are validated against
+func WithBusinessRules(rules item.BusinessRules) RepositoryOption {
+	return func(r *postgresItemRepository) {
+		r.rules = rules
+	}
 }
 
 // NewPostgresItemRepository creates a new PostgreSQL item repository
-func NewPostgresItemRepository(db *database.DB) item.Repository {
-	return &postgresItemRepository{
-		db: db,
-		// Business rules hardcoded in infrastructure
-		maxPriceThreshold: 10000.0,
-		minInventoryLevel: 5,
-		defaultCurrency:   "USD",
-		autoDiscountRules: map[string]float64{
-			"electronics": 0.95,
-			"books":       0.90,
-			"clothing":    0.85,
-		},
+func NewPostgresItemRepository(db *database.DB, opts ...RepositoryOption) item.Repository {
+	r := &postgresItemRepository{
+		db:    db,
+		rules: item.DefaultBusinessRules(),
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // Save saves an item to the database with business validation in infrastructure
 func (r *postgresItemRepository) Save(ctx context.Context, itm *item.Item) error {
-	// Business validation that should be in domain layer - anti-pattern
-	if err := r.validateItemBusinessRules(itm); err != nil {
-		return fmt.Errorf("business validation failed: %w", err)
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	if err := r.prepareForInsert(itm); err != nil {
+		return err
+	}
+
+	// The item row and its events commit together so no event is lost or invented
+	err := r.db.WithTransaction(ctx, func(tx *sql.Tx) error {
+		return r.insertItem(ctx, tx, itm)
+	})
+	if err != nil {
+		return err
+	}
+	itm.PullEvents()
+
+	log.Ctx(ctx).Debug().
+		Str("item_id", itm.ID().String()).
+		Str("sku", itm.SKU().String()).
+		Msg("Item saved successfully")
+
+	return nil
+}
+
+// SaveAll inserts items in a single transaction, isolating each insert in a savepoint
+// A failed item is rolled back to its savepoint so the rest can still commit,
+// unless allOrNothing is set, in which case any failure rolls back the whole transaction.
+// Each statement gets its own query timeout, so a large batch is not cut off by one deadline
+func (r *postgresItemRepository) SaveAll(ctx context.Context, items []*item.Item, allOrNothing bool) ([]error, error) {
+	itemErrs := make([]error, len(items))
+	failed := false
+
+	err := r.db.WithTransaction(ctx, func(tx *sql.Tx) error {
+		for i, itm := range items {
+			if err := r.prepareForInsert(itm); err != nil {
+				itemErrs[i] = err
+				failed = true
+				continue
+			}
+
+			if err := r.execInTx(ctx, tx, `SAVEPOINT bulk_item`); err != nil {
+				return fmt.Errorf("failed to create savepoint: %w", err)
+			}
+
+			err := r.withQueryTimeout(ctx, func(ctx context.Context) error {
+				return r.insertItem(ctx, tx, itm)
+			})
+			if err != nil {
+				itemErrs[i] = err
+				failed = true
+				if rbErr := r.execInTx(ctx, tx, `ROLLBACK TO SAVEPOINT bulk_item`); rbErr != nil {
+					return fmt.Errorf("failed to roll back to savepoint: %w", rbErr)
+				}
+				continue
+			}
+
+			if err := r.execInTx(ctx, tx, `RELEASE SAVEPOINT bulk_item`); err != nil {
+				return fmt.Errorf("failed to release savepoint: %w", err)
+			}
+		}
+
+		if allOrNothing && failed {
+			return errors.New("bulk insert rolled back: at least one item failed")
+		}
+		return nil
+	})
+	if err != nil {
+		return itemErrs, err
 	}
 
-	// Auto-correct business data in infrastructure - anti-pattern
-	adjustedItem := r.applyBusinessCorrections(itm)
+	for i, itm := range items {
+		if itemErrs[i] == nil {
+			itm.PullEvents()
+		}
+	}
 
-	// Apply automatic discounts based on category - business logic in infrastructure
-	if discount, exists := r.autoDiscountRules[adjustedItem.Category().Name()]; exists {
-		originalPrice := adjustedItem.Price().Amount()
-		newPrice, _ := item.NewPrice(originalPrice*discount, adjustedItem.Price().Currency())
-		adjustedItem.SetPrice(newPrice)
+	return itemErrs, nil
+}
 
-		log.Info().
-			Str("category", adjustedItem.Category().Name()).
-			Float64("original_price", originalPrice).
-			Float64("discounted_price", adjustedItem.Price().Amount()).
-			Msg("Auto-discount applied in repository")
+// prepareForInsert validates an item before it is inserted
+// Items are stored as given; corrections are the use case's to apply and report
+func (r *postgresItemRepository) prepareForInsert(itm *item.Item) error {
+	// Business validation that should be in domain layer - anti-pattern
+	if err := r.validateItemBusinessRules(itm); err != nil {
+		return fmt.Errorf("business validation failed: %w", err)
 	}
 
+	return nil
+}
+
+// insertItem writes the item row and its pending events within tx
+func (r *postgresItemRepository) insertItem(ctx context.Context, tx *sql.Tx, itm *item.Item) error {
 	query := `
 		INSERT INTO items (
 			id, sku, name, description, price_amount, price_currency,
-			category_name, category_slug, inventory_quantity, images,
-			attributes, status, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+			category_name, category_slug, inventory_quantity, reserved_quantity,
+			images, attributes, measurements, barcode, brand_name, brand_slug,
+			status, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`
 
-	imagesJSON, err := json.Marshal(r.imagesToJSON(adjustedItem.Images()))
+	imagesJSON, err := json.Marshal(imagesToJSON(itm.Images()))
 	if err != nil {
 		return fmt.Errorf("failed to marshal images: %w", err)
 	}
 
-	attributesJSON, err := json.Marshal(adjustedItem.Attributes().All())
+	attributesJSON, err := json.Marshal(attributesToJSON(itm.Attributes()))
 	if err != nil {
 		return fmt.Errorf("failed to marshal attributes: %w", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query,
-		adjustedItem.ID().String(),
-		adjustedItem.SKU().String(),
-		adjustedItem.Name(),
-		adjustedItem.Description(),
-		int64(adjustedItem.Price().Amount()*100), // Store in cents
-		adjustedItem.Price().Currency(),
-		adjustedItem.Category().Name(),
-		adjustedItem.Category().Slug(),
-		adjustedItem.Inventory().Quantity(),
-		imagesJSON,
-		attributesJSON,
-		adjustedItem.Status().String(),
-		adjustedItem.CreatedAt(),
-		adjustedItem.UpdatedAt(),
-	)
-
+	measurementsJSON, err := measurementsToJSON(itm.Dimensions(), itm.Weight())
 	if err != nil {
-		return fmt.Errorf("failed to save item: %w", err)
+		return err
 	}
 
-	log.Debug().
-		Str("item_id", adjustedItem.ID().String()).
-		Str("sku", adjustedItem.SKU().String()).
-		Msg("Item saved successfully")
+	if _, err := tx.ExecContext(ctx, query,
+		itm.ID().String(),
+		itm.SKU().String(),
+		itm.Name(),
+		itm.Description(),
+		itm.Price().Cents(),
+		itm.Price().Currency(),
+		itm.Category().Name(),
+		itm.Category().Slug(),
+		itm.Inventory().Quantity(),
+		itm.Inventory().Reserved(),
+		imagesJSON,
+		attributesJSON,
+		measurementsJSON,
+		barcodeValue(itm.Barcode()),
+		nullableString(itm.Brand().Name()),
+		nullableString(itm.Brand().Slug()),
+		itm.Status().String(),
+		itm.CreatedAt(),
+		itm.UpdatedAt(),
+	); err != nil {
+		if isSKUConflict(err) {
+			return item.DuplicateSKUError(itm.SKU())
+		}
+		return fmt.Errorf("failed to save item: %w", queryError(ctx, err))
+	}
 
-	return nil
+	return insertOutboxEvents(ctx, tx, itm.Events())
 }
 
 // Business validation in infrastructure layer - anti-pattern
 func (r *postgresItemRepository) validateItemBusinessRules(itm *item.Item) error {
-	// Price validation - business rule in infrastructure
-	if itm.Price().Amount() <= 0 {
-		return errors.New("item price must be positive")
+	if err := r.rules.Validate(itm); err != nil {
+		return err
 	}
 
-	if itm.Price().Amount() > r.maxPriceThreshold {
-		return fmt.Errorf("item price %.2f exceeds maximum threshold %.2f",
-			itm.Price().Amount(), r.maxPriceThreshold)
+	// Category business rules in infrastructure
+	if itm.Category().IsRestricted() {
+		return item.RestrictedCategoryError(itm.Category())
 	}
 
-	// Currency validation - business rule in infrastructure
-	allowedCurrencies := []string{"USD", "EUR", "GBP", "JPY"}
-	validCurrency := false
-	for _, currency := range allowedCurrencies {
-		if itm.Price().Currency() == currency {
-			validCurrency = true
-			break
+	return nil
+}
+
+// FindByID finds an item by ID
+func (r *postgresItemRepository) FindByID(ctx context.Context, id item.ItemID) (*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items WHERE id = $1`
+
+	row, err := scanItemRow(r.db.QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, item.ItemNotFoundError(id)
 		}
-	}
-	if !validCurrency {
-		return fmt.Errorf("unsupported currency: %s", itm.Price().Currency())
+		return nil, fmt.Errorf("failed to find item by ID: %w", queryError(ctx, err))
 	}
 
-	// Name validation - business rule in infrastructure
-	if len(itm.Name()) < 3 {
-		return errors.New("item name must be at least 3 characters")
-	}
+	return rowToItem(row)
+}
 
-	if len(itm.Name()) > 200 {
-		return errors.New("item name too long")
-	}
+// FindBySKU finds an item by SKU
+func (r *postgresItemRepository) FindBySKU(ctx context.Context, sku item.SKU) (*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-	// SKU business rules in infrastructure
-	skuStr := itm.SKU().String()
-	if !strings.Contains(skuStr, "-") {
-		return errors.New("SKU must contain at least one hyphen")
-	}
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items WHERE sku = $1`
 
-	// Category business rules in infrastructure
-	if itm.Category().Name() == "restricted" {
-		return errors.New("restricted category not allowed")
+	row, err := scanItemRow(r.db.QueryRowContext(ctx, query, sku.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, item.ItemNotFoundBySKUError(sku)
+		}
+		return nil, fmt.Errorf("failed to find item by SKU: %w", queryError(ctx, err))
 	}
 
-	// Inventory business rules in infrastructure
-	if itm.Inventory().Quantity() < 0 {
-		return errors.New("inventory quantity cannot be negative")
+	return rowToItem(row)
+}
+
+// FindByBarcode finds an item by its GTIN
+func (r *postgresItemRepository) FindByBarcode(ctx context.Context, barcode item.Barcode) (*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items WHERE barcode = $1`
+
+	row, err := scanItemRow(r.db.QueryRowContext(ctx, query, barcode.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, item.ItemNotFoundByBarcodeError(barcode)
+		}
+		return nil, fmt.Errorf("failed to find item by barcode: %w", queryError(ctx, err))
 	}
 
-	return nil
+	return rowToItem(row)
 }
 
-// Business corrections in infrastructure layer - anti-pattern
-func (r *postgresItemRepository) applyBusinessCorrections(itm *item.Item) *item.Item {
-	// Auto-correct inventory if below minimum - business logic in infrastructure
-	if itm.Inventory().Quantity() > 0 && itm.Inventory().Quantity() < r.minInventoryLevel {
-		correctedInventory, _ := item.NewInventory(r.minInventoryLevel)
-		itm.SetInventory(correctedInventory)
+// FindByIDs finds all items with the given IDs in a single query
+// IDs that do not exist are simply absent from the result
+func (r *postgresItemRepository) FindByIDs(ctx context.Context, ids []item.ItemID) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-		log.Warn().
-			Int("original_quantity", itm.Inventory().Quantity()).
-			Int("corrected_quantity", r.minInventoryLevel).
-			Msg("Auto-corrected inventory to minimum level")
+	if len(ids) == 0 {
+		return []*item.Item{}, nil
 	}
 
-	// Auto-correct currency if not set - business logic in infrastructure
-	if itm.Price().Currency() == "" {
-		correctedPrice, _ := item.NewPrice(itm.Price().Amount(), r.defaultCurrency)
-		itm.SetPrice(correctedPrice)
-
-		log.Warn().
-			Str("default_currency", r.defaultCurrency).
-			Msg("Auto-corrected currency to default")
+	idStrings := make([]string, len(ids))
+	for i, id := range ids {
+		idStrings[i] = id.String()
 	}
 
-	// Auto-activate items with high inventory - business logic in infrastructure
-	if itm.Inventory().Quantity() > 100 && itm.Status() == item.StatusDraft {
-		itm.SetStatus(item.StatusActive)
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items WHERE id = ANY($1)`
 
-		log.Info().
-			Int("inventory", itm.Inventory().Quantity()).
-			Msg("Auto-activated item due to high inventory")
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(idStrings))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items by IDs: %w", queryError(ctx, err))
 	}
+	defer rows.Close()
 
-	return itm
+	return r.rowsToItems(ctx, rows)
 }
 
-// FindByID finds an item by ID
-func (r *postgresItemRepository) FindByID(ctx context.Context, id item.ItemID) (*item.Item, error) {
-	query := `
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items WHERE id = $1`
+// Update writes the item as given, after validating it against the business rules
+func (r *postgresItemRepository) Update(ctx context.Context, itm *item.Item) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-	var row itemRow
-	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
-		&row.ID,
-		&row.SKU,
-		&row.Name,
-		&row.Description,
-		&row.PriceAmount,
-		&row.PriceCurrency,
-		&row.CategoryName,
-		&row.CategorySlug,
-		&row.InventoryQuantity,
-		&row.Images,
-		&row.Attributes,
-		&row.Status,
-		&row.CreatedAt,
-		&row.UpdatedAt,
-	)
+	if err := r.prepareForUpdate(itm); err != nil {
+		return err
+	}
 
+	err := r.db.WithTransaction(ctx, func(tx *sql.Tx) error {
+		return r.updateItem(ctx, tx, itm)
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, item.ItemNotFoundError(id)
-		}
-		return nil, fmt.Errorf("failed to find item by ID: %w", err)
+		return err
 	}
+	itm.PullEvents()
 
-	return r.rowToItem(&row)
+	return nil
 }
 
-// FindBySKU finds an item by SKU
-func (r *postgresItemRepository) FindBySKU(ctx context.Context, sku item.SKU) (*item.Item, error) {
-	query := `
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items WHERE sku = $1`
+// UpdateAll updates items in a single transaction, isolating each update in a savepoint
+// A failed item is rolled back to its savepoint so the rest can still commit.
+// Each statement gets its own query timeout, as in SaveAll
+func (r *postgresItemRepository) UpdateAll(ctx context.Context, items []*item.Item) ([]error, error) {
+	itemErrs := make([]error, len(items))
+	written := make([]bool, len(items))
+
+	err := r.db.WithTransaction(ctx, func(tx *sql.Tx) error {
+		for i, itm := range items {
+			if err := r.prepareForUpdate(itm); err != nil {
+				itemErrs[i] = err
+				continue
+			}
 
-	var row itemRow
-	err := r.db.QueryRowContext(ctx, query, sku.String()).Scan(
-		&row.ID,
-		&row.SKU,
-		&row.Name,
-		&row.Description,
-		&row.PriceAmount,
-		&row.PriceCurrency,
-		&row.CategoryName,
-		&row.CategorySlug,
-		&row.InventoryQuantity,
-		&row.Images,
-		&row.Attributes,
-		&row.Status,
-		&row.CreatedAt,
-		&row.UpdatedAt,
-	)
+			if err := r.execInTx(ctx, tx, `SAVEPOINT bulk_update`); err != nil {
+				return fmt.Errorf("failed to create savepoint: %w", err)
+			}
+
+			err := r.withQueryTimeout(ctx, func(ctx context.Context) error {
+				return r.updateItem(ctx, tx, itm)
+			})
+			if err != nil {
+				itemErrs[i] = err
+				if rbErr := r.execInTx(ctx, tx, `ROLLBACK TO SAVEPOINT bulk_update`); rbErr != nil {
+					return fmt.Errorf("failed to roll back to savepoint: %w", rbErr)
+				}
+				continue
+			}
 
+			if err := r.execInTx(ctx, tx, `RELEASE SAVEPOINT bulk_update`); err != nil {
+				return fmt.Errorf("failed to release savepoint: %w", err)
+			}
+			written[i] = true
+		}
+		return nil
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, item.ItemNotFoundBySKUError(sku)
+		return itemErrs, err
+	}
+
+	for i, itm := range items {
+		if written[i] {
+			itm.PullEvents()
 		}
-		return nil, fmt.Errorf("failed to find item by SKU: %w", err)
 	}
 
-	return r.rowToItem(&row)
+	return itemErrs, nil
 }
 
-// Update with business logic in infrastructure layer - anti-pattern
-func (r *postgresItemRepository) Update(ctx context.Context, itm *item.Item) error {
-	// Business validation before update - anti-pattern
-	if err := r.validateUpdateBusinessRules(itm); err != nil {
+// prepareForUpdate validates an item before it is updated
+// Status changes that follow from stock or the season are the use case's, made before the write
+func (r *postgresItemRepository) prepareForUpdate(itm *item.Item) error {
+	if err := r.rules.Validate(itm); err != nil {
 		return fmt.Errorf("update validation failed: %w", err)
 	}
+	return nil
+}
 
-	// Apply business transformations - anti-pattern
-	transformedItem := r.applyUpdateTransformations(itm)
-
+// updateItem writes the item row, its pending events and any price change within tx
+func (r *postgresItemRepository) updateItem(ctx context.Context, tx *sql.Tx, itm *item.Item) error {
 	query := `
 		UPDATE items SET
 			name = $2, description = $3, price_amount = $4, price_currency = $5,
 			category_name = $6, category_slug = $7, inventory_quantity = $8,
-			images = $9, attributes = $10, status = $11, updated_at = $12
+			reserved_quantity = $9, images = $10, attributes = $11, measurements = $12,
+			barcode = $13, brand_name = $14, brand_slug = $15, status = $16, updated_at = $17
 		WHERE id = $1`
 
-	imagesJSON, err := json.Marshal(r.imagesToJSON(transformedItem.Images()))
+	imagesJSON, err := json.Marshal(imagesToJSON(itm.Images()))
 	if err != nil {
 		return fmt.Errorf("failed to marshal images: %w", err)
 	}
 
-	attributesJSON, err := json.Marshal(transformedItem.Attributes().All())
+	attributesJSON, err := json.Marshal(attributesToJSON(itm.Attributes()))
 	if err != nil {
 		return fmt.Errorf("failed to marshal attributes: %w", err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query,
-		transformedItem.ID().String(),
-		transformedItem.Name(),
-		transformedItem.Description(),
-		int64(transformedItem.Price().Amount()*100), // Store in cents
-		transformedItem.Price().Currency(),
-		transformedItem.Category().Name(),
-		transformedItem.Category().Slug(),
-		transformedItem.Inventory().Quantity(),
+	measurementsJSON, err := measurementsToJSON(itm.Dimensions(), itm.Weight())
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, query,
+		itm.ID().String(),
+		itm.Name(),
+		itm.Description(),
+		itm.Price().Cents(),
+		itm.Price().Currency(),
+		itm.Category().Name(),
+		itm.Category().Slug(),
+		itm.Inventory().Quantity(),
+		itm.Inventory().Reserved(),
 		imagesJSON,
 		attributesJSON,
-		transformedItem.Status().String(),
-		transformedItem.UpdatedAt(),
+		measurementsJSON,
+		barcodeValue(itm.Barcode()),
+		nullableString(itm.Brand().Name()),
+		nullableString(itm.Brand().Slug()),
+		itm.Status().String(),
+		itm.UpdatedAt(),
 	)
-
 	if err != nil {
-		return fmt.Errorf("failed to update item: %w", err)
+		return fmt.Errorf("failed to update item: %w", queryError(ctx, err))
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -324,72 +443,25 @@ func (r *postgresItemRepository) Update(ctx context.Context, itm *item.Item) err
 	}
 
 	if rowsAffected == 0 {
-		return item.ItemNotFoundError(transformedItem.ID())
-	}
-
-	return nil
-}
-
-// Business validation for updates in infrastructure - anti-pattern
-func (r *postgresItemRepository) validateUpdateBusinessRules(itm *item.Item) error {
-	// Business rule: Can't update price of active items by more than 50%
-	if itm.Status() == item.StatusActive {
-		// Get current item to compare prices
-		currentItem, err := r.FindByID(context.Background(), itm.ID())
-		if err == nil {
-			priceDiff := itm.Price().Amount() - currentItem.Price().Amount()
-			maxIncrease := currentItem.Price().Amount() * 0.5
-
-			if priceDiff > maxIncrease {
-				return fmt.Errorf("price increase %.2f exceeds maximum allowed %.2f for active items",
-					priceDiff, maxIncrease)
-			}
-		}
-	}
-
-	// Business rule: Can't reduce inventory below reserved amount (hardcoded as 10% of current)
-	if itm.Inventory().Quantity() < itm.Inventory().Quantity()/10 {
-		return errors.New("cannot reduce inventory below reserved amount")
-	}
-
-	return nil
-}
-
-// Business transformations in infrastructure - anti-pattern
-func (r *postgresItemRepository) applyUpdateTransformations(itm *item.Item) *item.Item {
-	// Auto-archive items with zero inventory - business logic in infrastructure
-	if itm.Inventory().Quantity() == 0 && itm.Status() == item.StatusActive {
-		itm.SetStatus(item.StatusArchived)
-
-		log.Info().
-			Str("item_id", itm.ID().String()).
-			Msg("Auto-archived item due to zero inventory")
+		return item.ItemNotFoundError(itm.ID())
 	}
 
-	// Apply category-based status rules - business logic in infrastructure
-	if itm.Category().Name() == "seasonal" && itm.Status() == item.StatusActive {
-		// Check if it's off-season (simplified logic)
-		currentMonth := time.Now().Month()
-		if currentMonth < 6 || currentMonth > 9 { // Not summer
-			itm.SetStatus(item.StatusInactive)
-
-			log.Info().
-				Str("item_id", itm.ID().String()).
-				Str("category", itm.Category().Name()).
-				Msg("Auto-deactivated seasonal item")
-		}
+	if err := insertOutboxEvents(ctx, tx, itm.Events()); err != nil {
+		return err
 	}
-
-	return itm
+	return insertPriceHistory(ctx, tx, itm.Events())
 }
 
 // Delete deletes an item
 func (r *postgresItemRepository) Delete(ctx context.Context, id item.ItemID) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `DELETE FROM items WHERE id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, id.String())
 	if err != nil {
-		return fmt.Errorf("failed to delete item: %w", err)
+		return fmt.Errorf("failed to delete item: %w", queryError(ctx, err))
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -404,145 +476,801 @@ func (r *postgresItemRepository) Delete(ctx context.Context, id item.ItemID) err
 	return nil
 }
 
+// DeleteAll deletes items one by one in a single transaction, so a failure part way
+// through rolls back the items already deleted. Each delete gets its own query timeout
+func (r *postgresItemRepository) DeleteAll(ctx context.Context, ids []item.ItemID) ([]item.ItemID, error) {
+	var notFound []item.ItemID
+
+	err := r.db.WithTransaction(ctx, func(tx *sql.Tx) error {
+		for _, id := range ids {
+			var rowsAffected int64
+			err := r.withQueryTimeout(ctx, func(ctx context.Context) error {
+				result, err := tx.ExecContext(ctx, `DELETE FROM items WHERE id = $1`, id.String())
+				if err != nil {
+					return fmt.Errorf("failed to delete item %s: %w", id, queryError(ctx, err))
+				}
+
+				if rowsAffected, err = result.RowsAffected(); err != nil {
+					return fmt.Errorf("failed to get rows affected: %w", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if rowsAffected == 0 {
+				notFound = append(notFound, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Debug().
+		Int("requested", len(ids)).
+		Int("not_found", len(notFound)).
+		Msg("Items deleted in bulk")
+
+	return notFound, nil
+}
+
 // FindByCategory finds items by category
-func (r *postgresItemRepository) FindByCategory(ctx context.Context, category item.Category, limit, offset int) ([]*item.Item, error) {
+func (r *postgresItemRepository) FindByCategory(ctx context.Context, category item.Category, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items WHERE category_slug = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+		SELECT ` + itemColumns + `
+		FROM items WHERE category_slug = $1 ` + orderBy(sort) + ` LIMIT $2 OFFSET $3`
 
 	rows, err := r.db.QueryContext(ctx, query, category.Slug(), limit, offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find items by category: %w", err)
+		return nil, fmt.Errorf("failed to find items by category: %w", queryError(ctx, err))
 	}
 	defer rows.Close()
 
-	return r.rowsToItems(rows)
+	return r.rowsToItems(ctx, rows)
 }
 
-// FindByStatus finds items by status
-func (r *postgresItemRepository) FindByStatus(ctx context.Context, status item.Status, limit, offset int) ([]*item.Item, error) {
+// FindByBrand finds items by brand slug, newest first
+func (r *postgresItemRepository) FindByBrand(ctx context.Context, brandSlug string, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items WHERE status = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+		SELECT ` + itemColumns + `
+		FROM items WHERE brand_slug = $1 ` + orderBy(item.DefaultSort()) + ` LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.QueryContext(ctx, query, status.String(), limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, brandSlug, limit, offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find items by status: %w", err)
+		return nil, fmt.Errorf("failed to find items by brand: %w", queryError(ctx, err))
 	}
 	defer rows.Close()
 
-	return r.rowsToItems(rows)
+	return r.rowsToItems(ctx, rows)
 }
 
-// Search searches for items
-func (r *postgresItemRepository) Search(ctx context.Context, query string, limit, offset int) ([]*item.Item, error) {
-	// Build dynamic query for better performance
-	searchQuery := fmt.Sprintf(`
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items 
-		WHERE (name ILIKE '%%%s%%' OR description ILIKE '%%%s%%' OR sku ILIKE '%%%s%%')
-		ORDER BY created_at DESC LIMIT %d OFFSET %d`, query, query, query, limit, offset)
+// categoryTree selects $1 and the slug of every category below it
+// UNION rather than UNION ALL stops a cycle in the hierarchy from recursing forever
+const categoryTree = `
+	WITH RECURSIVE category_tree(slug) AS (
+		SELECT $1::VARCHAR
+		UNION
+		SELECT c.slug FROM categories c JOIN category_tree t ON c.parent_slug = t.slug
+	)`
+
+// FindByCategoryTree finds items in a category or any of its descendants
+func (r *postgresItemRepository) FindByCategoryTree(ctx context.Context, category item.Category, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-	rows, err := r.db.QueryContext(ctx, searchQuery)
+	query := categoryTree + `
+		SELECT ` + itemColumns + `
+		FROM items WHERE category_slug IN (SELECT slug FROM category_tree) ` + orderBy(sort) + ` LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, category.Slug(), limit, offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search items: %w", err)
+		return nil, fmt.Errorf("failed to find items by category tree: %w", queryError(ctx, err))
 	}
 	defer rows.Close()
 
-	return r.rowsToItems(rows)
+	return r.rowsToItems(ctx, rows)
 }
 
-// FindAvailableItems finds available items
-func (r *postgresItemRepository) FindAvailableItems(ctx context.Context, limit, offset int) ([]*item.Item, error) {
+// FindByStatus finds items by status
+func (r *postgresItemRepository) FindByStatus(ctx context.Context, status item.Status, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items 
-		WHERE status = 'active' AND inventory_quantity > 0
-		ORDER BY created_at DESC LIMIT $1 OFFSET $2`
+		SELECT ` + itemColumns + `
+		FROM items WHERE status = $1 ` + orderBy(sort) + ` LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, status.String(), limit, offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find available items: %w", err)
+		return nil, fmt.Errorf("failed to find items by status: %w", queryError(ctx, err))
 	}
 	defer rows.Close()
 
-	return r.rowsToItems(rows)
+	return r.rowsToItems(ctx, rows)
 }
 
-// FindItemsWithLowStock finds items with low stock
-func (r *postgresItemRepository) FindItemsWithLowStock(ctx context.Context, threshold int) ([]*item.Item, error) {
-	query := `
-		SELECT id, sku, name, description, price_amount, price_currency,
-			   category_name, category_slug, inventory_quantity, images,
-			   attributes, status, created_at, updated_at
-		FROM items 
-		WHERE inventory_quantity <= $1 AND status = 'active'
-		ORDER BY inventory_quantity ASC`
+// FindByFilter finds items matching every field set in filter
+func (r *postgresItemRepository) FindByFilter(ctx context.Context, filter item.ListFilter, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-	rows, err := r.db.QueryContext(ctx, query, threshold)
+	where, args := listFilter(filter)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+		SELECT `+itemColumns+`
+		FROM items WHERE %s `+orderBy(sort)+` LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find items with low stock: %w", err)
+		return nil, fmt.Errorf("failed to find items by filter: %w", queryError(ctx, err))
 	}
 	defer rows.Close()
 
-	return r.rowsToItems(rows)
+	return r.rowsToItems(ctx, rows)
 }
 
-// CountByCategory counts items by category
-func (r *postgresItemRepository) CountByCategory(ctx context.Context, category item.Category) (int, error) {
-	query := `SELECT COUNT(*) FROM items WHERE category_slug = $1`
+// FindSummariesByFilter reads a page of FindByFilter's items as summaries
+// Only the summary columns are selected, so images, attributes and measurements are never decoded
+func (r *postgresItemRepository) FindSummariesByFilter(ctx context.Context, filter item.ListFilter, sort item.Sort, limit, offset int) ([]item.ItemSummary, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-	var count int
-	err := r.db.QueryRowContext(ctx, query, category.Slug()).Scan(&count)
+	where, args := listFilter(filter)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+		SELECT id, sku, name, price_amount, price_currency, category_name, status,
+			inventory_quantity > 0 AS in_stock
+		FROM items WHERE %s `+orderBy(sort)+` LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("failed to count items by category: %w", err)
+		return nil, fmt.Errorf("failed to find item summaries by filter: %w", queryError(ctx, err))
 	}
+	defer rows.Close()
 
-	return count, nil
-}
+	var summaries []item.ItemSummary
+	for rows.Next() {
+		var (
+			row     itemRow
+			inStock bool
+		)
+		if err := rows.Scan(&row.ID, &row.SKU, &row.Name, &row.PriceAmount, &row.PriceCurrency,
+			&row.CategoryName, &row.Status, &inStock); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
 
-// CountByStatus counts items by status
-func (r *postgresItemRepository) CountByStatus(ctx context.Context, status item.Status) (int, error) {
-	query := `SELECT COUNT(*) FROM items WHERE status = $1`
+		summary, err := rowToSummary(&row, inStock)
+		if err != nil {
+			if !r.lenientReads {
+				return nil, fmt.Errorf("failed to convert row to item summary: %w", err)
+			}
 
-	var count int
-	err := r.db.QueryRowContext(ctx, query, status.String()).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("failed to count items by status: %w", err)
+			log.Ctx(ctx).Warn().
+				Err(err).
+				Str("item_id", row.ID).
+				Msg("Skipping item row that cannot be converted")
+			continue
+		}
+
+		summaries = append(summaries, summary)
 	}
 
-	return count, nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return summaries, nil
 }
 
-// ExistsBySKU checks if an item exists by SKU
-func (r *postgresItemRepository) ExistsBySKU(ctx context.Context, sku item.SKU) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM items WHERE sku = $1)`
+// FindByPriceRange finds items priced between min and max in the given currency
+// A max of +Inf leaves the range open at the top
+func (r *postgresItemRepository) FindByPriceRange(ctx context.Context, min, max float64, currency string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	return r.findByPriceRange(ctx, nil, min, max, currency, sort, limit, offset)
+}
 
-	var exists bool
-	err := r.db.QueryRowContext(ctx, query, sku.String()).Scan(&exists)
+// FindByCategoryAndPriceRange finds items in a category priced between min and max
+func (r *postgresItemRepository) FindByCategoryAndPriceRange(ctx context.Context, category item.Category, min, max float64, currency string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	return r.findByPriceRange(ctx, &category, min, max, currency, sort, limit, offset)
+}
+
+func (r *postgresItemRepository) findByPriceRange(ctx context.Context, category *item.Category, min, max float64, currency string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	where, args := priceRangeFilter(category, min, max, currency)
+	query := fmt.Sprintf(`
+		SELECT `+itemColumns+`
+		FROM items WHERE %s %s LIMIT $%d OFFSET $%d`,
+		where, orderBy(sort), len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
 	if err != nil {
-		return false, fmt.Errorf("failed to check SKU existence: %w", err)
+		return nil, fmt.Errorf("failed to find items by price range: %w", queryError(ctx, err))
 	}
+	defer rows.Close()
 
-	return exists, nil
+	return r.rowsToItems(ctx, rows)
 }
 
-// ExistsByID checks if an item exists by ID
-func (r *postgresItemRepository) ExistsByID(ctx context.Context, id item.ItemID) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM items WHERE id = $1)`
+// FindByAttributes finds items whose attributes contain every given key/value pair
+func (r *postgresItemRepository) FindByAttributes(ctx context.Context, filters map[string]string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
 
-	var exists bool
-	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(&exists)
+	filterJSON, err := json.Marshal(filters)
 	if err != nil {
-		return false, fmt.Errorf("failed to check ID existence: %w", err)
+		return nil, fmt.Errorf("failed to marshal attribute filters: %w", err)
+	}
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items WHERE attributes @> $1 ` + orderBy(sort) + ` LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, string(filterJSON), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items by attributes: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// FindByAttributeRanges finds items whose numeric attributes fall within every range
+func (r *postgresItemRepository) FindByAttributeRanges(ctx context.Context, ranges []item.AttributeRange, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	where, args := attributeRangeFilter(ranges)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+		SELECT `+itemColumns+`
+		FROM items WHERE %s `+orderBy(sort)+` LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items by attribute range: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// Stream walks matching items in creation order without loading them all
+// lib/pq reads rows off the connection as Next is called, so memory stays flat for any table size
+// It is not bound by the query timeout, since an export runs as long as the caller keeps reading
+func (r *postgresItemRepository) Stream(ctx context.Context, filter item.StreamFilter, fn func(*item.Item) error) error {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if filter.Category != nil {
+		args = append(args, filter.Category.Slug())
+		conditions = append(conditions, fmt.Sprintf("category_slug = $%d", len(args)))
+	}
+	if filter.Status != nil {
+		args = append(args, filter.Status.String())
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	query := `SELECT ` + itemColumns + ` FROM items`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to stream items: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row, err := scanItemRow(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		itm, err := rowToItem(row)
+		if err != nil {
+			return fmt.Errorf("failed to convert row to item: %w", err)
+		}
+
+		if err := fn(itm); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+// Search searches for items by name, description or SKU
+func (r *postgresItemRepository) Search(ctx context.Context, query string, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	searchQuery := `
+		SELECT ` + itemColumns + `
+		FROM items 
+		WHERE (name ILIKE $1 OR description ILIKE $1 OR sku ILIKE $1)
+		` + orderBy(sort) + ` LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, searchQuery, containsPattern(query), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// SearchRanked searches the search_vector column, ordering by ts_rank with the newest item first on ties
+func (r *postgresItemRepository) SearchRanked(ctx context.Context, query string, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	searchQuery := `
+		SELECT ` + itemColumns + `
+		FROM items, plainto_tsquery('english', $1) AS search_query
+		WHERE search_vector @@ search_query
+		ORDER BY ts_rank(search_vector, search_query) DESC, created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, searchQuery, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// FindAvailableItems finds available items
+func (r *postgresItemRepository) FindAvailableItems(ctx context.Context, sort item.Sort, limit, offset int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items 
+		WHERE status = 'active' AND inventory_quantity > reserved_quantity
+		` + orderBy(sort) + ` LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find available items: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// FindItemsWithLowStock finds items with low stock
+func (r *postgresItemRepository) FindItemsWithLowStock(ctx context.Context, threshold int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items 
+		WHERE inventory_quantity <= $1 AND status = 'active'
+		ORDER BY inventory_quantity ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items with low stock: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// FindRelated finds other active, in-stock items in the source item's category
+// Items are ranked by how many attribute key/value pairs they share with the source, then newest first
+func (r *postgresItemRepository) FindRelated(ctx context.Context, source *item.Item, limit int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	attributesJSON, err := json.Marshal(attributesToJSON(source.Attributes()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attributes: %w", err)
+	}
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items
+		WHERE category_slug = $1 AND id <> $2
+			AND status = 'active' AND inventory_quantity > reserved_quantity
+		ORDER BY (
+			SELECT COUNT(*) FROM jsonb_each(attributes) AS shared(key, value)
+			WHERE $3::jsonb -> shared.key = shared.value
+		) DESC, created_at DESC
+		LIMIT $4`
+
+	rows, err := r.db.QueryContext(ctx, query, source.Category().Slug(), source.ID().String(), attributesJSON, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find related items: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// FindByNamePrefix finds active items whose name starts with prefix, ignoring case
+// lower(name) LIKE matches the text_pattern_ops index from migration 016, so unlike Search
+// it never scans the whole table
+func (r *postgresItemRepository) FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*item.Item, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + itemColumns + `
+		FROM items
+		WHERE lower(name) LIKE $1 AND status = 'active'
+		ORDER BY length(name), name
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, prefixPattern(strings.ToLower(prefix)), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items by name prefix: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	return r.rowsToItems(ctx, rows)
+}
+
+// FindMostViewed ranks active items by the number of views recorded at or after since
+// Ties go to the newer item
+func (r *postgresItemRepository) FindMostViewed(ctx context.Context, since time.Time, limit int) ([]item.ItemViewCount, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + itemColumns + `, views.view_count
+		FROM items
+		JOIN (
+			SELECT item_id, COUNT(*) AS view_count
+			FROM item_views WHERE viewed_at >= $1 GROUP BY item_id
+		) views ON views.item_id = items.id
+		WHERE items.status = 'active'
+		ORDER BY views.view_count DESC, items.created_at DESC
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find most viewed items: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	var results []item.ItemViewCount
+	for rows.Next() {
+		var viewed item.ItemViewCount
+		row, err := scanItemRow(rows, &viewed.ViewCount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if viewed.Item, err = rowToItem(row); err != nil {
+			return nil, fmt.Errorf("failed to convert row to item: %w", err)
+		}
+		results = append(results, viewed)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", queryError(ctx, err))
+	}
+
+	return results, nil
+}
+
+// RecordView inserts one row into item_views stamped with the current time
+func (r *postgresItemRepository) RecordView(ctx context.Context, id item.ItemID) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `INSERT INTO item_views (item_id, viewed_at) VALUES ($1, $2)`
+
+	if _, err := r.db.ExecContext(ctx, query, id.String(), time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to record item view: %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+// CountByCategory counts items by category
+func (r *postgresItemRepository) CountByCategory(ctx context.Context, category item.Category) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM items WHERE category_slug = $1`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, category.Slug()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by category: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByBrand counts items by brand slug
+func (r *postgresItemRepository) CountByBrand(ctx context.Context, brandSlug string) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM items WHERE brand_slug = $1`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, brandSlug).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by brand: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByCategoryTree counts items in a category or any of its descendants
+func (r *postgresItemRepository) CountByCategoryTree(ctx context.Context, category item.Category) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := categoryTree + `
+		SELECT COUNT(*) FROM items WHERE category_slug IN (SELECT slug FROM category_tree)`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, category.Slug()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by category tree: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByStatus counts items by status
+func (r *postgresItemRepository) CountByStatus(ctx context.Context, status item.Status) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM items WHERE status = $1`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, status.String()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by status: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByFilter counts items matching every field set in filter
+func (r *postgresItemRepository) CountByFilter(ctx context.Context, filter item.ListFilter) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	where, args := listFilter(filter)
+	query := `SELECT COUNT(*) FROM items WHERE ` + where
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by filter: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountTopCategories counts items per category and returns the limit largest categories
+func (r *postgresItemRepository) CountTopCategories(ctx context.Context, limit int) ([]item.CategoryCount, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT MIN(category_name), category_slug, COUNT(*)
+		FROM items
+		GROUP BY category_slug
+		ORDER BY COUNT(*) DESC, category_slug
+		LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count items by category: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	counts := make([]item.CategoryCount, 0)
+	for rows.Next() {
+		var (
+			name, slug string
+			count      int
+		)
+		if err := rows.Scan(&name, &slug, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan category count: %w", queryError(ctx, err))
+		}
+
+		category, err := item.NewCategory(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid category %s in items: %w", slug, err)
+		}
+		counts = append(counts, item.CategoryCount{Category: category, Count: count})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate category counts: %w", queryError(ctx, err))
+	}
+
+	return counts, nil
+}
+
+// CountBySearch counts items matching a search term
+func (r *postgresItemRepository) CountBySearch(ctx context.Context, query string) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	countQuery := `SELECT COUNT(*) FROM items WHERE (name ILIKE $1 OR description ILIKE $1 OR sku ILIKE $1)`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, countQuery, containsPattern(query)).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by search: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountBySearchRanked counts items whose search_vector matches query
+func (r *postgresItemRepository) CountBySearchRanked(ctx context.Context, query string) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	countQuery := `SELECT COUNT(*) FROM items WHERE search_vector @@ plainto_tsquery('english', $1)`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, countQuery, query).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by search: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByPriceRange counts items priced between min and max in the given currency
+func (r *postgresItemRepository) CountByPriceRange(ctx context.Context, min, max float64, currency string) (int, error) {
+	return r.countByPriceRange(ctx, nil, min, max, currency)
+}
+
+// CountByCategoryAndPriceRange counts items in a category priced between min and max
+func (r *postgresItemRepository) CountByCategoryAndPriceRange(ctx context.Context, category item.Category, min, max float64, currency string) (int, error) {
+	return r.countByPriceRange(ctx, &category, min, max, currency)
+}
+
+func (r *postgresItemRepository) countByPriceRange(ctx context.Context, category *item.Category, min, max float64, currency string) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	where, args := priceRangeFilter(category, min, max, currency)
+	query := `SELECT COUNT(*) FROM items WHERE ` + where
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by price range: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByAttributes counts items whose attributes contain every given key/value pair
+func (r *postgresItemRepository) CountByAttributes(ctx context.Context, filters map[string]string) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	filterJSON, err := json.Marshal(filters)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal attribute filters: %w", err)
+	}
+
+	query := `SELECT COUNT(*) FROM items WHERE attributes @> $1`
+
+	var count int
+	err = r.db.QueryRowContext(ctx, query, string(filterJSON)).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by attributes: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountByAttributeRanges counts items whose numeric attributes fall within every range
+func (r *postgresItemRepository) CountByAttributeRanges(ctx context.Context, ranges []item.AttributeRange) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	where, args := attributeRangeFilter(ranges)
+	query := `SELECT COUNT(*) FROM items WHERE ` + where
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count items by attribute range: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// CountAvailable counts active items that are in stock
+func (r *postgresItemRepository) CountAvailable(ctx context.Context) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM items WHERE status = 'active' AND inventory_quantity > reserved_quantity`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count available items: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
+
+// ExistsBySKU checks if an item exists by SKU
+func (r *postgresItemRepository) ExistsBySKU(ctx context.Context, sku item.SKU) (bool, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT EXISTS(SELECT 1 FROM items WHERE sku = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, sku.String()).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check SKU existence: %w", queryError(ctx, err))
+	}
+
+	return exists, nil
+}
+
+// ExistsCategory checks if a category exists by slug
+// Categories first used by an item are not added to the categories table, so items are checked too
+func (r *postgresItemRepository) ExistsCategory(ctx context.Context, category item.Category) (bool, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT EXISTS(SELECT 1 FROM categories WHERE slug = $1) OR EXISTS(SELECT 1 FROM items WHERE category_slug = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, category.Slug()).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check category existence: %w", queryError(ctx, err))
+	}
+
+	return exists, nil
+}
+
+// ExistsByID checks if an item exists by ID
+func (r *postgresItemRepository) ExistsByID(ctx context.Context, id item.ItemID) (bool, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT EXISTS(SELECT 1 FROM items WHERE id = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check ID existence: %w", queryError(ctx, err))
 	}
 
 	return exists, nil
@@ -550,6 +1278,48 @@ func (r *postgresItemRepository) ExistsByID(ctx context.Context, id item.ItemID)
 
 // Helper types and methods
 
+// itemColumns lists the items table columns in the order scanItemRow reads them
+const itemColumns = `id, sku, name, description, price_amount, price_currency,
+		category_name, category_slug, inventory_quantity, reserved_quantity,
+		images, attributes, measurements, barcode, brand_name, brand_slug,
+		status, created_at, updated_at`
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanItemRow reads one row selected with itemColumns
+// extra receives any columns selected after itemColumns
+func scanItemRow(scanner rowScanner, extra ...interface{}) (*itemRow, error) {
+	var row itemRow
+	dest := []interface{}{
+		&row.ID,
+		&row.SKU,
+		&row.Name,
+		&row.Description,
+		&row.PriceAmount,
+		&row.PriceCurrency,
+		&row.CategoryName,
+		&row.CategorySlug,
+		&row.InventoryQuantity,
+		&row.ReservedQuantity,
+		&row.Images,
+		&row.Attributes,
+		&row.Measurements,
+		&row.Barcode,
+		&row.BrandName,
+		&row.BrandSlug,
+		&row.Status,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	}
+	if err := scanner.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
 type itemRow struct {
 	ID                string
 	SKU               string
@@ -560,8 +1330,13 @@ type itemRow struct {
 	CategoryName      string
 	CategorySlug      string
 	InventoryQuantity int
+	ReservedQuantity  int
 	Images            []byte
 	Attributes        []byte
+	Measurements      []byte
+	Barcode           sql.NullString
+	BrandName         sql.NullString
+	BrandSlug         sql.NullString
 	Status            string
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -573,114 +1348,190 @@ type imageJSON struct {
 	IsPrimary bool   `json:"is_primary"`
 }
 
-func (r *postgresItemRepository) rowToItem(row *itemRow) (*item.Item, error) {
+// rowToItem rebuilds a domain item from a row
+// A row breaking a domain rule is bad stored data, not a bad request, so the domain error
+// is formatted rather than wrapped and does not map to a client error
+func rowToItem(row *itemRow) (*item.Item, error) {
 	// Convert database row to domain item
 	id, err := item.NewItemIDFromString(row.ID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid item ID: %w", err)
+		return nil, fmt.Errorf("invalid item ID: %v", err)
 	}
 
-	sku, err := item.NewSKU(row.SKU)
-	if err != nil {
-		return nil, fmt.Errorf("invalid SKU: %w", err)
-	}
+	// Stored SKUs passed whichever SKU policy was configured when they were written
+	sku := item.ReconstituteSKU(row.SKU)
 
-	price, err := item.NewPrice(float64(row.PriceAmount)/100, row.PriceCurrency)
+	price, err := item.NewPriceFromCents(row.PriceAmount, row.PriceCurrency)
 	if err != nil {
-		return nil, fmt.Errorf("invalid price: %w", err)
+		return nil, fmt.Errorf("invalid price: %v", err)
 	}
 
 	category, err := item.NewCategory(row.CategoryName)
 	if err != nil {
-		return nil, fmt.Errorf("invalid category: %w", err)
+		return nil, fmt.Errorf("invalid category: %v", err)
 	}
 
-	inventory, err := item.NewInventory(row.InventoryQuantity)
+	inventory, err := item.NewInventoryWithReserved(row.InventoryQuantity, row.ReservedQuantity)
 	if err != nil {
-		return nil, fmt.Errorf("invalid inventory: %w", err)
+		return nil, fmt.Errorf("invalid inventory: %v", err)
 	}
 
 	status, err := item.StatusFromString(row.Status)
 	if err != nil {
-		return nil, fmt.Errorf("invalid status: %w", err)
+		return nil, fmt.Errorf("invalid status: %v", err)
 	}
 
-	// Parse images
-	var imagesJSON []imageJSON
-	if err := json.Unmarshal(row.Images, &imagesJSON); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal images: %w", err)
+	images, err := imagesFromJSON(row.Images)
+	if err != nil {
+		return nil, err
 	}
 
-	images := make([]item.Image, len(imagesJSON))
-	for i, imgJSON := range imagesJSON {
-		img, err := item.NewImage(imgJSON.URL, imgJSON.Alt, imgJSON.IsPrimary)
-		if err != nil {
-			return nil, fmt.Errorf("invalid image: %w", err)
-		}
-		images[i] = img
+	attributes, err := attributesFromJSON(row.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions, weight, err := measurementsFromJSON(row.Measurements)
+	if err != nil {
+		return nil, err
 	}
 
-	// Parse attributes
-	var attributesMap map[string]string
-	if err := json.Unmarshal(row.Attributes, &attributesMap); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal attributes: %w", err)
+	var barcode item.Barcode
+	if row.Barcode.Valid {
+		if barcode, err = item.NewBarcode(row.Barcode.String); err != nil {
+			return nil, fmt.Errorf("invalid barcode: %v", err)
+		}
 	}
 
-	attributes := item.NewAttributes()
-	for key, value := range attributesMap {
-		if err := attributes.Set(key, value); err != nil {
-			return nil, fmt.Errorf("failed to set attribute: %w", err)
+	var brand item.Brand
+	if row.BrandName.Valid {
+		if brand, err = item.NewBrand(row.BrandName.String); err != nil {
+			return nil, fmt.Errorf("invalid brand: %v", err)
 		}
 	}
 
-	// Create item using domain constructor
-	createdItem, err := item.NewItem(sku, row.Name, row.Description, price, category)
+	// Rebuild the aggregate with its persisted identity and state
+	return item.Reconstitute(
+		id,
+		sku,
+		row.Name,
+		row.Description,
+		price,
+		category,
+		inventory,
+		images,
+		attributes,
+		dimensions,
+		weight,
+		barcode,
+		brand,
+		status,
+		row.CreatedAt,
+		row.UpdatedAt,
+	), nil
+}
+
+// rowToSummary rebuilds an item summary from the columns FindSummariesByFilter selects
+// Domain errors are formatted rather than wrapped for the same reason as in rowToItem
+func rowToSummary(row *itemRow, inStock bool) (item.ItemSummary, error) {
+	id, err := item.NewItemIDFromString(row.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create item: %w", err)
+		return item.ItemSummary{}, fmt.Errorf("invalid item ID: %v", err)
 	}
 
-	// TODO: Set remaining fields using item methods
-	// This is a simplified implementation - normally you'd need proper methods
-	// to reconstruct the complete item state including ID, inventory, images, etc.
+	price, err := item.NewPriceFromCents(row.PriceAmount, row.PriceCurrency)
+	if err != nil {
+		return item.ItemSummary{}, fmt.Errorf("invalid price: %v", err)
+	}
 
-	// Use the variables to avoid compilation errors
-	_ = id
-	_ = inventory
-	_ = status
-	_ = images
-	_ = attributes
+	category, err := item.NewCategory(row.CategoryName)
+	if err != nil {
+		return item.ItemSummary{}, fmt.Errorf("invalid category: %v", err)
+	}
 
-	return createdItem, nil
+	status, err := item.StatusFromString(row.Status)
+	if err != nil {
+		return item.ItemSummary{}, fmt.Errorf("invalid status: %v", err)
+	}
+
+	return item.ItemSummary{
+		ID:       id,
+		SKU:      item.ReconstituteSKU(row.SKU),
+		Name:     row.Name,
+		Price:    price,
+		Category: category,
+		Status:   status,
+		InStock:  inStock,
+	}, nil
+}
+
+// itemToRow flattens an item into the row shape read back by rowToItem
+func itemToRow(itm *item.Item) (*itemRow, error) {
+	images, err := json.Marshal(imagesToJSON(itm.Images()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal images: %w", err)
+	}
+
+	attributes, err := json.Marshal(attributesToJSON(itm.Attributes()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attributes: %w", err)
+	}
+
+	measurements, err := measurementsToJSON(itm.Dimensions(), itm.Weight())
+	if err != nil {
+		return nil, err
+	}
+
+	return &itemRow{
+		ID:                itm.ID().String(),
+		SKU:               itm.SKU().String(),
+		Name:              itm.Name(),
+		Description:       itm.Description(),
+		PriceAmount:       itm.Price().Cents(),
+		PriceCurrency:     itm.Price().Currency(),
+		CategoryName:      itm.Category().Name(),
+		CategorySlug:      itm.Category().Slug(),
+		InventoryQuantity: itm.Inventory().Quantity(),
+		ReservedQuantity:  itm.Inventory().Reserved(),
+		Images:            images,
+		Attributes:        attributes,
+		Measurements:      measurements,
+		Barcode:           sql.NullString{String: itm.Barcode().String(), Valid: !itm.Barcode().IsZero()},
+		BrandName:         sql.NullString{String: itm.Brand().Name(), Valid: !itm.Brand().IsZero()},
+		BrandSlug:         sql.NullString{String: itm.Brand().Slug(), Valid: !itm.Brand().IsZero()},
+		Status:            itm.Status().String(),
+		CreatedAt:         itm.CreatedAt(),
+		UpdatedAt:         itm.UpdatedAt(),
+	}, nil
 }
 
-func (r *postgresItemRepository) rowsToItems(rows *sql.Rows) ([]*item.Item, error) {
-	var items []*item.Item
+// rowsToItems converts every row, failing on the first row that cannot be converted
+// In lenient mode such rows are logged and skipped, and the number skipped is recorded
+// on the current span as db.rows.skipped
+func (r *postgresItemRepository) rowsToItems(ctx context.Context, rows *sql.Rows) ([]*item.Item, error) {
+	var (
+		items   []*item.Item
+		skipped int
+	)
 
 	for rows.Next() {
-		var row itemRow
-		err := rows.Scan(
-			&row.ID,
-			&row.SKU,
-			&row.Name,
-			&row.Description,
-			&row.PriceAmount,
-			&row.PriceCurrency,
-			&row.CategoryName,
-			&row.CategorySlug,
-			&row.InventoryQuantity,
-			&row.Images,
-			&row.Attributes,
-			&row.Status,
-			&row.CreatedAt,
-			&row.UpdatedAt,
-		)
+		row, err := scanItemRow(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
-		itm, err := r.rowToItem(&row)
+		itm, err := rowToItem(row)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert row to item: %w", err)
+			if !r.lenientReads {
+				return nil, fmt.Errorf("failed to convert row to item: %w", err)
+			}
+
+			log.Ctx(ctx).Warn().
+				Err(err).
+				Str("item_id", row.ID).
+				Msg("Skipping item row that cannot be converted")
+			skipped++
+			continue
 		}
 
 		items = append(items, itm)
@@ -690,10 +1541,173 @@ func (r *postgresItemRepository) rowsToItems(rows *sql.Rows) ([]*item.Item, erro
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
+	if skipped > 0 {
+		trace.SpanFromContext(ctx).SetAttributes(tracing.AttrDBRowsSkipped.Int(skipped))
+		log.Ctx(ctx).Warn().
+			Int("skipped", skipped).
+			Int("returned", len(items)).
+			Msg("Skipped unconvertible item rows")
+	}
+
 	return items, nil
 }
 
-func (r *postgresItemRepository) imagesToJSON(images []item.Image) []imageJSON {
+// likeEscaper escapes LIKE wildcards so user input is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// queryError reports a query cut short by its context as the context error
+// Drivers surface cancellation as their own error, which callers could not match with errors.Is
+func queryError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+		return fmt.Errorf("%w: %v", ctxErr, err)
+	}
+	return err
+}
+
+// withQueryTimeout runs fn under a fresh query timeout; bulk writes use it per statement
+// so a long batch of fast statements inside one transaction is not cut off by a shared deadline
+func (r *postgresItemRepository) withQueryTimeout(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+	return fn(ctx)
+}
+
+// execInTx runs a statement without arguments, such as a savepoint command, in tx under its own query timeout
+func (r *postgresItemRepository) execInTx(ctx context.Context, tx *sql.Tx, statement string) error {
+	return r.withQueryTimeout(ctx, func(ctx context.Context) error {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return queryError(ctx, err)
+		}
+		return nil
+	})
+}
+
+// skuUniqueConstraint is the name Postgres gives the UNIQUE constraint on items.sku
+const skuUniqueConstraint = "items_sku_key"
+
+// isSKUConflict reports whether err is a unique violation on the SKU, e.g. from a concurrent insert
+func isSKUConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == skuUniqueConstraint
+}
+
+// containsPattern builds an ILIKE pattern matching the term anywhere in a column
+func containsPattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+// prefixPattern builds a LIKE pattern matching the term at the start of a column
+func prefixPattern(term string) string {
+	return likeEscaper.Replace(term) + "%"
+}
+
+// sortColumns maps sort fields to columns; only these literals ever reach ORDER BY
+var sortColumns = map[item.SortField]string{
+	item.SortByCreatedAt: "created_at",
+	item.SortByUpdatedAt: "updated_at",
+	item.SortByPrice:     "price_amount",
+	item.SortByName:      "name",
+}
+
+// orderBy builds the ORDER BY clause, falling back to newest first for unknown fields
+func orderBy(sort item.Sort) string {
+	column, ok := sortColumns[sort.Field]
+	if !ok {
+		column = sortColumns[item.SortByCreatedAt]
+	}
+
+	direction := "DESC"
+	if sort.Order == item.SortAsc {
+		direction = "ASC"
+	}
+
+	return "ORDER BY " + column + " " + direction
+}
+
+// priceRangeFilter builds the WHERE clause for a price range query
+// Bounds are compared in cents against price_amount; an infinite max adds no upper bound
+func priceRangeFilter(category *item.Category, min, max float64, currency string) (string, []interface{}) {
+	if currency == "" {
+		currency = item.DefaultCurrency
+	}
+
+	conditions := []string{"price_currency = $1", "price_amount >= $2"}
+	args := []interface{}{strings.ToUpper(currency), toCents(min)}
+
+	if !math.IsInf(max, 1) {
+		args = append(args, toCents(max))
+		conditions = append(conditions, fmt.Sprintf("price_amount <= $%d", len(args)))
+	}
+	if category != nil {
+		args = append(args, category.Slug())
+		conditions = append(conditions, fmt.Sprintf("category_slug = $%d", len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// listFilter builds the WHERE clause for a ListFilter, TRUE when it sets nothing
+func listFilter(filter item.ListFilter) (string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if filter.Category != nil {
+		args = append(args, filter.Category.Slug())
+		conditions = append(conditions, fmt.Sprintf("category_slug = $%d", len(args)))
+	}
+	if filter.Status != nil {
+		args = append(args, filter.Status.String())
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.BrandSlug != "" {
+		args = append(args, filter.BrandSlug)
+		conditions = append(conditions, fmt.Sprintf("brand_slug = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// attributeRangeFilter builds the WHERE clause for numeric attribute ranges
+// The CASE keeps the numeric cast away from values stored as strings or booleans,
+// which Postgres could otherwise try to cast before checking the type
+func attributeRangeFilter(ranges []item.AttributeRange) (string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	for _, rng := range ranges {
+		args = append(args, rng.Key)
+		value := fmt.Sprintf("(CASE WHEN jsonb_typeof(attributes->$%[1]d) = 'number' THEN (attributes->>$%[1]d)::numeric END)", len(args))
+		conditions = append(conditions, value+" IS NOT NULL")
+
+		if !math.IsInf(rng.Min, -1) {
+			args = append(args, rng.Min)
+			conditions = append(conditions, fmt.Sprintf("%s >= $%d", value, len(args)))
+		}
+		if !math.IsInf(rng.Max, 1) {
+			args = append(args, rng.Max)
+			conditions = append(conditions, fmt.Sprintf("%s <= $%d", value, len(args)))
+		}
+	}
+
+	if len(conditions) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// toCents converts an amount to cents the same way item.NewPrice does
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func imagesToJSON(images []item.Image) []imageJSON {
 	result := make([]imageJSON, len(images))
 	for i, img := range images {
 		result[i] = imageJSON{
@@ -705,44 +1719,221 @@ func (r *postgresItemRepository) imagesToJSON(images []item.Image) []imageJSON {
 	return result
 }
 
-// PERFORMANCE ISSUE 1: N+1 Query Problem
-// GetItemsWithRelatedData demonstrates N+1 query anti-pattern
-func (r *postgresItemRepository) GetItemsWithRelatedData(ctx context.Context, itemIDs []string) ([]*item.Item, error) {
-	var items []*item.Item
+// imagesFromJSON decodes the images column, preserving order and the primary flag
+func imagesFromJSON(data []byte) ([]item.Image, error) {
+	if isNullJSON(data) {
+		return make([]item.Image, 0), nil
+	}
 
-	// N+1 Query Problem: Making individual queries instead of batch query
-	for _, id := range itemIDs {
-		// Individual query for each item - performance killer for large datasets
-		itemQuery := `SELECT id, sku, name, description, price_amount, price_currency,
-					  category_name, category_slug, inventory_quantity, images,
-					  attributes, status, created_at, updated_at
-					  FROM items WHERE id = $1`
+	var imagesJSON []imageJSON
+	if err := json.Unmarshal(data, &imagesJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal images: %w", err)
+	}
 
-		rows, err := r.db.QueryContext(ctx, itemQuery, id)
+	images := make([]item.Image, len(imagesJSON))
+	for i, imgJSON := range imagesJSON {
+		img, err := item.NewImage(imgJSON.URL, imgJSON.Alt, imgJSON.IsPrimary)
 		if err != nil {
-			continue // Silently continue - makes debugging harder
+			return nil, fmt.Errorf("invalid image: %w", err)
 		}
+		images[i] = img
+	}
 
-		itemList, err := r.rowsToItems(rows)
-		rows.Close()
-		if err != nil {
-			continue
+	return images, nil
+}
+
+// attributesToJSON prepares attributes for the JSONB column
+// Each value keeps its kind as its JSON type: string, number or boolean
+func attributesToJSON(attributes item.Attributes) map[string]interface{} {
+	values := attributes.Values()
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		result[key] = value.Interface()
+	}
+	return result
+}
+
+// attributesFromJSON decodes the attributes column into domain attributes
+// Rows written before typed attributes hold only strings and decode unchanged
+func attributesFromJSON(data []byte) (item.Attributes, error) {
+	attributes := item.NewAttributes()
+	if isNullJSON(data) {
+		return attributes, nil
+	}
+
+	var attributesMap map[string]interface{}
+	if err := json.Unmarshal(data, &attributesMap); err != nil {
+		return item.Attributes{}, fmt.Errorf("failed to unmarshal attributes: %w", err)
+	}
+
+	for key, raw := range attributesMap {
+		var value item.AttributeValue
+		switch v := raw.(type) {
+		case string:
+			value = item.StringAttribute(v)
+		case bool:
+			value = item.BoolAttribute(v)
+		case float64:
+			number, err := item.NumberAttribute(v)
+			if err != nil {
+				return item.Attributes{}, fmt.Errorf("invalid attribute %s: %w", key, err)
+			}
+			value = number
+		default:
+			return item.Attributes{}, fmt.Errorf("invalid attribute %s: unsupported JSON type %T", key, raw)
+		}
+
+		if err := attributes.SetValue(key, value); err != nil {
+			return item.Attributes{}, fmt.Errorf("failed to set attribute: %w", err)
+		}
+	}
+
+	return attributes, nil
+}
+
+type dimensionsJSON struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Unit   string  `json:"unit"`
+}
+
+type weightJSON struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// measurementsJSON is the shape of the measurements column; unknown values are left out
+type measurementsJSON struct {
+	Dimensions *dimensionsJSON `json:"dimensions,omitempty"`
+	Weight     *weightJSON     `json:"weight,omitempty"`
+}
+
+// measurementsToJSON encodes dimensions and weight for the measurements column
+// An item with neither is stored as NULL
+func measurementsToJSON(dimensions item.Dimensions, weight item.Weight) ([]byte, error) {
+	if dimensions.IsZero() && weight.IsZero() {
+		return nil, nil
+	}
+
+	var measurements measurementsJSON
+	if !dimensions.IsZero() {
+		measurements.Dimensions = &dimensionsJSON{
+			Length: dimensions.Length(),
+			Width:  dimensions.Width(),
+			Height: dimensions.Height(),
+			Unit:   string(dimensions.Unit()),
 		}
+	}
+	if !weight.IsZero() {
+		measurements.Weight = &weightJSON{Value: weight.Value(), Unit: string(weight.Unit())}
+	}
+
+	data, err := json.Marshal(measurements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal measurements: %w", err)
+	}
+	return data, nil
+}
 
-		if len(itemList) > 0 {
-			// Additional N+1 problems: Individual queries for related data
-			relatedDataQuery := `SELECT COUNT(*) FROM item_views WHERE item_id = $1`
-			var viewCount int
-			_ = r.db.QueryRowContext(ctx, relatedDataQuery, id).Scan(&viewCount)
+// measurementsFromJSON decodes the measurements column; missing values come back as zero
+func measurementsFromJSON(data []byte) (item.Dimensions, item.Weight, error) {
+	var dimensions item.Dimensions
+	var weight item.Weight
+	if isNullJSON(data) {
+		return dimensions, weight, nil
+	}
 
-			// Another individual query for ratings - multiplying the N+1 problem
-			ratingQuery := `SELECT AVG(rating) FROM item_ratings WHERE item_id = $1`
-			var avgRating float64
-			_ = r.db.QueryRowContext(ctx, ratingQuery, id).Scan(&avgRating)
+	var measurements measurementsJSON
+	if err := json.Unmarshal(data, &measurements); err != nil {
+		return dimensions, weight, fmt.Errorf("failed to unmarshal measurements: %w", err)
+	}
 
-			items = append(items, itemList[0])
+	var err error
+	if d := measurements.Dimensions; d != nil {
+		if dimensions, err = item.NewDimensions(d.Length, d.Width, d.Height, d.Unit); err != nil {
+			return item.Dimensions{}, item.Weight{}, fmt.Errorf("invalid dimensions: %w", err)
+		}
+	}
+	if w := measurements.Weight; w != nil {
+		if weight, err = item.NewWeight(w.Value, w.Unit); err != nil {
+			return item.Dimensions{}, item.Weight{}, fmt.Errorf("invalid weight: %w", err)
 		}
 	}
 
-	return items, nil
+	return dimensions, weight, nil
+}
+
+// barcodeValue returns the barcode column value; items without a barcode store NULL
+func barcodeValue(barcode item.Barcode) interface{} {
+	return nullableString(barcode.String())
+}
+
+// nullableString stores an empty optional value as NULL
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// isNullJSON reports whether a nullable JSONB column holds no value
+func isNullJSON(data []byte) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
+// GetItemsWithRelatedData finds items by ID together with their view count and rating figures
+// Views and ratings are aggregated per item in the same query rather than one query per item
+func (r *postgresItemRepository) GetItemsWithRelatedData(ctx context.Context, ids []item.ItemID) ([]item.ItemWithStats, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	if len(ids) == 0 {
+		return []item.ItemWithStats{}, nil
+	}
+
+	idStrings := make([]string, len(ids))
+	for i, id := range ids {
+		idStrings[i] = id.String()
+	}
+
+	query := `
+		SELECT ` + itemColumns + `,
+			COALESCE(views.view_count, 0), COALESCE(ratings.average_rating, 0), COALESCE(ratings.rating_count, 0)
+		FROM items
+		LEFT JOIN (
+			SELECT item_id, COUNT(*) AS view_count
+			FROM item_views WHERE item_id = ANY($1) GROUP BY item_id
+		) views ON views.item_id = items.id
+		LEFT JOIN (
+			SELECT item_id, AVG(rating) AS average_rating, COUNT(*) AS rating_count
+			FROM item_ratings WHERE item_id = ANY($1) GROUP BY item_id
+		) ratings ON ratings.item_id = items.id
+		WHERE items.id = ANY($1)`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(idStrings))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find items with related data: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	results := make([]item.ItemWithStats, 0, len(ids))
+	for rows.Next() {
+		var stats item.ItemWithStats
+		row, err := scanItemRow(rows, &stats.ViewCount, &stats.AverageRating, &stats.RatingCount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if stats.Item, err = rowToItem(row); err != nil {
+			return nil, fmt.Errorf("failed to convert row to item: %w", err)
+		}
+		results = append(results, stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", queryError(ctx, err))
+	}
+
+	return results, nil
 }
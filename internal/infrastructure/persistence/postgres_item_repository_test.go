@@ -3,16 +3,24 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
 	"item-pdp-service/internal/domain/item"
 	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/tracing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestPostgresItemRepository_Save(t *testing.T) {
@@ -27,6 +35,7 @@ func TestPostgresItemRepository_Save(t *testing.T) {
 	testItem := createTestItem(t)
 
 	t.Run("successful save", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO items").
 			WithArgs(
 				testItem.ID().String(),
@@ -38,13 +47,19 @@ func TestPostgresItemRepository_Save(t *testing.T) {
 				testItem.Category().Name(),
 				testItem.Category().Slug(),
 				testItem.Inventory().Quantity(),
+				testItem.Inventory().Reserved(),
 				sqlmock.AnyArg(), // images JSON
 				sqlmock.AnyArg(), // attributes JSON
+				sqlmock.AnyArg(), // measurements JSON
+				nil,              // barcode
+				nil,              // brand_name
+				nil,              // brand_slug
 				testItem.Status().String(),
 				sqlmock.AnyArg(), // created_at
 				sqlmock.AnyArg(), // updated_at
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectOutboxCommit(mock, testItem)
 
 		err := repo.Save(ctx, testItem)
 
@@ -53,8 +68,10 @@ func TestPostgresItemRepository_Save(t *testing.T) {
 	})
 
 	t.Run("database error", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO items").
 			WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
 
 		err := repo.Save(ctx, testItem)
 
@@ -62,6 +79,86 @@ func TestPostgresItemRepository_Save(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to save item")
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("taken SKU is a duplicate", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO items").
+			WillReturnError(&pq.Error{Code: "23505", Constraint: "items_sku_key"})
+		mock.ExpectRollback()
+
+		err := repo.Save(ctx, testItem)
+
+		assert.ErrorIs(t, err, item.ErrItemAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_SaveAll(t *testing.T) {
+	// expectBulkInserts expects three savepointed inserts where the second hits a duplicate SKU
+	expectBulkInserts := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		for i := 0; i < 3; i++ {
+			mock.ExpectExec("SAVEPOINT bulk_item").WillReturnResult(sqlmock.NewResult(0, 0))
+			if i == 1 {
+				mock.ExpectExec("INSERT INTO items").
+					WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "items_sku_key"`))
+				mock.ExpectExec("ROLLBACK TO SAVEPOINT bulk_item").WillReturnResult(sqlmock.NewResult(0, 0))
+				continue
+			}
+			mock.ExpectExec("INSERT INTO items").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec("INSERT INTO outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec("RELEASE SAVEPOINT bulk_item").WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+	}
+
+	t.Run("best effort commits the valid items", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+		items := []*item.Item{createTestItem(t), createTestItem(t), createTestItem(t)}
+
+		expectBulkInserts(mock)
+		mock.ExpectCommit()
+
+		itemErrs, err := repo.SaveAll(context.Background(), items, false)
+
+		require.NoError(t, err)
+		require.Len(t, itemErrs, 3)
+		assert.NoError(t, itemErrs[0])
+		assert.ErrorContains(t, itemErrs[1], "items_sku_key")
+		assert.NoError(t, itemErrs[2])
+
+		// Committed items have handed their events to the outbox; the failed one keeps them
+		assert.Empty(t, items[0].Events())
+		assert.Len(t, items[1].Events(), 1)
+		assert.Empty(t, items[2].Events())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("all or nothing rolls back the transaction", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+		items := []*item.Item{createTestItem(t), createTestItem(t), createTestItem(t)}
+
+		expectBulkInserts(mock)
+		mock.ExpectRollback()
+
+		itemErrs, err := repo.SaveAll(context.Background(), items, true)
+
+		assert.ErrorContains(t, err, "rolled back")
+		require.Len(t, itemErrs, 3)
+		assert.NoError(t, itemErrs[0])
+		assert.Error(t, itemErrs[1])
+		for _, itm := range items {
+			assert.Len(t, itm.Events(), 1)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestPostgresItemRepository_FindByID(t *testing.T) {
@@ -82,8 +179,8 @@ func TestPostgresItemRepository_FindByID(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{
 			"id", "sku", "name", "description", "price_amount", "price_currency",
-			"category_name", "category_slug", "inventory_quantity", "images",
-			"attributes", "status", "created_at", "updated_at",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
 		}).AddRow(
 			testItem.ID().String(),
 			testItem.SKU().String(),
@@ -94,8 +191,13 @@ func TestPostgresItemRepository_FindByID(t *testing.T) {
 			testItem.Category().Name(),
 			testItem.Category().Slug(),
 			testItem.Inventory().Quantity(),
+			testItem.Inventory().Reserved(),
 			images,
 			attributes,
+			nil,
+			nil,
+			nil,
+			nil,
 			testItem.Status().String(),
 			testItem.CreatedAt(),
 			testItem.UpdatedAt(),
@@ -158,8 +260,8 @@ func TestPostgresItemRepository_FindBySKU(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{
 			"id", "sku", "name", "description", "price_amount", "price_currency",
-			"category_name", "category_slug", "inventory_quantity", "images",
-			"attributes", "status", "created_at", "updated_at",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
 		}).AddRow(
 			testItem.ID().String(),
 			testItem.SKU().String(),
@@ -170,8 +272,13 @@ func TestPostgresItemRepository_FindBySKU(t *testing.T) {
 			testItem.Category().Name(),
 			testItem.Category().Slug(),
 			testItem.Inventory().Quantity(),
+			testItem.Inventory().Reserved(),
 			images,
 			attributes,
+			nil,
+			nil,
+			nil,
+			nil,
 			testItem.Status().String(),
 			testItem.CreatedAt(),
 			testItem.UpdatedAt(),
@@ -203,6 +310,202 @@ func TestPostgresItemRepository_FindBySKU(t *testing.T) {
 	})
 }
 
+func TestPostgresItemRepository_FindByBarcode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	testItem := createTestItem(t)
+	barcode, err := item.NewBarcode("4006381333931")
+	require.NoError(t, err)
+
+	t.Run("successful find", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{
+			"id", "sku", "name", "description", "price_amount", "price_currency",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		}).AddRow(testItem.ID().String(), testItem.SKU().String(), testItem.Name(), "", 9999, "USD",
+			"Electronics", "electronics", 10, 0, []byte(`[]`), []byte(`{}`), nil, "4006381333931", nil, nil, "active", time.Now(), time.Now())
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE barcode = \\$1").
+			WithArgs("4006381333931").
+			WillReturnRows(rows)
+
+		result, err := repo.FindByBarcode(ctx, barcode)
+
+		require.NoError(t, err)
+		assert.Equal(t, barcode, result.Barcode())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE barcode = \\$1").
+			WithArgs("4006381333931").
+			WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.FindByBarcode(ctx, barcode)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "item with barcode 4006381333931 not found")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_FindByIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	t.Run("mix of existing and missing IDs", func(t *testing.T) {
+		existing := item.NewItemID()
+		missing := item.NewItemID()
+
+		rows := sqlmock.NewRows([]string{
+			"id", "sku", "name", "description", "price_amount", "price_currency",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		}).AddRow(
+			existing.String(), "TEST-001", "Test Item", "Test Description", 9999, "USD",
+			"Electronics", "electronics", 10, 0,
+			[]byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now(),
+		)
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE id = ANY\\(\\$1\\)").
+			WithArgs(pq.Array([]string{existing.String(), missing.String()})).
+			WillReturnRows(rows)
+
+		result, err := repo.FindByIDs(ctx, []item.ItemID{existing, missing})
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, existing.String(), result[0].ID().String())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no IDs skips the query", func(t *testing.T) {
+		result, err := repo.FindByIDs(ctx, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_GetItemsWithRelatedData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	popular := item.NewItemID()
+	unrated := item.NewItemID()
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		"view_count", "average_rating", "rating_count",
+	}
+
+	t.Run("stats come from the joined rows", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(popular.String(), "TEST-001", "Popular Item", "", 9999, "USD",
+				"Electronics", "electronics", 10, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now(),
+				42, 4.5, 8).
+			AddRow(unrated.String(), "TEST-002", "Unrated Item", "", 1999, "USD",
+				"Electronics", "electronics", 3, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now(),
+				0, 0.0, 0)
+
+		mock.ExpectQuery("SELECT (.+) FROM items LEFT JOIN (.+) item_views (.+) LEFT JOIN (.+) item_ratings (.+) WHERE items.id = ANY\\(\\$1\\)").
+			WithArgs(pq.Array([]string{popular.String(), unrated.String()})).
+			WillReturnRows(rows)
+
+		results, err := repo.GetItemsWithRelatedData(ctx, []item.ItemID{popular, unrated})
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, popular.String(), results[0].Item.ID().String())
+		assert.Equal(t, 42, results[0].ViewCount)
+		assert.Equal(t, 4.5, results[0].AverageRating)
+		assert.Equal(t, 8, results[0].RatingCount)
+		assert.Equal(t, unrated.String(), results[1].Item.ID().String())
+		assert.Zero(t, results[1].ViewCount)
+		assert.Zero(t, results[1].RatingCount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query errors are returned", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items").
+			WithArgs(pq.Array([]string{popular.String()})).
+			WillReturnError(errors.New("relation \"item_views\" does not exist"))
+
+		results, err := repo.GetItemsWithRelatedData(ctx, []item.ItemID{popular})
+
+		assert.Nil(t, results)
+		assert.ErrorContains(t, err, "item_views")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_RecordView(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	itemID := item.NewItemID()
+
+	mock.ExpectExec("INSERT INTO item_views \\(item_id, viewed_at\\)").
+		WithArgs(itemID.String(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.RecordView(context.Background(), itemID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_FindMostViewed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+
+	most := item.NewItemID()
+	fewer := item.NewItemID()
+	since := time.Now().Add(-24 * time.Hour)
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		"view_count",
+	}).
+		AddRow(most.String(), "TEST-001", "Most Viewed", "", 9999, "USD",
+			"Electronics", "electronics", 10, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now(), 30).
+		AddRow(fewer.String(), "TEST-002", "Fewer Views", "", 1999, "USD",
+			"Electronics", "electronics", 3, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now(), 4)
+
+	mock.ExpectQuery("SELECT (.+) FROM items JOIN (.+) item_views WHERE viewed_at >= \\$1 (.+) ORDER BY views.view_count DESC, items.created_at DESC LIMIT \\$2").
+		WithArgs(since, 5).
+		WillReturnRows(rows)
+
+	results, err := repo.FindMostViewed(context.Background(), since, 5)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, most.String(), results[0].Item.ID().String())
+	assert.Equal(t, 30, results[0].ViewCount)
+	assert.Equal(t, fewer.String(), results[1].Item.ID().String())
+	assert.Equal(t, 4, results[1].ViewCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostgresItemRepository_Update(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -214,6 +517,7 @@ func TestPostgresItemRepository_Update(t *testing.T) {
 	testItem := createTestItem(t)
 
 	t.Run("successful update", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectExec("UPDATE items SET").
 			WithArgs(
 				testItem.ID().String(),
@@ -224,12 +528,18 @@ func TestPostgresItemRepository_Update(t *testing.T) {
 				testItem.Category().Name(),
 				testItem.Category().Slug(),
 				testItem.Inventory().Quantity(),
+				testItem.Inventory().Reserved(),
 				sqlmock.AnyArg(), // images JSON
 				sqlmock.AnyArg(), // attributes JSON
+				sqlmock.AnyArg(), // measurements JSON
+				nil,              // barcode
+				nil,              // brand_name
+				nil,              // brand_slug
 				testItem.Status().String(),
 				sqlmock.AnyArg(), // updated_at
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectOutboxCommit(mock, testItem)
 
 		err := repo.Update(ctx, testItem)
 
@@ -237,9 +547,38 @@ func TestPostgresItemRepository_Update(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("removed attribute is dropped from the stored JSON", func(t *testing.T) {
+		attrItem := createTestItem(t)
+		attrs := attrItem.Attributes()
+		require.NoError(t, attrs.Set("color", "red"))
+		require.NoError(t, attrs.Set("size", "L"))
+		attrItem.SetAttributes(attrs)
+		require.True(t, attrItem.RemoveAttribute("color"))
+
+		attributesJSON := &capturedArg{}
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE items SET").
+			WithArgs(
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				attributesJSON,
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg(),
+			).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectOutboxCommit(mock, attrItem)
+
+		require.NoError(t, repo.Update(ctx, attrItem))
+
+		assert.JSONEq(t, `{"size":"L"}`, string(attributesJSON.value.([]byte)))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("item not found", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectExec("UPDATE items SET").
 			WillReturnResult(sqlmock.NewResult(1, 0)) // 0 rows affected
+		mock.ExpectRollback()
 
 		err := repo.Update(ctx, testItem)
 
@@ -249,6 +588,51 @@ func TestPostgresItemRepository_Update(t *testing.T) {
 	})
 }
 
+func TestPostgresItemRepository_UpdateAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+
+	items := make([]*item.Item, 3)
+	for i := range items {
+		items[i] = createTestItem(t)
+		items[i].PullEvents()
+		inventory, err := item.NewInventory(10 + i)
+		require.NoError(t, err)
+		items[i].SetInventory(inventory)
+	}
+
+	mock.ExpectBegin()
+	for i := range items {
+		mock.ExpectExec("SAVEPOINT bulk_update").WillReturnResult(sqlmock.NewResult(0, 0))
+		if i == 1 {
+			// The row vanished since it was read
+			mock.ExpectExec("UPDATE items SET").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec("ROLLBACK TO SAVEPOINT bulk_update").WillReturnResult(sqlmock.NewResult(0, 0))
+			continue
+		}
+		mock.ExpectExec("UPDATE items SET").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("RELEASE SAVEPOINT bulk_update").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectCommit()
+
+	itemErrs, err := repo.UpdateAll(context.Background(), items)
+
+	require.NoError(t, err)
+	require.Len(t, itemErrs, 3)
+	assert.NoError(t, itemErrs[0])
+	assert.True(t, errors.Is(itemErrs[1], item.ErrItemNotFound))
+	assert.NoError(t, itemErrs[2])
+
+	assert.Empty(t, items[0].Events())
+	assert.Len(t, items[1].Events(), 1)
+	assert.Empty(t, items[2].Events())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostgresItemRepository_Delete(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -283,6 +667,50 @@ func TestPostgresItemRepository_Delete(t *testing.T) {
 	})
 }
 
+func TestPostgresItemRepository_DeleteAll(t *testing.T) {
+	ctx := context.Background()
+	first, unknown, last := item.NewItemID(), item.NewItemID(), item.NewItemID()
+
+	t.Run("unknown IDs are reported and the rest deleted", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM items WHERE id = \\$1").WithArgs(first.String()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM items WHERE id = \\$1").WithArgs(unknown.String()).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM items WHERE id = \\$1").WithArgs(last.String()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		notFound, err := repo.DeleteAll(ctx, []item.ItemID{first, unknown, last})
+
+		require.NoError(t, err)
+		assert.Equal(t, []item.ItemID{unknown}, notFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("a database error rolls back every delete", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM items WHERE id = \\$1").WithArgs(first.String()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM items WHERE id = \\$1").WithArgs(unknown.String()).WillReturnError(errors.New("connection reset"))
+		mock.ExpectRollback()
+
+		notFound, err := repo.DeleteAll(ctx, []item.ItemID{first, unknown, last})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection reset")
+		assert.Nil(t, notFound)
+		// The first delete is undone with the rest and nothing is committed
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestPostgresItemRepository_ExistsBySKU(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -320,6 +748,29 @@ func TestPostgresItemRepository_ExistsBySKU(t *testing.T) {
 	})
 }
 
+func TestPostgresItemRepository_ExistsCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	category, _ := item.NewCategory("Home & Garden")
+
+	for _, exists := range []bool{true, false} {
+		mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM categories WHERE slug = \$1\) OR EXISTS\(SELECT 1 FROM items WHERE category_slug = \$1\)`).
+			WithArgs("home-garden").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(exists))
+
+		got, err := repo.ExistsCategory(ctx, category)
+
+		require.NoError(t, err)
+		assert.Equal(t, exists, got)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostgresItemRepository_Search(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -338,8 +789,8 @@ func TestPostgresItemRepository_Search(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{
 			"id", "sku", "name", "description", "price_amount", "price_currency",
-			"category_name", "category_slug", "inventory_quantity", "images",
-			"attributes", "status", "created_at", "updated_at",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
 		}).AddRow(
 			"550e8400-e29b-41d4-a716-446655440000",
 			"TEST-001",
@@ -350,17 +801,23 @@ func TestPostgresItemRepository_Search(t *testing.T) {
 			"Electronics",
 			"electronics",
 			10,
+			0,
 			images,
 			attributes,
+			nil,
+			nil,
+			nil,
+			nil,
 			"active",
 			time.Now(),
 			time.Now(),
 		)
 
-		mock.ExpectQuery("SELECT (.+) FROM items WHERE \\(name ILIKE '%test%' OR description ILIKE '%test%' OR sku ILIKE '%test%'\\) ORDER BY created_at DESC LIMIT 10 OFFSET 0").
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE \\(name ILIKE \\$1 OR description ILIKE \\$1 OR sku ILIKE \\$1\\) ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
+			WithArgs("%test%", limit, offset).
 			WillReturnRows(rows)
 
-		results, err := repo.Search(ctx, query, limit, offset)
+		results, err := repo.Search(ctx, query, item.DefaultSort(), limit, offset)
 
 		assert.NoError(t, err)
 		assert.Len(t, results, 1)
@@ -370,14 +827,52 @@ func TestPostgresItemRepository_Search(t *testing.T) {
 	t.Run("no results", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "sku", "name", "description", "price_amount", "price_currency",
-			"category_name", "category_slug", "inventory_quantity", "images",
-			"attributes", "status", "created_at", "updated_at",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		})
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE \\(name ILIKE \\$1 OR description ILIKE \\$1 OR sku ILIKE \\$1\\) ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
+			WithArgs("%test%", limit, offset).
+			WillReturnRows(rows)
+
+		results, err := repo.Search(ctx, query, item.DefaultSort(), limit, offset)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 0)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wildcard characters are matched literally", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{
+			"id", "sku", "name", "description", "price_amount", "price_currency",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		})
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE (.+) LIMIT \\$2 OFFSET \\$3").
+			WithArgs(`%50\%%`, limit, offset).
+			WillReturnRows(rows)
+
+		results, err := repo.Search(ctx, "50%", item.DefaultSort(), limit, offset)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 0)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("quotes are bound rather than interpolated", func(t *testing.T) {
+		injection := "a' OR '1'='1"
+		rows := sqlmock.NewRows([]string{
+			"id", "sku", "name", "description", "price_amount", "price_currency",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
 		})
 
-		mock.ExpectQuery("SELECT (.+) FROM items WHERE \\(name ILIKE '%test%' OR description ILIKE '%test%' OR sku ILIKE '%test%'\\) ORDER BY created_at DESC LIMIT 10 OFFSET 0").
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE \\(name ILIKE \\$1 OR description ILIKE \\$1 OR sku ILIKE \\$1\\) ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
+			WithArgs("%"+injection+"%", limit, offset).
 			WillReturnRows(rows)
 
-		results, err := repo.Search(ctx, query, limit, offset)
+		results, err := repo.Search(ctx, injection, item.DefaultSort(), limit, offset)
 
 		assert.NoError(t, err)
 		assert.Len(t, results, 0)
@@ -385,7 +880,1242 @@ func TestPostgresItemRepository_Search(t *testing.T) {
 	})
 }
 
-// Helper function to create a test item
+func TestPostgresItemRepository_SearchRanked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+	searchQuery := "SELECT (.+) FROM items, plainto_tsquery\\('english', \\$1\\) AS search_query WHERE search_vector @@ search_query " +
+		"ORDER BY ts_rank\\(search_vector, search_query\\) DESC, created_at DESC LIMIT \\$2 OFFSET \\$3"
+
+	t.Run("title match ranks above description match", func(t *testing.T) {
+		now := time.Now()
+		// Rows come back in ts_rank order; the weighted search_vector ranks name matches first
+		rows := sqlmock.NewRows(columns).
+			AddRow("550e8400-e29b-41d4-a716-446655440001", "AUD-001", "Wireless Headphones", "Over-ear, noise cancelling",
+				14999, "USD", "Electronics", "electronics", 5, 0, []byte("[]"), []byte("{}"), nil, nil, nil, nil, "active", now, now).
+			AddRow("550e8400-e29b-41d4-a716-446655440002", "ACC-001", "Travel Case", "Hard shell case that fits most headphones",
+				1999, "USD", "Electronics", "electronics", 5, 0, []byte("[]"), []byte("{}"), nil, nil, nil, nil, "active", now.Add(time.Hour), now)
+
+		mock.ExpectQuery(searchQuery).
+			WithArgs("headphones", 10, 0).
+			WillReturnRows(rows)
+
+		results, err := repo.SearchRanked(ctx, "headphones", 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Wireless Headphones", results[0].Name())
+		assert.Equal(t, "Travel Case", results[1].Name())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query is bound rather than interpolated", func(t *testing.T) {
+		injection := "a' OR '1'='1"
+		mock.ExpectQuery(searchQuery).
+			WithArgs(injection, 10, 20).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		results, err := repo.SearchRanked(ctx, injection, 10, 20)
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count uses the same match", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE search_vector @@ plainto_tsquery\\('english', \\$1\\)").
+			WithArgs("headphones").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repo.CountBySearchRanked(ctx, "headphones")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_FindByPriceRange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+
+	t.Run("range includes some items", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(item.NewItemID().String(), "TEST-001", "Cheap Item", "", 1999, "USD",
+				"Electronics", "electronics", 10, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now()).
+			AddRow(item.NewItemID().String(), "TEST-002", "Mid Item", "", 4500, "USD",
+				"Electronics", "electronics", 5, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE price_currency = \\$1 AND price_amount >= \\$2 AND price_amount <= \\$3 ORDER BY created_at DESC LIMIT \\$4 OFFSET \\$5").
+			WithArgs("USD", int64(1000), int64(5000), 10, 0).
+			WillReturnRows(rows)
+
+		results, err := repo.FindByPriceRange(ctx, 10, 50, "usd", item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, 19.99, results[0].Price().Amount())
+		assert.Equal(t, 45.0, results[1].Price().Amount())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("range includes no items", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE price_currency = \\$1 AND price_amount >= \\$2 AND price_amount <= \\$3 ORDER BY created_at DESC LIMIT \\$4 OFFSET \\$5").
+			WithArgs("USD", int64(100000), int64(200000), 10, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		results, err := repo.FindByPriceRange(ctx, 1000, 2000, "USD", item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("open upper bound combined with category", func(t *testing.T) {
+		category, err := item.NewCategory("Electronics")
+		require.NoError(t, err)
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE price_currency = \\$1 AND price_amount >= \\$2 AND category_slug = \\$3 ORDER BY created_at DESC LIMIT \\$4 OFFSET \\$5").
+			WithArgs("USD", int64(2500), "electronics", 10, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err = repo.FindByCategoryAndPriceRange(ctx, category, 25, math.Inf(1), "", item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count by price range", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE price_currency = \\$1 AND price_amount >= \\$2 AND price_amount <= \\$3").
+			WithArgs("USD", int64(1000), int64(5000)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repo.CountByPriceRange(ctx, 10, 50, "USD")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_FindByAttributes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+
+	t.Run("color=red returns only matching items", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(item.NewItemID().String(), "TEST-RED", "Red Shirt", "", 1999, "USD",
+				"Clothing", "clothing", 10, 0, []byte(`[]`), []byte(`{"color":"red","size":"M"}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE attributes @> \\$1 ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
+			WithArgs(`{"color":"red"}`, 10, 0).
+			WillReturnRows(rows)
+
+		results, err := repo.FindByAttributes(ctx, map[string]string{"color": "red"}, item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		color, ok := results[0].Attributes().Get("color")
+		assert.True(t, ok)
+		assert.Equal(t, "red", color)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("multiple filters are combined into one containment check", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE attributes @> \\$1").
+			WithArgs(`{"color":"red","size":"L"}`, 10, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		results, err := repo.FindByAttributes(ctx, map[string]string{"size": "L", "color": "red"}, item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count by attributes", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE attributes @> \\$1").
+			WithArgs(`{"color":"red"}`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repo.CountByAttributes(ctx, map[string]string{"color": "red"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_FindByAttributeRanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+	numeric := func(n int) string {
+		return fmt.Sprintf("\\(CASE WHEN jsonb_typeof\\(attributes->\\$%[1]d\\) = 'number' THEN \\(attributes->>\\$%[1]d\\)::numeric END\\)", n)
+	}
+
+	t.Run("closed range bounds the numeric value", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(item.NewItemID().String(), "BAG-001", "Backpack", "", 4999, "USD",
+				"Bags", "bags", 10, 0, []byte(`[]`), []byte(`{"weight":1.5,"waterproof":true}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE "+numeric(1)+" IS NOT NULL AND "+
+			numeric(1)+" >= \\$2 AND "+numeric(1)+" <= \\$3 ORDER BY created_at DESC LIMIT \\$4 OFFSET \\$5").
+			WithArgs("weight", 1.0, 2.0, 10, 0).
+			WillReturnRows(rows)
+
+		results, err := repo.FindByAttributeRanges(ctx, []item.AttributeRange{{Key: "weight", Min: 1, Max: 2}}, item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		weight, _ := results[0].Attributes().Value("weight")
+		number, ok := weight.Number()
+		assert.True(t, ok)
+		assert.Equal(t, 1.5, number)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("open bounds add no comparison", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE "+numeric(1)+" IS NOT NULL AND "+
+			numeric(1)+" >= \\$2 AND "+numeric(3)+" IS NOT NULL$").
+			WithArgs("weight", 1.0, "capacity").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+		count, err := repo.CountByAttributeRanges(ctx, []item.AttributeRange{
+			{Key: "weight", Min: 1, Max: math.Inf(1)},
+			{Key: "capacity", Min: math.Inf(-1), Max: math.Inf(1)},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_FindByBrand(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}).
+		AddRow(item.NewItemID().String(), "ANV-001", "Anvil", "", 4999, "USD",
+			"Tools", "tools", 3, 0, []byte(`[]`), []byte(`{}`), nil, nil, "Acme & Sons", "acme-sons", "active", time.Now(), time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE brand_slug = \\$1 ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
+		WithArgs("acme-sons", 10, 0).
+		WillReturnRows(rows)
+
+	results, err := repo.FindByBrand(ctx, "acme-sons", 10, 0)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Acme & Sons", results[0].Brand().Name())
+	assert.Equal(t, "acme-sons", results[0].Brand().Slug())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_FindByFilter(t *testing.T) {
+	newRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{
+			"id", "sku", "name", "description", "price_amount", "price_currency",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		}).
+			AddRow(item.NewItemID().String(), "ANV-001", "Anvil", "", 4999, "USD",
+				"Tools", "tools", 3, 0, []byte(`[]`), []byte(`{}`), nil, nil, "Acme & Sons", "acme-sons", "active", time.Now(), time.Now())
+	}
+
+	t.Run("combines status and category", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+		category, _ := item.NewCategory("Tools")
+		status := item.StatusActive
+		filter := item.ListFilter{Category: &category, Status: &status}
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM items WHERE category_slug = \$1 AND status = \$2$`).
+			WithArgs("tools", "active").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`SELECT (.+) FROM items WHERE category_slug = \$1 AND status = \$2 ORDER BY price_amount ASC LIMIT \$3 OFFSET \$4`).
+			WithArgs("tools", "active", 10, 20).
+			WillReturnRows(newRows())
+
+		count, err := repo.CountByFilter(context.Background(), filter)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		results, err := repo.FindByFilter(context.Background(), filter, item.Sort{Field: item.SortByPrice, Order: item.SortAsc}, 10, 20)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "tools", results[0].Category().Slug())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no filter matches every item", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+
+		mock.ExpectQuery(`SELECT (.+) FROM items WHERE TRUE ORDER BY created_at DESC LIMIT \$1 OFFSET \$2`).
+			WithArgs(10, 0).
+			WillReturnRows(newRows())
+
+		results, err := repo.FindByFilter(context.Background(), item.ListFilter{}, item.DefaultSort(), 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("brand narrows by slug", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM items WHERE brand_slug = \$1$`).
+			WithArgs("acme-sons").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+		count, err := repo.CountByFilter(context.Background(), item.ListFilter{BrandSlug: "acme-sons"})
+		require.NoError(t, err)
+		assert.Equal(t, 4, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_FindRelated(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	source := createTestItem(t)
+	attrs := source.Attributes()
+	require.NoError(t, attrs.Set("color", "red"))
+	source.SetAttributes(attrs)
+	attributesJSON := &capturedArg{}
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}).
+		AddRow(item.NewItemID().String(), "TV-RED", "Red Television", "", 49999, "USD",
+			"Electronics", "electronics", 4, 0, []byte(`[]`), []byte(`{"color":"red"}`), nil, nil, nil, nil, "active", time.Now(), time.Now()).
+		AddRow(item.NewItemID().String(), "TV-BLUE", "Blue Television", "", 49999, "USD",
+			"Electronics", "electronics", 2, 0, []byte(`[]`), []byte(`{"color":"blue"}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+	// The source is excluded by ID and only sellable items in its category are considered
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE category_slug = \\$1 AND id <> \\$2 "+
+		"AND status = 'active' AND inventory_quantity > reserved_quantity "+
+		"ORDER BY \\((.+)jsonb_each\\(attributes\\)(.+)\\) DESC, created_at DESC LIMIT \\$4").
+		WithArgs("electronics", source.ID().String(), attributesJSON, 5).
+		WillReturnRows(rows)
+
+	results, err := repo.FindRelated(ctx, source, 5)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "TV-RED", results[0].SKU().String())
+	assert.JSONEq(t, `{"color":"red"}`, string(attributesJSON.value.([]byte)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_FindSummariesByFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	status := item.StatusActive
+	id := item.NewItemID()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "price_amount", "price_currency", "category_name", "status", "in_stock",
+	}).
+		AddRow(id.String(), "ANV-001", "Anvil", 4999, "USD", "Tools", "active", true).
+		AddRow(item.NewItemID().String(), "ANV-002", "Small Anvil", 2999, "USD", "Tools", "active", false)
+
+	// Only the summary columns are read; images, attributes and measurements stay in the database
+	mock.ExpectQuery(`^\s*SELECT id, sku, name, price_amount, price_currency, category_name, status,\s+`+
+		`inventory_quantity > 0 AS in_stock\s+FROM items WHERE status = \$1 ORDER BY name ASC LIMIT \$2 OFFSET \$3$`).
+		WithArgs("active", 10, 0).
+		WillReturnRows(rows)
+
+	summaries, err := repo.FindSummariesByFilter(context.Background(), item.ListFilter{Status: &status},
+		item.Sort{Field: item.SortByName, Order: item.SortAsc}, 10, 0)
+
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, id, summaries[0].ID)
+	assert.Equal(t, "ANV-001", summaries[0].SKU.String())
+	assert.Equal(t, 49.99, summaries[0].Price.Amount())
+	assert.Equal(t, "tools", summaries[0].Category.Slug())
+	assert.True(t, summaries[0].InStock)
+	assert.False(t, summaries[1].InStock)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_FindByNamePrefix(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}).
+		AddRow(item.NewItemID().String(), "TEL-1", "Telescope", "", 19999, "USD",
+			"Electronics", "electronics", 4, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+	// The prefix is lowered and its LIKE wildcards escaped so they match literally
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE lower\\(name\\) LIKE \\$1 AND status = 'active' "+
+		"ORDER BY length\\(name\\), name LIMIT \\$2").
+		WithArgs(`te\_%`, 10).
+		WillReturnRows(rows)
+
+	results, err := repo.FindByNamePrefix(ctx, "Te_", 10)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Telescope", results[0].Name())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_FindByCategoryTree(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+
+	electronics, err := item.NewCategory("Electronics")
+	require.NoError(t, err)
+
+	t.Run("parent category returns items from its children", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(item.NewItemID().String(), "TV-001", "Television", "", 49999, "USD",
+				"Electronics", "electronics", 4, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now()).
+			AddRow(item.NewItemID().String(), "PHONE-001", "Smartphone", "", 79999, "USD",
+				"Phones", "phones", 10, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+		mock.ExpectQuery("WITH RECURSIVE category_tree\\(slug\\) AS \\(.+ UNION .+ JOIN category_tree t ON c.parent_slug = t.slug\\s+\\)\\s+"+
+			"SELECT (.+) FROM items WHERE category_slug IN \\(SELECT slug FROM category_tree\\) ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
+			WithArgs("electronics", 10, 0).
+			WillReturnRows(rows)
+
+		results, err := repo.FindByCategoryTree(ctx, electronics, item.DefaultSort(), 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "electronics", results[0].Category().Slug())
+		assert.Equal(t, "phones", results[1].Category().Slug())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count covers the whole tree", func(t *testing.T) {
+		mock.ExpectQuery("WITH RECURSIVE category_tree(.+)SELECT COUNT\\(\\*\\) FROM items WHERE category_slug IN \\(SELECT slug FROM category_tree\\)").
+			WithArgs("electronics").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+		count, err := repo.CountByCategoryTree(ctx, electronics)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		mock.ExpectQuery("WITH RECURSIVE category_tree").
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.FindByCategoryTree(ctx, electronics, item.DefaultSort(), 10, 0)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find items by category tree")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_Stream(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+
+	t.Run("category filter limits the rows", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(item.NewItemID().String(), "TOY-001", "Robot", "", 1999, "USD",
+				"Toys", "toys", 10, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now()).
+			AddRow(item.NewItemID().String(), "TOY-002", "Kite", "", 999, "USD",
+				"Toys", "toys", 3, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE category_slug = \\$1 ORDER BY created_at, id$").
+			WithArgs("toys").
+			WillReturnRows(rows)
+
+		category, err := item.NewCategory("toys")
+		require.NoError(t, err)
+
+		var skus []string
+		err = repo.Stream(ctx, item.StreamFilter{Category: &category}, func(itm *item.Item) error {
+			skus = append(skus, itm.SKU().String())
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"TOY-001", "TOY-002"}, skus)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no filter streams every item", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items ORDER BY created_at, id$").
+			WithArgs().
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		err := repo.Stream(ctx, item.StreamFilter{}, func(*item.Item) error { return nil })
+
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("an error from the callback stops the stream", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(item.NewItemID().String(), "TOY-001", "Robot", "", 1999, "USD",
+				"Toys", "toys", 10, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now()).
+			AddRow(item.NewItemID().String(), "TOY-002", "Kite", "", 999, "USD",
+				"Toys", "toys", 3, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now())
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE status = \\$1").
+			WithArgs("active").
+			WillReturnRows(rows)
+
+		status := item.StatusActive
+		stop := errors.New("client went away")
+		calls := 0
+		err := repo.Stream(ctx, item.StreamFilter{Status: &status}, func(*item.Item) error {
+			calls++
+			return stop
+		})
+
+		assert.Equal(t, stop, err)
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_LenientReads(t *testing.T) {
+	category, err := item.NewCategory("Electronics")
+	require.NoError(t, err)
+
+	goodID := item.NewItemID().String()
+	mixedRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{
+			"id", "sku", "name", "description", "price_amount", "price_currency",
+			"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+			"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+		}).
+			AddRow(goodID, "TV-RED", "Red Television", "", 49999, "USD",
+				"Electronics", "electronics", 4, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "active", time.Now(), time.Now()).
+			AddRow(item.NewItemID().String(), "TV-OLD", "Legacy Television", "", 19999, "USD",
+				"Electronics", "electronics", 1, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "retired", time.Now(), time.Now())
+	}
+
+	t.Run("strict mode fails the whole page", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE category_slug = \\$1").
+			WithArgs("electronics", 10, 0).
+			WillReturnRows(mixedRows())
+
+		results, err := repo.FindByCategory(context.Background(), category, item.DefaultSort(), 10, 0)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to convert row to item")
+		assert.Nil(t, results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("lenient mode skips the bad row", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db}, WithLenientReads())
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE category_slug = \\$1").
+			WithArgs("electronics", 10, 0).
+			WillReturnRows(mixedRows())
+
+		recorder := tracetest.NewSpanRecorder()
+		ctx, span := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).
+			Tracer("test").Start(context.Background(), "FindByCategory")
+
+		results, err := repo.FindByCategory(ctx, category, item.DefaultSort(), 10, 0)
+		span.End()
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, goodID, results[0].ID().String())
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		require.Len(t, recorder.Ended(), 1)
+		assert.Contains(t, recorder.Ended()[0].Attributes(), tracing.AttrDBRowsSkipped.Int(1))
+	})
+
+	t.Run("lenient mode keeps single lookups strict", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := NewPostgresItemRepository(&database.DB{DB: db}, WithLenientReads())
+		id := item.NewItemID()
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+			WithArgs(id.String()).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "sku", "name", "description", "price_amount", "price_currency",
+				"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+				"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+			}).AddRow(id.String(), "TV-OLD", "Legacy Television", "", 19999, "USD",
+				"Electronics", "electronics", 1, 0, []byte(`[]`), []byte(`{}`), nil, nil, nil, nil, "retired", time.Now(), time.Now()))
+
+		_, err = repo.FindByID(context.Background(), id)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_Sorting(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	columns := []string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}
+
+	t.Run("price ascending orders by price_amount", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE status = 'active' AND inventory_quantity > reserved_quantity\\s+ORDER BY price_amount ASC LIMIT \\$1 OFFSET \\$2").
+			WithArgs(10, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := repo.FindAvailableItems(ctx, item.Sort{Field: item.SortByPrice, Order: item.SortAsc}, 10, 0)
+
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("name descending on a filtered query", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE status = \\$1 ORDER BY name DESC LIMIT \\$2 OFFSET \\$3").
+			WithArgs("active", 10, 0).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := repo.FindByStatus(ctx, item.StatusActive, item.Sort{Field: item.SortByName, Order: item.SortDesc}, 10, 0)
+
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestOrderBy(t *testing.T) {
+	assert.Equal(t, "ORDER BY created_at DESC", orderBy(item.DefaultSort()))
+	assert.Equal(t, "ORDER BY price_amount ASC", orderBy(item.Sort{Field: item.SortByPrice, Order: item.SortAsc}))
+	assert.Equal(t, "ORDER BY updated_at DESC", orderBy(item.Sort{Field: item.SortByUpdatedAt}))
+	// Unknown values never reach the query text
+	assert.Equal(t, "ORDER BY created_at DESC", orderBy(item.Sort{Field: "id; DROP TABLE items", Order: "asc; --"}))
+}
+
+func TestPostgresItemRepository_Counts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	t.Run("count by search", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE \\(name ILIKE \\$1").
+			WithArgs("%test%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(25))
+
+		count, err := repo.CountBySearch(ctx, "test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 25, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count by brand", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE brand_slug = \\$1").
+			WithArgs("acme").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repo.CountByBrand(ctx, "acme")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count available", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE status = 'active' AND inventory_quantity > reserved_quantity").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+		count, err := repo.CountAvailable(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT").
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.CountAvailable(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to count available items")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count top categories", func(t *testing.T) {
+		mock.ExpectQuery("SELECT MIN\\(category_name\\), category_slug, COUNT\\(\\*\\)\\s+FROM items\\s+GROUP BY category_slug").
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"name", "slug", "count"}).
+				AddRow("Electronics", "electronics", 12).
+				AddRow("Home & Garden", "home-garden", 4))
+
+		counts, err := repo.CountTopCategories(ctx, 10)
+
+		require.NoError(t, err)
+		require.Len(t, counts, 2)
+		assert.Equal(t, "electronics", counts[0].Category.Slug())
+		assert.Equal(t, 12, counts[0].Count)
+		assert.Equal(t, "Home & Garden", counts[1].Category.Name())
+		assert.Equal(t, "home-garden", counts[1].Category.Slug())
+		assert.Equal(t, 4, counts[1].Count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresItemRepository_QueryTimeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db, QueryTimeout: 20 * time.Millisecond})
+	ctx := context.Background()
+	id := item.NewItemID()
+
+	t.Run("find by ID", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+			WithArgs(id.String()).
+			WillDelayFor(time.Second).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.FindByID(ctx, id)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM items, plainto_tsquery").
+			WithArgs("phone", 20, 0).
+			WillDelayFor(time.Second).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.SearchRanked(ctx, "phone", 20, 0)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM items WHERE id = \\$1").
+			WithArgs(id.String()).
+			WillDelayFor(time.Second).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.Delete(ctx, id)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("bulk delete times each statement rather than the batch", func(t *testing.T) {
+		// Four deletes of 8ms each take longer than the 20ms timeout together, but none does alone
+		ids := []item.ItemID{item.NewItemID(), item.NewItemID(), item.NewItemID(), item.NewItemID()}
+		mock.ExpectBegin()
+		for _, id := range ids {
+			mock.ExpectExec("DELETE FROM items WHERE id = \\$1").
+				WithArgs(id.String()).
+				WillDelayFor(8 * time.Millisecond).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+
+		notFound, err := repo.DeleteAll(ctx, ids)
+
+		require.NoError(t, err)
+		assert.Empty(t, notFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query within the timeout", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE brand_slug = \\$1").
+			WithArgs("acme").
+			WillDelayFor(time.Millisecond).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repo.CountByBrand(ctx, "acme")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("zero timeout leaves the query to the caller's context", func(t *testing.T) {
+		repo := NewPostgresItemRepository(&database.DB{DB: db})
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM items WHERE brand_slug = \\$1").
+			WithArgs("acme").
+			WillDelayFor(50 * time.Millisecond).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repo.CountByBrand(ctx, "acme")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+}
+
+func TestContainsPattern(t *testing.T) {
+	assert.Equal(t, "%test%", containsPattern("test"))
+	assert.Equal(t, `%50\%%`, containsPattern("50%"))
+	assert.Equal(t, `%a\_b%`, containsPattern("a_b"))
+	assert.Equal(t, `%c:\\dir%`, containsPattern(`c:\dir`))
+}
+
+func TestPostgresItemRepository_SaveAndFindByID_RoundTrip(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	testItem := createTestItem(t)
+	inventory, err := item.NewInventoryWithReserved(42, 5)
+	require.NoError(t, err)
+	testItem.SetInventory(inventory)
+	testItem.SetStatus(item.StatusActive)
+	attrs := testItem.Attributes()
+	require.NoError(t, attrs.Set("color", "red"))
+	require.NoError(t, attrs.Set("size", "L"))
+	testItem.SetAttributes(attrs)
+
+	// Capture every inserted column so it can be fed back as a row
+	args, row := captureArgs(19)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO items").
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectOutboxCommit(mock, testItem)
+
+	require.NoError(t, repo.Save(ctx, testItem))
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}).AddRow(row()...)
+
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+		WithArgs(testItem.ID().String()).
+		WillReturnRows(rows)
+
+	result, err := repo.FindByID(ctx, testItem.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, testItem.ID().String(), result.ID().String())
+	assert.Equal(t, item.StatusActive, result.Status())
+	assert.Equal(t, 42, result.Inventory().Quantity())
+	assert.Equal(t, 5, result.Inventory().Reserved())
+	assert.Equal(t, map[string]string{"color": "red", "size": "L"}, result.Attributes().All())
+	assert.True(t, testItem.CreatedAt().Equal(result.CreatedAt()))
+	assert.True(t, testItem.UpdatedAt().Equal(result.UpdatedAt()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_PriceStoredInCents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	testItem := createTestItem(t)
+	priceAmount := &capturedArg{}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO items").
+		WithArgs(
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			priceAmount,
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(),
+		).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectOutboxCommit(mock, testItem)
+
+	require.NoError(t, repo.Save(ctx, testItem))
+	assert.Equal(t, int64(9999), priceAmount.value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_RowToItemPrice(t *testing.T) {
+	row := &itemRow{
+		ID:                "550e8400-e29b-41d4-a716-446655440000",
+		SKU:               "TEST-001",
+		Name:              "Test Item",
+		PriceAmount:       9999,
+		PriceCurrency:     "USD",
+		CategoryName:      "Electronics",
+		CategorySlug:      "electronics",
+		InventoryQuantity: 10,
+		Images:            []byte(`[]`),
+		Attributes:        []byte(`{}`),
+		Status:            "active",
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+
+	result, err := rowToItem(row)
+
+	require.NoError(t, err)
+	assert.Equal(t, 99.99, result.Price().Amount())
+	assert.Equal(t, int64(9999), result.Price().Cents())
+}
+
+func TestPostgresItemRepository_ImagesAndAttributesRoundTrip(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	testItem := createTestItem(t)
+	front, err := item.NewImage("http://example.com/front.jpg", "Front", true)
+	require.NoError(t, err)
+	back, err := item.NewImage("http://example.com/back.jpg", "Back", false)
+	require.NoError(t, err)
+	testItem.AddImage(front)
+	testItem.AddImage(back)
+
+	attrs := testItem.Attributes()
+	require.NoError(t, attrs.Set("color", "red"))
+	require.NoError(t, attrs.Set("size", "L"))
+	require.NoError(t, attrs.Set("material", "cotton"))
+	weight, err := item.NumberAttribute(1.25)
+	require.NoError(t, err)
+	require.NoError(t, attrs.SetValue("weight", weight))
+	require.NoError(t, attrs.SetValue("waterproof", item.BoolAttribute(true)))
+	testItem.SetAttributes(attrs)
+
+	// Capture every inserted column so it can be fed back as a row
+	args, row := captureArgs(19)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO items").
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectOutboxCommit(mock, testItem)
+
+	require.NoError(t, repo.Save(ctx, testItem))
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}).AddRow(row()...)
+
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+		WithArgs(testItem.ID().String()).
+		WillReturnRows(rows)
+
+	result, err := repo.FindByID(ctx, testItem.ID())
+	require.NoError(t, err)
+
+	require.Len(t, result.Images(), 2)
+	assert.Equal(t, front, result.Images()[0])
+	assert.Equal(t, back, result.Images()[1])
+	assert.Equal(t, map[string]string{
+		"color":      "red",
+		"size":       "L",
+		"material":   "cotton",
+		"weight":     "1.25",
+		"waterproof": "true",
+	}, result.Attributes().All())
+
+	storedWeight, ok := result.Attributes().Value("weight")
+	require.True(t, ok)
+	number, ok := storedWeight.Number()
+	assert.True(t, ok)
+	assert.Equal(t, 1.25, number)
+	storedWaterproof, _ := result.Attributes().Value("waterproof")
+	assert.Equal(t, item.AttributeKindBool, storedWaterproof.Kind())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresItemRepository_OptionalFieldsRoundTrip(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	testItem := createTestItem(t)
+	dimensions, err := item.NewDimensions(30, 20, 10.5, "cm")
+	require.NoError(t, err)
+	weight, err := item.NewWeight(1.2, "kg")
+	require.NoError(t, err)
+	barcode, err := item.NewBarcode("036000291452")
+	require.NoError(t, err)
+	testItem.SetDimensions(dimensions)
+	testItem.SetWeight(weight)
+	testItem.SetBarcode(barcode)
+	brand, err := item.NewBrand("Acme")
+	require.NoError(t, err)
+	testItem.SetBrand(brand)
+
+	// Capture every inserted column so it can be fed back as a row
+	args, row := captureArgs(19)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO items").
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectOutboxCommit(mock, testItem)
+
+	require.NoError(t, repo.Save(ctx, testItem))
+
+	rows := sqlmock.NewRows([]string{
+		"id", "sku", "name", "description", "price_amount", "price_currency",
+		"category_name", "category_slug", "inventory_quantity", "reserved_quantity",
+		"images", "attributes", "measurements", "barcode", "brand_name", "brand_slug", "status", "created_at", "updated_at",
+	}).AddRow(row()...)
+
+	mock.ExpectQuery("SELECT (.+) FROM items WHERE id = \\$1").
+		WithArgs(testItem.ID().String()).
+		WillReturnRows(rows)
+
+	result, err := repo.FindByID(ctx, testItem.ID())
+	require.NoError(t, err)
+
+	assert.Equal(t, dimensions, result.Dimensions())
+	assert.Equal(t, weight, result.Weight())
+	assert.Equal(t, "0036000291452", result.Barcode().String())
+	assert.Equal(t, brand, result.Brand())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMeasurementsJSON(t *testing.T) {
+	t.Run("items without measurements store NULL", func(t *testing.T) {
+		data, err := measurementsToJSON(item.Dimensions{}, item.Weight{})
+
+		require.NoError(t, err)
+		assert.Nil(t, data)
+
+		dimensions, weight, err := measurementsFromJSON(nil)
+		require.NoError(t, err)
+		assert.True(t, dimensions.IsZero())
+		assert.True(t, weight.IsZero())
+	})
+
+	t.Run("weight alone leaves dimensions out", func(t *testing.T) {
+		weight, err := item.NewWeight(250, "g")
+		require.NoError(t, err)
+
+		data, err := measurementsToJSON(item.Dimensions{}, weight)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"weight":{"value":250,"unit":"g"}}`, string(data))
+	})
+
+	t.Run("invalid stored dimensions are rejected", func(t *testing.T) {
+		_, _, err := measurementsFromJSON([]byte(`{"dimensions":{"length":-1,"width":2,"height":3,"unit":"cm"}}`))
+
+		assert.ErrorContains(t, err, "invalid dimensions")
+	})
+}
+
+func TestAttributesJSON(t *testing.T) {
+	t.Run("kinds are stored as JSON types", func(t *testing.T) {
+		attrs := item.NewAttributes()
+		require.NoError(t, attrs.Set("color", "red"))
+		weight, err := item.NumberAttribute(2)
+		require.NoError(t, err)
+		require.NoError(t, attrs.SetValue("weight", weight))
+		require.NoError(t, attrs.SetValue("waterproof", item.BoolAttribute(false)))
+
+		data, err := json.Marshal(attributesToJSON(attrs))
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"color":"red","weight":2,"waterproof":false}`, string(data))
+	})
+
+	t.Run("string-only rows decode as strings", func(t *testing.T) {
+		attrs, err := attributesFromJSON([]byte(`{"weight":"2"}`))
+
+		require.NoError(t, err)
+		value, ok := attrs.Value("weight")
+		require.True(t, ok)
+		assert.Equal(t, item.AttributeKindString, value.Kind())
+	})
+
+	t.Run("nested values are rejected", func(t *testing.T) {
+		_, err := attributesFromJSON([]byte(`{"dimensions":{"w":1}}`))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPostgresItemRepository_RowToItemNullJSON(t *testing.T) {
+	row := &itemRow{
+		ID:            "550e8400-e29b-41d4-a716-446655440000",
+		SKU:           "TEST-001",
+		Name:          "Test Item",
+		PriceAmount:   9999,
+		PriceCurrency: "USD",
+		CategoryName:  "Electronics",
+		Status:        "active",
+	}
+
+	result, err := rowToItem(row)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Images())
+	assert.Empty(t, result.Attributes().All())
+}
+
+// capturedArg is a sqlmock argument matcher that records the value it sees
+type capturedArg struct {
+	value driver.Value
+}
+
+func (a *capturedArg) Match(v driver.Value) bool {
+	a.value = v
+	return true
+}
+
+// captureArgs returns n capturing matchers and a func yielding the captured values
+func captureArgs(n int) ([]driver.Value, func() []driver.Value) {
+	captured := make([]*capturedArg, n)
+	args := make([]driver.Value, n)
+	for i := range captured {
+		captured[i] = &capturedArg{}
+		args[i] = captured[i]
+	}
+
+	return args, func() []driver.Value {
+		values := make([]driver.Value, n)
+		for i, c := range captured {
+			values[i] = c.value
+		}
+		return values
+	}
+}
+
+// Helper function to create a test item
+// expectOutboxCommit expects one outbox row per pending event on itm, then the commit
+func expectOutboxCommit(mock sqlmock.Sqlmock, itm *item.Item) {
+	for range itm.Events() {
+		mock.ExpectExec("INSERT INTO outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+}
+
 func createTestItem(t *testing.T) *item.Item {
 	t.Helper()
 
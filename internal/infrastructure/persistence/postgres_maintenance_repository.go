@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"item-pdp-service/internal/application/usecase"
+	"item-pdp-service/internal/infrastructure/database"
+)
+
+// postgresMaintenanceRepository implements usecase.MaintenanceRepository using PostgreSQL
+type postgresMaintenanceRepository struct {
+	db *database.DB
+}
+
+// NewPostgresMaintenanceRepository creates a new PostgreSQL maintenance repository
+func NewPostgresMaintenanceRepository(db *database.DB) usecase.MaintenanceRepository {
+	return &postgresMaintenanceRepository{db: db}
+}
+
+// VacuumAnalyze reclaims dead tuples and refreshes planner statistics for items
+func (r *postgresMaintenanceRepository) VacuumAnalyze(ctx context.Context) error {
+	if _, err := r.db.ExecContext(ctx, `VACUUM ANALYZE items`); err != nil {
+		return fmt.Errorf("failed to vacuum analyze items: %w", err)
+	}
+	return nil
+}
+
+// RefreshStats refreshes planner statistics for items without vacuuming
+func (r *postgresMaintenanceRepository) RefreshStats(ctx context.Context) error {
+	if _, err := r.db.ExecContext(ctx, `ANALYZE items`); err != nil {
+		return fmt.Errorf("failed to analyze items: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresMaintenanceRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresMaintenanceRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	t.Run("vacuum analyze", func(t *testing.T) {
+		mock.ExpectExec("VACUUM ANALYZE items").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.NoError(t, repo.VacuumAnalyze(ctx))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("refresh stats", func(t *testing.T) {
+		mock.ExpectExec("^ANALYZE items$").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.NoError(t, repo.RefreshStats(ctx))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock.ExpectExec("VACUUM ANALYZE items").
+			WillReturnError(sql.ErrConnDone)
+
+		err := repo.VacuumAnalyze(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to vacuum analyze items")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/events"
+)
+
+// postgresOutboxRepository implements events.OutboxStore using PostgreSQL
+type postgresOutboxRepository struct {
+	db *database.DB
+}
+
+// NewPostgresOutboxRepository creates a new PostgreSQL outbox repository
+func NewPostgresOutboxRepository(db *database.DB) events.OutboxStore {
+	return &postgresOutboxRepository{db: db}
+}
+
+// FetchUnsent returns up to limit unsent messages, oldest first
+func (r *postgresOutboxRepository) FetchUnsent(ctx context.Context, limit int) ([]events.OutboxMessage, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, event_type, aggregate_id, payload, occurred_at
+		FROM outbox
+		WHERE sent_at IS NULL
+		ORDER BY created_at, id
+		LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	var messages []events.OutboxMessage
+	for rows.Next() {
+		var msg events.OutboxMessage
+		var payload []byte
+		if err := rows.Scan(&msg.ID, &msg.Type, &msg.Aggregate, &payload, &msg.Occurred); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox row: %w", queryError(ctx, err))
+		}
+		msg.Payload = payload
+		messages = append(messages, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate outbox rows: %w", queryError(ctx, err))
+	}
+
+	return messages, nil
+}
+
+// MarkSent stamps sent_at once; a message that is already sent is left untouched
+func (r *postgresOutboxRepository) MarkSent(ctx context.Context, id string) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE outbox SET sent_at = NOW(), attempts = attempts + 1 WHERE id = $1 AND sent_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark outbox message sent: %w", queryError(ctx, err))
+	}
+	return nil
+}
+
+// MarkFailed records the attempt and error while keeping the message unsent
+func (r *postgresOutboxRepository) MarkFailed(ctx context.Context, id string, cause error) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1 AND sent_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, id, cause.Error()); err != nil {
+		return fmt.Errorf("failed to mark outbox message failed: %w", queryError(ctx, err))
+	}
+	return nil
+}
+
+// insertOutboxEvents writes events to the outbox inside the caller's transaction
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, pending []item.DomainEvent) error {
+	query := `
+		INSERT INTO outbox (id, aggregate_id, event_type, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for _, event := range pending {
+		payload, err := json.Marshal(event.EventData())
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query,
+			event.EventID(),
+			event.AggregateID(),
+			event.EventType(),
+			payload,
+			event.OccurredAt(),
+		); err != nil {
+			return fmt.Errorf("failed to write %s event to outbox: %w", event.EventType(), err)
+		}
+	}
+
+	return nil
+}
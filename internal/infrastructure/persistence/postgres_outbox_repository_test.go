@@ -0,0 +1,102 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresItemRepository_SaveWritesOutbox(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	t.Run("events are written in the item transaction", func(t *testing.T) {
+		testItem := createTestItem(t)
+		created := testItem.Events()[0]
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO items").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox").
+			WithArgs(created.EventID(), testItem.ID().String(), "ItemCreated", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.Save(ctx, testItem))
+
+		assert.Empty(t, testItem.Events())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("outbox failure rolls back and keeps events pending", func(t *testing.T) {
+		testItem := createTestItem(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO items").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox").WillReturnError(errors.New("disk full"))
+		mock.ExpectRollback()
+
+		err := repo.Save(ctx, testItem)
+
+		assert.ErrorContains(t, err, "failed to write ItemCreated event to outbox")
+		assert.Len(t, testItem.Events(), 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresOutboxRepository_FetchUnsent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPostgresOutboxRepository(&database.DB{DB: db})
+	occurredAt := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "event_type", "aggregate_id", "payload", "occurred_at"}).
+		AddRow("msg-1", "ItemCreated", "item-1", []byte(`{"sku":"TEST-001"}`), occurredAt).
+		AddRow("msg-2", "ItemPriceChanged", "item-1", []byte(`{"newPrice":10}`), occurredAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM outbox WHERE sent_at IS NULL ORDER BY created_at, id LIMIT \\$1").
+		WithArgs(50).
+		WillReturnRows(rows)
+
+	messages, err := store.FetchUnsent(context.Background(), 50)
+
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "msg-1", messages[0].EventID())
+	assert.Equal(t, "ItemCreated", messages[0].EventType())
+	assert.Equal(t, "item-1", messages[0].AggregateID())
+	assert.JSONEq(t, `{"sku":"TEST-001"}`, string(messages[0].Payload))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresOutboxRepository_MarkSentAndFailed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPostgresOutboxRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE outbox SET sent_at = NOW\\(\\), attempts = attempts \\+ 1 WHERE id = \\$1 AND sent_at IS NULL").
+		WithArgs("msg-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE outbox SET attempts = attempts \\+ 1, last_error = \\$2 WHERE id = \\$1 AND sent_at IS NULL").
+		WithArgs("msg-2", "broker unavailable").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.MarkSent(ctx, "msg-1"))
+	require.NoError(t, store.MarkFailed(ctx, "msg-2", errors.New("broker unavailable")))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,106 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+)
+
+// insertPriceHistory records every ItemPriceChangedEvent among pending within tx
+func insertPriceHistory(ctx context.Context, tx *sql.Tx, pending []item.DomainEvent) error {
+	query := `
+		INSERT INTO item_price_history (item_id, old_amount, old_currency, new_amount, new_currency, changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, event := range pending {
+		changed, ok := event.(*item.ItemPriceChangedEvent)
+		if !ok {
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, query,
+			changed.ItemID.String(),
+			changed.OldPrice.Cents(),
+			changed.OldPrice.Currency(),
+			changed.NewPrice.Cents(),
+			changed.NewPrice.Currency(),
+			changed.OccurredAt(),
+		); err != nil {
+			return fmt.Errorf("failed to record price change: %w", queryError(ctx, err))
+		}
+	}
+
+	return nil
+}
+
+// FindPriceHistory returns one page of an item's price changes, oldest first
+func (r *postgresItemRepository) FindPriceHistory(ctx context.Context, id item.ItemID, limit, offset int) ([]item.PriceChange, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT old_amount, old_currency, new_amount, new_currency, changed_at
+		FROM item_price_history
+		WHERE item_id = $1
+		ORDER BY changed_at, id
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, id.String(), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find price history: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	history := make([]item.PriceChange, 0)
+	for rows.Next() {
+		var (
+			oldAmount, newAmount     int64
+			oldCurrency, newCurrency string
+			changedAt                time.Time
+		)
+		if err := rows.Scan(&oldAmount, &oldCurrency, &newAmount, &newCurrency, &changedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan price history row: %w", queryError(ctx, err))
+		}
+
+		oldPrice, err := item.NewPriceFromCents(oldAmount, oldCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid old price in history: %w", err)
+		}
+		newPrice, err := item.NewPriceFromCents(newAmount, newCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid new price in history: %w", err)
+		}
+
+		history = append(history, item.PriceChange{
+			ItemID:    id,
+			OldPrice:  oldPrice,
+			NewPrice:  newPrice,
+			ChangedAt: changedAt,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", queryError(ctx, err))
+	}
+
+	return history, nil
+}
+
+// CountPriceHistory counts the recorded price changes of an item
+func (r *postgresItemRepository) CountPriceHistory(ctx context.Context, id item.ItemID) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT COUNT(*) FROM item_price_history WHERE item_id = $1`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count price history: %w", queryError(ctx, err))
+	}
+
+	return count, nil
+}
@@ -0,0 +1,91 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/database"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresItemRepository_PriceHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPostgresItemRepository(&database.DB{DB: db})
+	ctx := context.Background()
+
+	testItem := createTestItem(t)
+	testItem.PullEvents()
+
+	t.Run("each price update records one history row", func(t *testing.T) {
+		for _, amount := range []float64{89.99, 79.99} {
+			oldCents := testItem.Price().Cents()
+			price, err := item.NewPrice(amount, "USD")
+			require.NoError(t, err)
+			testItem.SetPrice(price)
+
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE items SET").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec("INSERT INTO outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec("INSERT INTO item_price_history").
+				WithArgs(testItem.ID().String(), oldCents, "USD", price.Cents(), "USD", sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, repo.Update(ctx, testItem))
+		}
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("updates that keep the price record nothing", func(t *testing.T) {
+		testItem.SetName("Renamed Item")
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE items SET").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.Update(ctx, testItem))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("history is read oldest first", func(t *testing.T) {
+		first := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+		rows := sqlmock.NewRows([]string{"old_amount", "old_currency", "new_amount", "new_currency", "changed_at"}).
+			AddRow(9999, "USD", 8999, "USD", first).
+			AddRow(8999, "USD", 7999, "USD", first.Add(time.Hour))
+
+		mock.ExpectQuery("SELECT (.+) FROM item_price_history WHERE item_id = \\$1 ORDER BY changed_at, id LIMIT \\$2 OFFSET \\$3").
+			WithArgs(testItem.ID().String(), 10, 0).
+			WillReturnRows(rows)
+
+		history, err := repo.FindPriceHistory(ctx, testItem.ID(), 10, 0)
+
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, 99.99, history[0].OldPrice.Amount())
+		assert.Equal(t, 89.99, history[0].NewPrice.Amount())
+		assert.Equal(t, 79.99, history[1].NewPrice.Amount())
+		assert.True(t, history[0].ChangedAt.Before(history[1].ChangedAt))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM item_price_history WHERE item_id = \\$1").
+			WithArgs(testItem.ID().String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repo.CountPriceHistory(ctx, testItem.ID())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
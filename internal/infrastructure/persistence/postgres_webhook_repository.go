@@ -0,0 +1,170 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/lib/pq"
+)
+
+// Delivery statuses stored in webhook_deliveries.status
+const (
+	webhookPending   = "pending"
+	webhookDelivered = "delivered"
+	webhookFailed    = "failed"
+)
+
+// postgresWebhookRepository implements events.WebhookStore using PostgreSQL
+type postgresWebhookRepository struct {
+	db *database.DB
+}
+
+// NewPostgresWebhookRepository creates a new PostgreSQL webhook repository
+func NewPostgresWebhookRepository(db *database.DB) events.WebhookStore {
+	return &postgresWebhookRepository{db: db}
+}
+
+// Create inserts the webhook and fills in its creation time
+func (r *postgresWebhookRepository) Create(ctx context.Context, webhook *events.Webhook) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhooks (id, url, secret, event_types)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at`
+
+	err := r.db.QueryRowContext(ctx, query, webhook.ID, webhook.URL, webhook.Secret, pq.Array(webhook.EventTypes)).
+		Scan(&webhook.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", queryError(ctx, err))
+	}
+	return nil
+}
+
+// Enqueue adds a pending delivery for every webhook subscribed to eventType
+// The unique (webhook_id, event_id) pair makes a republished event a no-op
+func (r *postgresWebhookRepository) Enqueue(ctx context.Context, eventID, eventType string, payload []byte) (int, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		SELECT id, $1, $2, $3
+		FROM webhooks
+		WHERE $2 = ANY(event_types)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query, eventID, eventType, payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to queue webhook deliveries: %w", queryError(ctx, err))
+	}
+
+	queued, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count queued webhook deliveries: %w", err)
+	}
+	return int(queued), nil
+}
+
+// ClaimDue pushes the next attempt of up to limit due deliveries out by lease and returns them
+// SKIP LOCKED lets several workers claim at once without waiting on each other
+func (r *postgresWebhookRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]events.WebhookDelivery, error) {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	query := `
+		WITH due AS (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + $2 * INTERVAL '1 second'
+		FROM due, webhooks w
+		WHERE d.id = due.id AND w.id = d.webhook_id
+		RETURNING d.id, d.webhook_id, w.url, w.secret, d.event_type, d.payload, d.attempts`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", queryError(ctx, err))
+	}
+	defer rows.Close()
+
+	var deliveries []events.WebhookDelivery
+	for rows.Next() {
+		var delivery events.WebhookDelivery
+		var payload []byte
+		if err := rows.Scan(&delivery.ID, &delivery.WebhookID, &delivery.URL, &delivery.Secret,
+			&delivery.EventType, &payload, &delivery.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", queryError(ctx, err))
+		}
+		delivery.Payload = payload
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate webhook deliveries: %w", queryError(ctx, err))
+	}
+
+	return deliveries, nil
+}
+
+// RecordAttempt stores the attempt and updates its delivery in one transaction
+func (r *postgresWebhookRepository) RecordAttempt(ctx context.Context, attempt events.WebhookAttempt) error {
+	ctx, cancel := r.db.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var statusCode sql.NullInt64
+	if attempt.StatusCode != 0 {
+		statusCode = sql.NullInt64{Int64: int64(attempt.StatusCode), Valid: true}
+	}
+	var lastError sql.NullString
+	if attempt.Err != nil {
+		lastError = sql.NullString{String: attempt.Err.Error(), Valid: true}
+	}
+
+	status, nextAttemptAt := webhookPending, sql.NullTime{Time: attempt.NextAttemptAt, Valid: true}
+	switch {
+	case attempt.Delivered:
+		status, nextAttemptAt = webhookDelivered, sql.NullTime{}
+	case attempt.NextAttemptAt.IsZero():
+		status, nextAttemptAt = webhookFailed, sql.NullTime{}
+	}
+
+	err := r.db.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error)
+			VALUES ($1, $2, $3, $4)`,
+			attempt.DeliveryID, attempt.Attempt, statusCode, lastError,
+		); err != nil {
+			return fmt.Errorf("failed to insert webhook delivery attempt: %w", queryError(ctx, err))
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE webhook_deliveries SET
+				status = $2,
+				attempts = $3,
+				last_error = $4,
+				next_attempt_at = COALESCE($5, next_attempt_at),
+				delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() ELSE delivered_at END
+			WHERE id = $1`,
+			attempt.DeliveryID, status, attempt.Attempt, lastError, nextAttemptAt,
+		); err != nil {
+			return fmt.Errorf("failed to update webhook delivery: %w", queryError(ctx, err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"item-pdp-service/internal/infrastructure/database"
+	"item-pdp-service/internal/infrastructure/events"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresWebhookRepository_CreateAndEnqueue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPostgresWebhookRepository(&database.DB{DB: db})
+	ctx := context.Background()
+	createdAt := time.Now()
+
+	mock.ExpectQuery("INSERT INTO webhooks \\(id, url, secret, event_types\\)").
+		WithArgs("wh-1", "https://example.com/hook", "s3cret", pq.Array([]string{"ItemCreated"})).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(createdAt))
+	mock.ExpectExec("INSERT INTO webhook_deliveries (.+) FROM webhooks WHERE \\$2 = ANY\\(event_types\\) ON CONFLICT \\(webhook_id, event_id\\) DO NOTHING").
+		WithArgs("evt-1", "ItemCreated", []byte(`{"type":"ItemCreated"}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	webhook := &events.Webhook{ID: "wh-1", URL: "https://example.com/hook", Secret: "s3cret", EventTypes: []string{"ItemCreated"}}
+	require.NoError(t, store.Create(ctx, webhook))
+	assert.Equal(t, createdAt, webhook.CreatedAt)
+
+	queued, err := store.Enqueue(ctx, "evt-1", "ItemCreated", []byte(`{"type":"ItemCreated"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, queued)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresWebhookRepository_ClaimDue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPostgresWebhookRepository(&database.DB{DB: db})
+
+	rows := sqlmock.NewRows([]string{"id", "webhook_id", "url", "secret", "event_type", "payload", "attempts"}).
+		AddRow("del-1", "wh-1", "https://example.com/hook", "s3cret", "ItemCreated", []byte(`{"id":"evt-1"}`), 2)
+	mock.ExpectQuery("FOR UPDATE SKIP LOCKED (.+) UPDATE webhook_deliveries d SET next_attempt_at = NOW\\(\\) \\+ \\$2 \\* INTERVAL '1 second'").
+		WithArgs(10, 90.0).
+		WillReturnRows(rows)
+
+	deliveries, err := store.ClaimDue(context.Background(), 10, 90*time.Second)
+
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, events.WebhookDelivery{
+		ID:        "del-1",
+		WebhookID: "wh-1",
+		URL:       "https://example.com/hook",
+		Secret:    "s3cret",
+		EventType: "ItemCreated",
+		Payload:   []byte(`{"id":"evt-1"}`),
+		Attempts:  2,
+	}, deliveries[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresWebhookRepository_RecordAttempt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPostgresWebhookRepository(&database.DB{DB: db})
+	ctx := context.Background()
+	retryAt := time.Now().Add(time.Minute)
+
+	t.Run("failed attempt is kept and retried", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+			WithArgs("del-1", 1, sql.NullInt64{Int64: 500, Valid: true}, sql.NullString{String: "webhook responded with status 500", Valid: true}).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE webhook_deliveries SET").
+			WithArgs("del-1", "pending", 1, sql.NullString{String: "webhook responded with status 500", Valid: true}, sql.NullTime{Time: retryAt, Valid: true}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, store.RecordAttempt(ctx, events.WebhookAttempt{
+			DeliveryID:    "del-1",
+			Attempt:       1,
+			StatusCode:    500,
+			Err:           errors.New("webhook responded with status 500"),
+			NextAttemptAt: retryAt,
+		}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delivered attempt", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+			WithArgs("del-1", 2, sql.NullInt64{Int64: 200, Valid: true}, sql.NullString{}).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE webhook_deliveries SET").
+			WithArgs("del-1", "delivered", 2, sql.NullString{}, sql.NullTime{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, store.RecordAttempt(ctx, events.WebhookAttempt{DeliveryID: "del-1", Attempt: 2, StatusCode: 200, Delivered: true}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("last attempt gives up", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+			WithArgs("del-1", 8, sql.NullInt64{}, sql.NullString{String: "connection refused", Valid: true}).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE webhook_deliveries SET").
+			WithArgs("del-1", "failed", 8, sql.NullString{String: "connection refused", Valid: true}, sql.NullTime{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, store.RecordAttempt(ctx, events.WebhookAttempt{DeliveryID: "del-1", Attempt: 8, Err: errors.New("connection refused")}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,369 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"item-pdp-service/internal/domain/item"
+	"item-pdp-service/internal/infrastructure/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var repositoryTracer = tracing.Tracer("item-pdp-service/persistence")
+
+// TracingItemRepository decorates an item.Repository with one client span per call
+// Spans are named "ItemRepository.<Method>" and carry the method as db.query.name
+type TracingItemRepository struct {
+	next item.Repository
+}
+
+// NewTracingItemRepository wraps next so every call is traced
+func NewTracingItemRepository(next item.Repository) *TracingItemRepository {
+	return &TracingItemRepository{next: next}
+}
+
+func (r *TracingItemRepository) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, tracing.AttrDBSystem.String("postgresql"), tracing.AttrDBQuery.String(method))
+	return repositoryTracer.Start(ctx, "ItemRepository."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func (r *TracingItemRepository) Save(ctx context.Context, itm *item.Item) (err error) {
+	ctx, span := r.start(ctx, "Save", tracing.AttrItemID.String(itm.ID().String()), tracing.AttrItemSKU.String(itm.SKU().String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.Save(ctx, itm)
+}
+
+func (r *TracingItemRepository) SaveAll(ctx context.Context, items []*item.Item, allOrNothing bool) (itemErrs []error, err error) {
+	ctx, span := r.start(ctx, "SaveAll", attribute.Int("item.count", len(items)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.SaveAll(ctx, items, allOrNothing)
+}
+
+func (r *TracingItemRepository) FindByID(ctx context.Context, id item.ItemID) (result *item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByID", tracing.AttrItemID.String(id.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByID(ctx, id)
+}
+
+func (r *TracingItemRepository) FindBySKU(ctx context.Context, sku item.SKU) (result *item.Item, err error) {
+	ctx, span := r.start(ctx, "FindBySKU", tracing.AttrItemSKU.String(sku.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindBySKU(ctx, sku)
+}
+
+func (r *TracingItemRepository) FindByBarcode(ctx context.Context, barcode item.Barcode) (result *item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByBarcode", tracing.AttrItemBarcode.String(barcode.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByBarcode(ctx, barcode)
+}
+
+func (r *TracingItemRepository) FindByIDs(ctx context.Context, ids []item.ItemID) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByIDs", attribute.Int("item.count", len(ids)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByIDs(ctx, ids)
+}
+
+func (r *TracingItemRepository) GetItemsWithRelatedData(ctx context.Context, ids []item.ItemID) (result []item.ItemWithStats, err error) {
+	ctx, span := r.start(ctx, "GetItemsWithRelatedData", attribute.Int("item.count", len(ids)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.GetItemsWithRelatedData(ctx, ids)
+}
+
+func (r *TracingItemRepository) Update(ctx context.Context, itm *item.Item) (err error) {
+	ctx, span := r.start(ctx, "Update", tracing.AttrItemID.String(itm.ID().String()), tracing.AttrItemSKU.String(itm.SKU().String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.Update(ctx, itm)
+}
+
+func (r *TracingItemRepository) UpdateAll(ctx context.Context, items []*item.Item) (itemErrs []error, err error) {
+	ctx, span := r.start(ctx, "UpdateAll", attribute.Int("item.count", len(items)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.UpdateAll(ctx, items)
+}
+
+func (r *TracingItemRepository) Delete(ctx context.Context, id item.ItemID) (err error) {
+	ctx, span := r.start(ctx, "Delete", tracing.AttrItemID.String(id.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.Delete(ctx, id)
+}
+
+func (r *TracingItemRepository) DeleteAll(ctx context.Context, ids []item.ItemID) (notFound []item.ItemID, err error) {
+	ctx, span := r.start(ctx, "DeleteAll", attribute.Int("item.count", len(ids)))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.DeleteAll(ctx, ids)
+}
+
+func (r *TracingItemRepository) FindByCategory(ctx context.Context, category item.Category, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByCategory")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByCategory(ctx, category, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByCategoryTree(ctx context.Context, category item.Category, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByCategoryTree")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByCategoryTree(ctx, category, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByBrand(ctx context.Context, brandSlug string, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByBrand")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByBrand(ctx, brandSlug, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByStatus(ctx context.Context, status item.Status, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByStatus")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByStatus(ctx, status, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByFilter(ctx context.Context, filter item.ListFilter, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByFilter")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByFilter(ctx, filter, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindSummariesByFilter(ctx context.Context, filter item.ListFilter, sort item.Sort, limit, offset int) (result []item.ItemSummary, err error) {
+	ctx, span := r.start(ctx, "FindSummariesByFilter")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindSummariesByFilter(ctx, filter, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) Search(ctx context.Context, query string, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "Search")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.Search(ctx, query, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) SearchRanked(ctx context.Context, query string, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "SearchRanked")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.SearchRanked(ctx, query, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByPriceRange(ctx context.Context, min, max float64, currency string, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByPriceRange")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByPriceRange(ctx, min, max, currency, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByCategoryAndPriceRange(ctx context.Context, category item.Category, min, max float64, currency string, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByCategoryAndPriceRange")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByCategoryAndPriceRange(ctx, category, min, max, currency, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByAttributes(ctx context.Context, filters map[string]string, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByAttributes")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByAttributes(ctx, filters, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindByAttributeRanges(ctx context.Context, ranges []item.AttributeRange, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByAttributeRanges")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByAttributeRanges(ctx, ranges, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) Stream(ctx context.Context, filter item.StreamFilter, fn func(*item.Item) error) (err error) {
+	ctx, span := r.start(ctx, "Stream")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.Stream(ctx, filter, fn)
+}
+
+func (r *TracingItemRepository) FindPriceHistory(ctx context.Context, id item.ItemID, limit, offset int) (result []item.PriceChange, err error) {
+	ctx, span := r.start(ctx, "FindPriceHistory", tracing.AttrItemID.String(id.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindPriceHistory(ctx, id, limit, offset)
+}
+
+func (r *TracingItemRepository) CountPriceHistory(ctx context.Context, id item.ItemID) (result int, err error) {
+	ctx, span := r.start(ctx, "CountPriceHistory", tracing.AttrItemID.String(id.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountPriceHistory(ctx, id)
+}
+
+func (r *TracingItemRepository) FindAvailableItems(ctx context.Context, sort item.Sort, limit, offset int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindAvailableItems")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindAvailableItems(ctx, sort, limit, offset)
+}
+
+func (r *TracingItemRepository) FindItemsWithLowStock(ctx context.Context, threshold int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindItemsWithLowStock")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindItemsWithLowStock(ctx, threshold)
+}
+
+func (r *TracingItemRepository) FindRelated(ctx context.Context, source *item.Item, limit int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindRelated", tracing.AttrItemID.String(source.ID().String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindRelated(ctx, source, limit)
+}
+
+func (r *TracingItemRepository) FindByNamePrefix(ctx context.Context, prefix string, limit int) (result []*item.Item, err error) {
+	ctx, span := r.start(ctx, "FindByNamePrefix")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindByNamePrefix(ctx, prefix, limit)
+}
+
+func (r *TracingItemRepository) FindMostViewed(ctx context.Context, since time.Time, limit int) (result []item.ItemViewCount, err error) {
+	ctx, span := r.start(ctx, "FindMostViewed")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.FindMostViewed(ctx, since, limit)
+}
+
+func (r *TracingItemRepository) RecordView(ctx context.Context, id item.ItemID) (err error) {
+	ctx, span := r.start(ctx, "RecordView", tracing.AttrItemID.String(id.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.RecordView(ctx, id)
+}
+
+func (r *TracingItemRepository) CountByCategory(ctx context.Context, category item.Category) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByCategory")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByCategory(ctx, category)
+}
+
+func (r *TracingItemRepository) CountByCategoryTree(ctx context.Context, category item.Category) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByCategoryTree")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByCategoryTree(ctx, category)
+}
+
+func (r *TracingItemRepository) CountByBrand(ctx context.Context, brandSlug string) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByBrand")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByBrand(ctx, brandSlug)
+}
+
+func (r *TracingItemRepository) CountByStatus(ctx context.Context, status item.Status) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByStatus")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByStatus(ctx, status)
+}
+
+func (r *TracingItemRepository) CountByFilter(ctx context.Context, filter item.ListFilter) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByFilter")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByFilter(ctx, filter)
+}
+
+func (r *TracingItemRepository) CountTopCategories(ctx context.Context, limit int) (result []item.CategoryCount, err error) {
+	ctx, span := r.start(ctx, "CountTopCategories")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountTopCategories(ctx, limit)
+}
+
+func (r *TracingItemRepository) CountBySearch(ctx context.Context, query string) (result int, err error) {
+	ctx, span := r.start(ctx, "CountBySearch")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountBySearch(ctx, query)
+}
+
+func (r *TracingItemRepository) CountBySearchRanked(ctx context.Context, query string) (result int, err error) {
+	ctx, span := r.start(ctx, "CountBySearchRanked")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountBySearchRanked(ctx, query)
+}
+
+func (r *TracingItemRepository) CountByPriceRange(ctx context.Context, min, max float64, currency string) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByPriceRange")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByPriceRange(ctx, min, max, currency)
+}
+
+func (r *TracingItemRepository) CountByCategoryAndPriceRange(ctx context.Context, category item.Category, min, max float64, currency string) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByCategoryAndPriceRange")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByCategoryAndPriceRange(ctx, category, min, max, currency)
+}
+
+func (r *TracingItemRepository) CountByAttributes(ctx context.Context, filters map[string]string) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByAttributes")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByAttributes(ctx, filters)
+}
+
+func (r *TracingItemRepository) CountByAttributeRanges(ctx context.Context, ranges []item.AttributeRange) (result int, err error) {
+	ctx, span := r.start(ctx, "CountByAttributeRanges")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountByAttributeRanges(ctx, ranges)
+}
+
+func (r *TracingItemRepository) CountAvailable(ctx context.Context) (result int, err error) {
+	ctx, span := r.start(ctx, "CountAvailable")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.CountAvailable(ctx)
+}
+
+func (r *TracingItemRepository) ExistsBySKU(ctx context.Context, sku item.SKU) (result bool, err error) {
+	ctx, span := r.start(ctx, "ExistsBySKU", tracing.AttrItemSKU.String(sku.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.ExistsBySKU(ctx, sku)
+}
+
+func (r *TracingItemRepository) ExistsByID(ctx context.Context, id item.ItemID) (result bool, err error) {
+	ctx, span := r.start(ctx, "ExistsByID", tracing.AttrItemID.String(id.String()))
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.ExistsByID(ctx, id)
+}
+
+func (r *TracingItemRepository) ExistsCategory(ctx context.Context, category item.Category) (result bool, err error) {
+	ctx, span := r.start(ctx, "ExistsCategory")
+	defer func() { tracing.End(span, err) }()
+
+	return r.next.ExistsCategory(ctx, category)
+}
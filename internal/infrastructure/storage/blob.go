@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// Blob stores uploaded files and returns the public URL they are served from
+type Blob interface {
+	// Put writes the contents of r under key and returns its public URL
+	Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error)
+	// Delete removes key; deleting a missing key is not an error
+	Delete(ctx context.Context, key string) error
+}
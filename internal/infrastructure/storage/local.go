@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalBlob is a Blob on the local filesystem, served by the API under a static route
+type LocalBlob struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalBlob stores files under dir and builds URLs from baseURL
+func NewLocalBlob(dir, baseURL string) *LocalBlob {
+	return &LocalBlob{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// Put writes the file atomically so readers never see a partial upload
+func (b *LocalBlob) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	path, err := b.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create directory for %s: %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file for %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to store %s: %w", key, err)
+	}
+
+	return b.baseURL + "/" + key, nil
+}
+
+// Delete removes the file for key
+func (b *LocalBlob) Delete(ctx context.Context, key string) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// path resolves key inside dir, refusing keys that would escape it
+func (b *LocalBlob) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(b.dir, filepath.FromSlash(key)), nil
+}
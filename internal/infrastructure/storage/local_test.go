@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlob(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blob := NewLocalBlob(dir, "http://localhost:8080/uploads/")
+
+	t.Run("put writes the file and returns its URL", func(t *testing.T) {
+		url, err := blob.Put(ctx, "items/abc/photo.png", strings.NewReader("png-bytes"), "image/png")
+
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:8080/uploads/items/abc/photo.png", url)
+		data, err := os.ReadFile(filepath.Join(dir, "items", "abc", "photo.png"))
+		require.NoError(t, err)
+		assert.Equal(t, "png-bytes", string(data))
+	})
+
+	t.Run("delete removes the file and ignores missing keys", func(t *testing.T) {
+		_, err := blob.Put(ctx, "items/abc/old.png", strings.NewReader("old"), "image/png")
+		require.NoError(t, err)
+
+		require.NoError(t, blob.Delete(ctx, "items/abc/old.png"))
+		_, err = os.Stat(filepath.Join(dir, "items", "abc", "old.png"))
+		assert.True(t, os.IsNotExist(err))
+		assert.NoError(t, blob.Delete(ctx, "items/abc/old.png"))
+	})
+
+	t.Run("keys cannot escape the directory", func(t *testing.T) {
+		_, err := blob.Put(ctx, "../escape.png", strings.NewReader("x"), "image/png")
+
+		assert.Error(t, err)
+		_, statErr := os.Stat(filepath.Join(filepath.Dir(dir), "escape.png"))
+		assert.True(t, os.IsNotExist(statErr))
+	})
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"item-pdp-service/internal/infrastructure/config"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3Blob is a Blob in an S3 (or S3-compatible) bucket
+type S3Blob struct {
+	client    *s3.Client
+	bucket    string
+	publicURL string
+}
+
+// NewS3Blob stores objects in bucket and builds URLs from publicURL
+func NewS3Blob(client *s3.Client, bucket, publicURL string) *S3Blob {
+	return &S3Blob{
+		client:    client,
+		bucket:    bucket,
+		publicURL: strings.TrimRight(publicURL, "/"),
+	}
+}
+
+// Put uploads the object with its content type
+func (b *S3Blob) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(b.bucket),
+		Key:         aws.String(key),
+		Body:        r,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload %s to s3: %w", key, err)
+	}
+	return b.publicURL + "/" + key, nil
+}
+
+// Delete removes the object; S3 treats missing keys as already deleted
+func (b *S3Blob) Delete(ctx context.Context, key string) error {
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s from s3: %w", key, err)
+	}
+	return nil
+}
+
+// NewS3BlobFromConfig creates an S3 store using the default AWS credential chain
+// A custom endpoint switches to path-style addressing for S3-compatible servers
+func NewS3BlobFromConfig(ctx context.Context, cfg *config.Config) (*S3Blob, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(cfg.Storage.S3Region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if cfg.Storage.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Storage.S3Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	publicURL := cfg.Storage.S3PublicURL
+	if publicURL == "" {
+		publicURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.Storage.S3Bucket, cfg.Storage.S3Region)
+	}
+
+	return NewS3Blob(client, cfg.Storage.S3Bucket, publicURL), nil
+}
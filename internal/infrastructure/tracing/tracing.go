@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"item-pdp-service/internal/infrastructure/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys shared by every layer
+const (
+	AttrItemID      = attribute.Key("item.id")
+	AttrItemSKU     = attribute.Key("item.sku")
+	AttrItemBarcode = attribute.Key("item.barcode")
+	AttrDBQuery     = attribute.Key("db.query.name")
+	AttrDBSystem    = attribute.Key("db.system")
+	// AttrDBRowsSkipped counts rows a lenient read left out because they could not be converted
+	AttrDBRowsSkipped = attribute.Key("db.rows.skipped")
+)
+
+// Tracer returns the named tracer from the global provider
+// Tracers obtained before Setup pick up the configured provider once it is installed
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// End records err on the span, if any, and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Setup installs the global tracer provider and W3C propagators
+// When tracing is disabled spans stay no-ops and the returned provider is nil
+func Setup(ctx context.Context, cfg *config.Config) (*sdktrace.TracerProvider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Tracing.Enabled {
+		return nil, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Tracing.Endpoint)}
+	if cfg.Tracing.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := NewProvider(cfg, sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(provider)
+	return provider, nil
+}
+
+// NewProvider creates a tracer provider tagged with the service name and version
+func NewProvider(cfg *config.Config, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.App.Name),
+		semconv.ServiceVersion(cfg.App.Version),
+		semconv.DeploymentEnvironment(cfg.App.Environment),
+	)
+
+	opts = append([]sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Tracing.SampleRatio))),
+	}, opts...)
+
+	return sdktrace.NewTracerProvider(opts...)
+}
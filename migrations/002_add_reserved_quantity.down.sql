@@ -0,0 +1,9 @@
+-- Restore the original available items index
+DROP INDEX IF EXISTS idx_items_available;
+CREATE INDEX idx_items_available ON items(status, inventory_quantity)
+WHERE status = 'active' AND inventory_quantity > 0;
+
+-- Drop reserved quantity constraints and column
+ALTER TABLE items DROP CONSTRAINT IF EXISTS chk_reserved_within_inventory;
+ALTER TABLE items DROP CONSTRAINT IF EXISTS chk_reserved_quantity_non_negative;
+ALTER TABLE items DROP COLUMN IF EXISTS reserved_quantity;
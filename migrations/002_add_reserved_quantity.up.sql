@@ -0,0 +1,10 @@
+-- Track units held for pending orders separately from stock on hand
+ALTER TABLE items ADD COLUMN reserved_quantity INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE items ADD CONSTRAINT chk_reserved_quantity_non_negative CHECK (reserved_quantity >= 0);
+ALTER TABLE items ADD CONSTRAINT chk_reserved_within_inventory CHECK (reserved_quantity <= inventory_quantity);
+
+-- Available items are those with unreserved stock
+DROP INDEX IF EXISTS idx_items_available;
+CREATE INDEX idx_items_available ON items(status, inventory_quantity, reserved_quantity)
+WHERE status = 'active' AND inventory_quantity > reserved_quantity;
@@ -0,0 +1,2 @@
+-- Attribute filters use JSONB containment (attributes @> '{"color":"red"}')
+CREATE INDEX IF NOT EXISTS idx_items_attributes ON items USING gin(attributes jsonb_path_ops);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_item_price_history_item;
+DROP TABLE IF EXISTS item_price_history;
@@ -0,0 +1,13 @@
+-- One row per price change, written in the same transaction as the item update
+CREATE TABLE IF NOT EXISTS item_price_history (
+    id BIGSERIAL PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    old_amount BIGINT NOT NULL, -- stored in cents
+    old_currency VARCHAR(3) NOT NULL,
+    new_amount BIGINT NOT NULL, -- stored in cents
+    new_currency VARCHAR(3) NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- History is always read per item in chronological order
+CREATE INDEX IF NOT EXISTS idx_item_price_history_item ON item_price_history(item_id, changed_at, id);
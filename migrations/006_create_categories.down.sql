@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_categories_parent_slug;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,18 @@
+-- Category hierarchy; items reference a category by slug
+CREATE TABLE IF NOT EXISTS categories (
+    slug VARCHAR(100) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    parent_slug VARCHAR(100) REFERENCES categories(slug) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_categories_parent_not_self CHECK (parent_slug <> slug)
+);
+
+-- Descendant lookups walk the hierarchy from parent to children
+CREATE INDEX IF NOT EXISTS idx_categories_parent_slug ON categories(parent_slug);
+
+-- Categories already in use start at the top level
+INSERT INTO categories (slug, name)
+SELECT DISTINCT ON (category_slug) category_slug, category_name
+FROM items
+ORDER BY category_slug, created_at
+ON CONFLICT (slug) DO NOTHING;
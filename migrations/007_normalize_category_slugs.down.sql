@@ -0,0 +1,3 @@
+-- The old slugs cannot be recovered from the normalized ones, and the service only produces
+-- normalized slugs from here on, so there is nothing to undo
+SELECT 1;
@@ -0,0 +1,10 @@
+-- Slugs used to keep punctuation ("home-&-garden"); rewrite them the way item.NewCategory now does:
+-- lowercase, every run of non-alphanumeric characters becomes one hyphen, none at either end
+UPDATE items
+SET category_slug = trim(BOTH '-' FROM regexp_replace(lower(category_name), '[^[:alnum:]]+', '-', 'g'))
+WHERE category_slug <> trim(BOTH '-' FROM regexp_replace(lower(category_name), '[^[:alnum:]]+', '-', 'g'));
+
+-- Parent links are rewritten in the same statement so the foreign key holds at its end
+UPDATE categories
+SET slug = trim(BOTH '-' FROM regexp_replace(lower(slug), '[^[:alnum:]]+', '-', 'g')),
+    parent_slug = trim(BOTH '-' FROM regexp_replace(lower(parent_slug), '[^[:alnum:]]+', '-', 'g'));
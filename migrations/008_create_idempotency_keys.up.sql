@@ -0,0 +1,13 @@
+-- Idempotency-Key values seen on item creation and the response each one produced
+-- response stays NULL while the first request for a key is still running
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    request_hash VARCHAR(64) NOT NULL, -- sha256 of the request body
+    item_id UUID,
+    response JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Expired keys are reclaimed on reuse and can be purged by expiry
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
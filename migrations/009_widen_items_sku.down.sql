@@ -0,0 +1 @@
+ALTER TABLE items ALTER COLUMN sku TYPE VARCHAR(20);
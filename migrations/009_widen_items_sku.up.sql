@@ -0,0 +1,2 @@
+-- Leave room for SKU policies longer than the original 20 characters
+ALTER TABLE items ALTER COLUMN sku TYPE VARCHAR(64);
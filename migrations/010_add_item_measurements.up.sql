@@ -0,0 +1,4 @@
+-- Package dimensions and weight for shipping, e.g.
+-- {"dimensions": {"length": 30, "width": 20, "height": 10, "unit": "cm"}, "weight": {"value": 1.2, "unit": "kg"}}
+-- NULL when neither is known; either key may be missing
+ALTER TABLE items ADD COLUMN measurements JSONB;
@@ -0,0 +1,4 @@
+-- GTIN-13 barcode; UPC-A codes are stored with a leading zero. NULL when the item has none
+ALTER TABLE items ADD COLUMN barcode VARCHAR(13);
+
+CREATE UNIQUE INDEX idx_items_barcode ON items(barcode) WHERE barcode IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_items_brand_slug;
+
+ALTER TABLE items DROP COLUMN IF EXISTS brand_slug;
+ALTER TABLE items DROP COLUMN IF EXISTS brand_name;
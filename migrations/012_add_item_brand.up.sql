@@ -0,0 +1,5 @@
+-- Brand an item is sold under; the slug is derived from the name like category_slug. NULL when unbranded
+ALTER TABLE items ADD COLUMN brand_name VARCHAR(100);
+ALTER TABLE items ADD COLUMN brand_slug VARCHAR(100);
+
+CREATE INDEX idx_items_brand_slug ON items(brand_slug) WHERE brand_slug IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_item_ratings_item;
+DROP TABLE IF EXISTS item_ratings;
+DROP INDEX IF EXISTS idx_item_views_item;
+DROP TABLE IF EXISTS item_views;
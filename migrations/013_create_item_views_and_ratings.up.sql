@@ -0,0 +1,19 @@
+-- One row per time an item was viewed
+CREATE TABLE IF NOT EXISTS item_views (
+    id BIGSERIAL PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Views are counted per item, optionally within a time window
+CREATE INDEX IF NOT EXISTS idx_item_views_item ON item_views(item_id, viewed_at);
+
+-- One row per customer rating, from 1 to 5
+CREATE TABLE IF NOT EXISTS item_ratings (
+    id BIGSERIAL PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_ratings_item ON item_ratings(item_id);
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS idx_items_search ON items USING gin(to_tsvector('english', name || ' ' || description));
+
+DROP INDEX IF EXISTS idx_items_search_vector;
+
+ALTER TABLE items DROP COLUMN IF EXISTS search_vector;
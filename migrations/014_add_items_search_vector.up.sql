@@ -0,0 +1,11 @@
+-- Weighted full-text document for ranked search; name and SKU (A) outrank description (B)
+ALTER TABLE items ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(sku, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_items_search_vector ON items USING gin(search_vector);
+
+-- The expression index from 001 is superseded by search_vector
+DROP INDEX IF EXISTS idx_items_search;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_webhook_delivery_attempts_delivery;
+DROP TABLE IF EXISTS webhook_delivery_attempts;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP INDEX IF EXISTS idx_webhooks_event_types;
+DROP TABLE IF EXISTS webhooks;
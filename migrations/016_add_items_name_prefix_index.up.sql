@@ -0,0 +1,3 @@
+-- Autocomplete matches lower(name) LIKE 'prefix%'; text_pattern_ops lets a btree serve
+-- the prefix regardless of the database collation
+CREATE INDEX IF NOT EXISTS idx_items_name_prefix ON items (lower(name) text_pattern_ops);